@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestMatchingBuildsPending(t *testing.T) {
@@ -578,3 +579,41 @@ func TestMatchingBuildsSchedulingFewCommonDependencies(t *testing.T) {
 	assert.True(t, matches)
 	assert.Equal(t, buildA.Name, buildMatch.Name)
 }
+
+func TestBuildFailureClass_Validate(t *testing.T) {
+	for _, class := range BuildFailureClasses {
+		assert.NoError(t, class.Validate())
+	}
+	assert.Error(t, BuildFailureClass("Wrong").Validate())
+}
+
+func TestBuildRetryPolicyRetries(t *testing.T) {
+	var noPolicy *BuildRetryPolicy
+	assert.True(t, noPolicy.Retries(BuildFailureClassCompilation))
+	assert.True(t, (&BuildRetryPolicy{MaxAttempts: ptr.To(int32(3))}).Retries(BuildFailureClassCompilation))
+
+	policy := &BuildRetryPolicy{
+		RetryOn: []BuildFailureClass{BuildFailureClassNetwork},
+	}
+	assert.True(t, policy.Retries(BuildFailureClassNetwork))
+	assert.False(t, policy.Retries(BuildFailureClassCompilation))
+	assert.False(t, policy.Retries(BuildFailureClassUnknown))
+}
+
+func TestSBOMFormat_Validate(t *testing.T) {
+	for _, format := range SBOMFormats {
+		assert.NoError(t, format.Validate())
+	}
+	assert.Error(t, SBOMFormat("Wrong").Validate())
+}
+
+func TestVulnerabilitySeverity(t *testing.T) {
+	for _, severity := range VulnerabilitySeverities {
+		assert.NoError(t, severity.Validate())
+		assert.True(t, severity.AtLeast(VulnerabilitySeverityLow))
+		assert.True(t, severity.AtLeast(severity))
+	}
+	assert.Error(t, VulnerabilitySeverity("Moderate").Validate())
+	assert.True(t, VulnerabilitySeverityCritical.AtLeast(VulnerabilitySeverityHigh))
+	assert.False(t, VulnerabilitySeverityMedium.AtLeast(VulnerabilitySeverityHigh))
+}
@@ -48,12 +48,55 @@ type BuildSpec struct {
 	// and its phase set to BuildPhaseFailed.
 	// +kubebuilder:validation:Format=duration
 	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// The priority of the Build, used by the `priority` build order strategy.
+	// Builds with a higher priority are scheduled first (default 0).
+	Priority int32 `json:"priority,omitempty"`
+	// The policy used to retry the Build when it fails (default is to retry any failure up to 5 times).
+	RetryPolicy *BuildRetryPolicy `json:"retryPolicy,omitempty"`
 	// the maximum amount of parallel running builds started by this operator instance.
 	//
 	// Deprecated: no longer in use in Camel K 2 - maintained for backward compatibility
 	MaxRunningBuilds int32 `json:"maxRunningBuilds,omitempty"`
 }
 
+// BuildRetryPolicy defines how a failed Build is retried.
+type BuildRetryPolicy struct {
+	// the maximum number of attempts to recover a failed Build, `0` disabling the recovery (default 5)
+	// +kubebuilder:validation:Minimum=0
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// the delay before the first recovery attempt, doubled after each attempt (default 5s)
+	// +kubebuilder:validation:Format=duration
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// the maximum delay between two recovery attempts (default 5m)
+	// +kubebuilder:validation:Format=duration
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// the classes of failure which are retried (default any)
+	RetryOn []BuildFailureClass `json:"retryOn,omitempty"`
+}
+
+// BuildFailureClass classifies the cause of a Build failure.
+// +kubebuilder:validation:Enum=Network;Dependency;Compilation;Unknown
+type BuildFailureClass string
+
+const (
+	// BuildFailureClassNetwork is a failure to reach a remote repository or registry, likely transient.
+	BuildFailureClassNetwork BuildFailureClass = "Network"
+	// BuildFailureClassDependency is a failure to resolve the dependencies of the project.
+	BuildFailureClassDependency BuildFailureClass = "Dependency"
+	// BuildFailureClassCompilation is a failure to compile the sources of the project.
+	BuildFailureClassCompilation BuildFailureClass = "Compilation"
+	// BuildFailureClassUnknown is any other failure.
+	BuildFailureClassUnknown BuildFailureClass = "Unknown"
+)
+
+// BuildFailureClasses is the list of the failure classes.
+var BuildFailureClasses = []BuildFailureClass{
+	BuildFailureClassNetwork,
+	BuildFailureClassDependency,
+	BuildFailureClassCompilation,
+	BuildFailureClassUnknown,
+}
+
 // Task represents the abstract task. Only one of the task should be configured to represent the specific task chosen.
 type Task struct {
 	// Application building
@@ -88,6 +131,8 @@ type Task struct {
 	S2i *S2iTask `json:"s2i,omitempty"`
 	// a JibTask, for Jib strategy
 	Jib *JibTask `json:"jib,omitempty"`
+	// a BuildpacksTask, for Buildpacks strategy
+	Buildpacks *BuildpacksTask `json:"buildpacks,omitempty"`
 }
 
 // BaseTask is a base for the struct hierarchy.
@@ -118,6 +163,86 @@ type BuilderTask struct {
 	Sources []SourceSpec `json:"sources,omitempty"`
 	// the configuration of the project to build on Git
 	Git *GitConfigSpec `json:"git,omitempty"`
+	// the configuration of the Software Bill of Materials to generate
+	SBOM *SBOMSpec `json:"sbom,omitempty"`
+	// the configuration of the provenance attestation to generate
+	Provenance *ProvenanceSpec `json:"provenance,omitempty"`
+	// the configuration of the scan of the dependencies against a vulnerability database
+	VulnerabilityScan *VulnerabilityScanSpec `json:"vulnerabilityScan,omitempty"`
+}
+
+// VulnerabilityScanSpec defines how the dependencies are checked against an offline database of OSV vulnerability records.
+type VulnerabilityScanSpec struct {
+	// the ConfigMap holding the OSV vulnerability records, as JSON documents
+	ConfigMap string `json:"configMap,omitempty"`
+	// the PersistentVolumeClaim holding the OSV vulnerability records, as JSON files (requires the `pod` build strategy)
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// the lowest severity of the vulnerabilities failing the build, the other vulnerabilities are only reported
+	FailureThreshold VulnerabilitySeverity `json:"failureThreshold,omitempty"`
+}
+
+// VulnerabilitySeverity is the severity of a vulnerability.
+// +kubebuilder:validation:Enum=Low;Medium;High;Critical
+type VulnerabilitySeverity string
+
+const (
+	// VulnerabilitySeverityLow --.
+	VulnerabilitySeverityLow VulnerabilitySeverity = "Low"
+	// VulnerabilitySeverityMedium --.
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "Medium"
+	// VulnerabilitySeverityHigh --.
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "High"
+	// VulnerabilitySeverityCritical --.
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
+)
+
+// VulnerabilitySeverities is the list of the vulnerability severities, sorted by increasing severity.
+var VulnerabilitySeverities = []VulnerabilitySeverity{
+	VulnerabilitySeverityLow,
+	VulnerabilitySeverityMedium,
+	VulnerabilitySeverityHigh,
+	VulnerabilitySeverityCritical,
+}
+
+// Vulnerability is a vulnerability affecting a dependency.
+type Vulnerability struct {
+	// the identifier of the vulnerability, ie, the OSV record identifier
+	ID string `json:"id"`
+	// the vulnerable dependency, ie, `<groupId>:<artifactId>:<version>`
+	Dependency string `json:"dependency"`
+	// the severity of the vulnerability
+	Severity VulnerabilitySeverity `json:"severity,omitempty"`
+	// a short description of the vulnerability
+	Summary string `json:"summary,omitempty"`
+}
+
+// ProvenanceSpec defines the build parameters recorded by the SLSA provenance attestation.
+type ProvenanceSpec struct {
+	// the digest of the Integration the image is built for
+	IntegrationDigest string `json:"integrationDigest,omitempty"`
+}
+
+// SBOMSpec defines the Software Bill of Materials generated out of the project dependencies.
+type SBOMSpec struct {
+	// the format of the Software Bill of Materials
+	Format SBOMFormat `json:"format,omitempty"`
+}
+
+// SBOMFormat is the format of a Software Bill of Materials.
+// +kubebuilder:validation:Enum=CycloneDX;SPDX
+type SBOMFormat string
+
+const (
+	// SBOMFormatCycloneDX is the CycloneDX JSON format.
+	SBOMFormatCycloneDX SBOMFormat = "CycloneDX"
+	// SBOMFormatSPDX is the SPDX JSON format.
+	SBOMFormatSPDX SBOMFormat = "SPDX"
+)
+
+// SBOMFormats is the list of the supported Software Bill of Materials formats.
+var SBOMFormats = []SBOMFormat{
+	SBOMFormatCycloneDX,
+	SBOMFormatSPDX,
 }
 
 // GitConfigSpec defines the Git configuration of a project.
@@ -205,6 +330,38 @@ type KanikoTaskCache struct {
 type JibTask struct {
 	BaseTask    `json:",inline"`
 	PublishTask `json:",inline"`
+
+	// push the Software Bill of Materials generated by the package task as an OCI artifact next to the image
+	PushSBOM bool `json:"pushSBOM,omitempty"`
+	// the configuration used to sign the image
+	Signing *ImageSigningSpec `json:"signing,omitempty"`
+}
+
+// ImageSigningSpec defines how the image is signed once published.
+type ImageSigningSpec struct {
+	// the Secret holding the cosign private key (`cosign.key`) and its password (`cosign.password`)
+	Secret string `json:"secret,omitempty"`
+	// attach the SLSA provenance attestation generated by the package task, signed with the same key
+	Provenance bool `json:"provenance,omitempty"`
+}
+
+// ImageSignature reports the signature and the attestations pushed next to an image.
+type ImageSignature struct {
+	// the reference of the image signature
+	Signature string `json:"signature,omitempty"`
+	// the reference of the SLSA provenance attestation
+	Provenance string `json:"provenance,omitempty"`
+}
+
+// BuildpacksTask is used to configure Cloud Native Buildpacks.
+type BuildpacksTask struct {
+	BaseTask    `json:",inline"`
+	PublishTask `json:",inline"`
+
+	// the Cloud Native Buildpacks builder image providing the lifecycle and the buildpacks
+	BuilderImage string `json:"builderImage,omitempty"`
+	// the run image to use as a base for the application image (default, the one provided by the builder)
+	RunImage string `json:"runImage,omitempty"`
 }
 
 // SpectrumTask is used to configure Spectrum.
@@ -258,6 +415,10 @@ type BuildStatus struct {
 	BaseImage string `json:"baseImage,omitempty"`
 	// a list of artifacts contained in the build
 	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// the signature of the image (if signed)
+	Signature *ImageSignature `json:"signature,omitempty"`
+	// the vulnerabilities found in the dependencies (if scanned)
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
 	// the error description (if any)
 	Error string `json:"error,omitempty"`
 	// the reason of the failure (if any)
@@ -308,6 +469,20 @@ const (
 	BuildConditionReadyReason string = "Ready"
 	// BuildConditionWaitingReason --.
 	BuildConditionWaitingReason string = "Waiting"
+	// BuildConditionQuotaExceededReason --.
+	BuildConditionQuotaExceededReason string = "QuotaExceeded"
+	// BuildConditionVulnerabilityScan --.
+	BuildConditionVulnerabilityScan BuildConditionType = "VulnerabilityScan"
+	// BuildConditionVulnerabilityScanPassedReason --.
+	BuildConditionVulnerabilityScanPassedReason string = "VulnerabilityScanPassed"
+	// BuildConditionVulnerabilitiesFoundReason --.
+	BuildConditionVulnerabilitiesFoundReason string = "VulnerabilitiesFound"
+	// BuildConditionMavenCacheAvailable --.
+	BuildConditionMavenCacheAvailable BuildConditionType = "MavenCacheAvailable"
+	// BuildConditionMavenCacheAvailableReason --.
+	BuildConditionMavenCacheAvailableReason string = "MavenCacheAvailable"
+	// BuildConditionMavenCacheNotAvailableReason --.
+	BuildConditionMavenCacheNotAvailableReason string = "MavenCacheNotAvailable"
 )
 
 // +genclient
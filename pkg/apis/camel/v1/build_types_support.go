@@ -19,6 +19,8 @@ package v1
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -105,6 +107,9 @@ func ConfigurationTasksByName(tasks []Task, name string) *BuildConfiguration {
 		if t.Jib != nil && t.Jib.Name == name {
 			return &t.Jib.Configuration
 		}
+		if t.Buildpacks != nil && t.Buildpacks.Name == name {
+			return &t.Buildpacks.Configuration
+		}
 	}
 
 	return &BuildConfiguration{}
@@ -121,6 +126,49 @@ func SetBuilderConfigurationTasks(tasks []Task, conf *BuildConfiguration) {
 	}
 }
 
+// Validate checks the failure class is supported.
+func (c BuildFailureClass) Validate() error {
+	switch c {
+	case BuildFailureClassNetwork, BuildFailureClassDependency, BuildFailureClassCompilation, BuildFailureClassUnknown:
+		return nil
+	default:
+		return fmt.Errorf("invalid BuildFailureClass: %q", c)
+	}
+}
+
+// Validate checks the SBOM format is supported.
+func (f SBOMFormat) Validate() error {
+	switch f {
+	case SBOMFormatCycloneDX, SBOMFormatSPDX:
+		return nil
+	default:
+		return fmt.Errorf("invalid SBOMFormat: %q", f)
+	}
+}
+
+// Validate checks the vulnerability severity is supported.
+func (s VulnerabilitySeverity) Validate() error {
+	if !slices.Contains(VulnerabilitySeverities, s) {
+		return fmt.Errorf("invalid VulnerabilitySeverity: %q", s)
+	}
+
+	return nil
+}
+
+// AtLeast tells if the severity is equal to or higher than the given one.
+func (s VulnerabilitySeverity) AtLeast(severity VulnerabilitySeverity) bool {
+	return slices.Index(VulnerabilitySeverities, s) >= slices.Index(VulnerabilitySeverities, severity)
+}
+
+// Retries tells if a failure of the given class has to be retried, which is the case of any failure by default.
+func (in *BuildRetryPolicy) Retries(class BuildFailureClass) bool {
+	if in == nil || len(in.RetryOn) == 0 {
+		return true
+	}
+
+	return slices.Contains(in.RetryOn, class)
+}
+
 func (buildPhase *BuildPhase) String() string {
 	return string(*buildPhase)
 }
@@ -38,6 +38,8 @@ const (
 	//
 	// Deprecated: won't be supported in future releases.
 	IntegrationProfileNamespaceAnnotation = "camel.apache.org/integration-profile.namespace"
+	// IntegrationDigestAnnotation the digest of the Integration an IntegrationKit has been created for.
+	IntegrationDigestAnnotation = "camel.apache.org/integration.digest"
 	// IntegrationDontRunAfterBuildAnnotation -- .
 	IntegrationDontRunAfterBuildAnnotation = "camel.apache.org/dont-run-after-build"
 	// IntegrationDontRunAfterBuildAnnotationTrueValue -- .
@@ -97,6 +99,10 @@ const (
 	BuildOrderStrategyDependencies BuildOrderStrategy = "dependencies"
 	// BuildOrderStrategySequential runs builds strictly sequential so that only one single build per operator namespace is running at a time.
 	BuildOrderStrategySequential BuildOrderStrategy = "sequential"
+	// BuildOrderStrategyPriority runs builds ordered by their priority, so that higher priority builds are scheduled first.
+	// The priority of a waiting build is raised over time to prevent its starvation, and each namespace is granted a fair share
+	// of the maximum number of running builds when builds from other namespaces are waiting.
+	BuildOrderStrategyPriority BuildOrderStrategy = "priority"
 )
 
 // BuildStrategies is a list of strategies allowed for the build.
@@ -106,7 +112,7 @@ var BuildStrategies = []BuildStrategy{
 }
 
 // BuildOrderStrategy specifies how builds are reconciled and queued.
-// +kubebuilder:validation:Enum=dependencies;fifo;sequential
+// +kubebuilder:validation:Enum=dependencies;fifo;sequential;priority
 type BuildOrderStrategy string
 
 // BuildOrderStrategies is a list of order strategies allowed for the build.
@@ -114,6 +120,7 @@ var BuildOrderStrategies = []BuildOrderStrategy{
 	BuildOrderStrategyFIFO,
 	BuildOrderStrategyDependencies,
 	BuildOrderStrategySequential,
+	BuildOrderStrategyPriority,
 }
 
 // KameletRepositorySpec defines the location of the Kamelet catalog to use.
@@ -190,10 +197,14 @@ var AllTraitProfiles = []TraitProfile{TraitProfileKubernetes, TraitProfileKnativ
 type Traits struct {
 	// The configuration of Affinity trait
 	Affinity *trait.AffinityTrait `json:"affinity,omitempty" property:"affinity"`
+	// The configuration of Auto Rollback trait
+	AutoRollback *trait.AutoRollbackTrait `json:"auto-rollback,omitempty" property:"auto-rollback"`
 	// The configuration of Builder trait
 	Builder *trait.BuilderTrait `json:"builder,omitempty" property:"builder"`
 	// The configuration of Camel trait
 	Camel *trait.CamelTrait `json:"camel,omitempty" property:"camel"`
+	// The configuration of Canary trait
+	Canary *trait.CanaryTrait `json:"canary,omitempty" property:"canary"`
 	// The configuration of Container trait
 	Container *trait.ContainerTrait `json:"container,omitempty" property:"container"`
 	// The configuration of Cron trait
@@ -218,6 +229,8 @@ type Traits struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty" property:"gitops"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty" property:"health"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty" property:"hpa"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty" property:"ingress"`
 	// The configuration of Init Containers trait
@@ -238,12 +251,16 @@ type Traits struct {
 	Knative *trait.KnativeTrait `json:"knative,omitempty" property:"knative"`
 	// The configuration of Knative Service trait
 	KnativeService *trait.KnativeServiceTrait `json:"knative-service,omitempty" property:"knative-service"`
+	// The configuration of Log Shipping trait
+	LogShipping *trait.LogShippingTrait `json:"log-shipping,omitempty" property:"log-shipping"`
 	// The configuration of Logging trait
 	Logging *trait.LoggingTrait `json:"logging,omitempty" property:"logging"`
 	// The configuration of Master trait
 	Master *trait.MasterTrait `json:"master,omitempty" property:"master"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty" property:"mount"`
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty" property:"network-policy"`
 	// The configuration of OpenAPI trait.
 	//
 	// Deprecated: no longer in use.
@@ -280,10 +297,14 @@ type Traits struct {
 	//
 	// Deprecated: no longer in use.
 	ServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty" property:"service-binding"`
+	// The configuration of StatefulSet trait
+	StatefulSet *trait.StatefulSetTrait `json:"stateful-set,omitempty" property:"stateful-set"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty" property:"telemetry"`
 	// The configuration of Toleration trait
 	Toleration *trait.TolerationTrait `json:"toleration,omitempty" property:"toleration"`
+	// The configuration of VPA trait
+	VPA *trait.VPATrait `json:"vpa,omitempty" property:"vpa"`
 
 	// Deprecated: no longer in use.
 	Addons map[string]AddonTrait `json:"addons,omitempty"`
@@ -405,6 +426,10 @@ type CamelProperty struct {
 const (
 	// ServiceTypeUser service user type label marker.
 	ServiceTypeUser = "user"
+	// ServiceTypeHeadless service headless type label marker.
+	ServiceTypeHeadless = "headless"
+	// ServiceTypeCanary service canary type label marker.
+	ServiceTypeCanary = "canary"
 
 	// CapabilityAzureKeyVault defines the azure key vault capability.
 	CapabilityAzureKeyVault = "azure-key-vault"
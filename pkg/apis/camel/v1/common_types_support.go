@@ -292,7 +292,7 @@ func (b BuildStrategy) Validate() error {
 // Validate checks if the strategy is supported.
 func (b BuildOrderStrategy) Validate() error {
 	switch b {
-	case BuildOrderStrategyDependencies, BuildOrderStrategyFIFO, BuildOrderStrategySequential:
+	case BuildOrderStrategyDependencies, BuildOrderStrategyFIFO, BuildOrderStrategySequential, BuildOrderStrategyPriority:
 		return nil
 	default:
 		return fmt.Errorf("invalid BuildStrategy: %q", b)
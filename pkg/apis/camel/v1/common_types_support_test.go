@@ -224,6 +224,7 @@ func TestBuildOrderStrategy_Validate(t *testing.T) {
 		{"valid dependencies", BuildOrderStrategyDependencies, false},
 		{"valid fifo", BuildOrderStrategyFIFO, false},
 		{"valid sequential", BuildOrderStrategySequential, false},
+		{"valid priority", BuildOrderStrategyPriority, false},
 		{"invalid strategy", BuildOrderStrategy("wrong"), true},
 		{"empty strategy", BuildOrderStrategy(""), true},
 	}
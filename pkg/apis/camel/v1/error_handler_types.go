@@ -27,6 +27,20 @@ type ErrorHandlerParameters struct {
 	RawMessage `json:",inline,omitempty"`
 }
 
+// ErrorHandlerRedeliveryPolicy represents the policy used to redeliver a failing event before it is handled by the error handler.
+type ErrorHandlerRedeliveryPolicy struct {
+	// The maximum number of redelivery attempts, -1 to redeliver forever (default 0)
+	MaximumRedeliveries *int32 `json:"maximumRedeliveries,omitempty"`
+	// The delay in milliseconds before the first redelivery attempt (default 1000)
+	RedeliveryDelay *int64 `json:"redeliveryDelay,omitempty"`
+	// The multiplier applied to the delay between each redelivery attempt, enabling an exponential backoff when greater than 1
+	BackOffMultiplier *float64 `json:"backOffMultiplier,omitempty"`
+	// The maximum delay in milliseconds between redelivery attempts when using a backoff multiplier (default 60000)
+	MaximumRedeliveryDelay *int64 `json:"maximumRedeliveryDelay,omitempty"`
+	// The fully qualified class names of the exceptions to redeliver, all exceptions are redelivered when empty
+	RetryOn []string `json:"retryOn,omitempty"`
+}
+
 // BeanProperties represent an unstructured object properties to be set on a bean.
 type BeanProperties struct {
 	RawMessage `json:",inline,omitempty"`
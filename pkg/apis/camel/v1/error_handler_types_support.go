@@ -76,6 +76,10 @@ type ErrorHandlerLog struct {
 	ErrorHandlerNone
 
 	Parameters *ErrorHandlerParameters `json:"parameters,omitempty"`
+	// The policy used to redeliver a failing event before it is handled
+	RedeliveryPolicy *ErrorHandlerRedeliveryPolicy `json:"redeliveryPolicy,omitempty"`
+	// Handle the original message, as it was before the failing event was processed, rather than the current message
+	UseOriginalMessage *bool `json:"useOriginalMessage,omitempty"`
 }
 
 // Type --.
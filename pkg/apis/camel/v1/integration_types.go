@@ -40,6 +40,8 @@ import (
 // +kubebuilder:printcolumn:name="Catalog Version",type=string,JSONPath=`.status.catalog.version`,description="The catalog version"
 // +kubebuilder:printcolumn:name="Kit",type=string,JSONPath=`.status.integrationKit.name`,description="The integration kit"
 // +kubebuilder:printcolumn:name="Replicas",type=integer,JSONPath=`.status.replicas`,description="The number of pods"
+// +kubebuilder:printcolumn:name="Recommended CPU",type=string,JSONPath=`.status.resourcesRecommendation.target.cpu`,description="The CPU recommended for the integration container",priority=1
+// +kubebuilder:printcolumn:name="Recommended Memory",type=string,JSONPath=`.status.resourcesRecommendation.target.memory`,description="The memory recommended for the integration container",priority=1
 
 // Integration is the Schema for the integrations API.
 type Integration struct {
@@ -139,8 +141,63 @@ type IntegrationStatus struct {
 	DeploymentTimestamp *metav1.Time `json:"lastDeploymentTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was built.
 	BuildTimestamp *metav1.Time `json:"lastBuildTimestamp,omitempty"`
+	// the progress of the canary rollout, when the canary trait is enabled
+	Canary *IntegrationCanaryStatus `json:"canary,omitempty"`
+	// the last revision of the Integration which was ready, when the auto-rollback trait is enabled
+	LastHealthyRevision *IntegrationHealthyRevision `json:"lastHealthyRevision,omitempty"`
+	// the resources recommended for the Integration container, when the vpa trait is enabled
+	ResourcesRecommendation *IntegrationResourcesRecommendation `json:"resourcesRecommendation,omitempty"`
 }
 
+// IntegrationResourcesRecommendation is the resources recommended for the Integration container by the VerticalPodAutoscaler.
+type IntegrationResourcesRecommendation struct {
+	// the recommended resources
+	Target corev1.ResourceList `json:"target,omitempty"`
+	// the minimum recommended resources
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// the maximum recommended resources
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// IntegrationHealthyRevision is a revision of an Integration which was ready.
+type IntegrationHealthyRevision struct {
+	// the digest of the Integration revision
+	Digest string `json:"digest,omitempty"`
+	// the reference of the `IntegrationKit` used by the Integration revision
+	IntegrationKit *corev1.ObjectReference `json:"integrationKit,omitempty"`
+	// the container image used by the Integration revision
+	Image string `json:"image,omitempty"`
+	// the timestamp representing the last time when the Integration revision was ready
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+}
+
+// IntegrationCanaryStatus reports the progress of the canary rollout of an Integration revision.
+type IntegrationCanaryStatus struct {
+	// the phase of the canary rollout
+	Phase IntegrationCanaryPhase `json:"phase,omitempty"`
+	// the Integration digest the canary is rolling out
+	Revision string `json:"revision,omitempty"`
+	// the container image run by the stable pods
+	StableImage string `json:"stableImage,omitempty"`
+	// the container image run by the canary pods
+	CanaryImage string `json:"canaryImage,omitempty"`
+	// the index of the current rollout step
+	Step int32 `json:"step,omitempty"`
+	// the percentage of the traffic routed to the canary pods
+	Weight int32 `json:"weight,omitempty"`
+	// the number of desired canary pods
+	Replicas int32 `json:"replicas,omitempty"`
+	// the number of ready canary pods
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// the last time the canary moved to its current step or phase
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// a human-readable message indicating details about the rollout
+	Message string `json:"message,omitempty"`
+}
+
+// IntegrationCanaryPhase is the phase of a canary rollout.
+type IntegrationCanaryPhase string
+
 // +kubebuilder:object:root=true
 
 // IntegrationList contains a list of Integration.
@@ -188,6 +245,15 @@ const (
 	// Deprecated: no longer in use.
 	IntegrationPhaseUnknown IntegrationPhase = "Unknown"
 
+	// IntegrationCanaryPhaseProgressing --.
+	IntegrationCanaryPhaseProgressing IntegrationCanaryPhase = "Progressing"
+	// IntegrationCanaryPhaseFailed --.
+	IntegrationCanaryPhaseFailed IntegrationCanaryPhase = "Failed"
+	// IntegrationCanaryPhasePromoted --.
+	IntegrationCanaryPhasePromoted IntegrationCanaryPhase = "Promoted"
+	// IntegrationCanaryPhaseRolledBack --.
+	IntegrationCanaryPhaseRolledBack IntegrationCanaryPhase = "RolledBack"
+
 	// IntegrationConditionReady --.
 	IntegrationConditionReady IntegrationConditionType = "Ready"
 	// IntegrationConditionKitAvailable --.
@@ -196,6 +262,8 @@ const (
 	IntegrationConditionPlatformAvailable IntegrationConditionType = "IntegrationPlatformAvailable"
 	// IntegrationConditionDeploymentAvailable --.
 	IntegrationConditionDeploymentAvailable IntegrationConditionType = "DeploymentAvailable"
+	// IntegrationConditionStatefulSetAvailable --.
+	IntegrationConditionStatefulSetAvailable IntegrationConditionType = "StatefulSetAvailable"
 	// IntegrationConditionServiceAvailable --.
 	IntegrationConditionServiceAvailable IntegrationConditionType = "ServiceAvailable"
 	// IntegrationConditionKnativeServiceAvailable --.
@@ -214,6 +282,10 @@ const (
 	IntegrationConditionProbesAvailable IntegrationConditionType = "ProbesAvailable"
 	// IntegrationConditionTraitInfo --.
 	IntegrationConditionTraitInfo IntegrationConditionType = "TraitInfo"
+	// IntegrationConditionRolledBack --.
+	IntegrationConditionRolledBack IntegrationConditionType = "RolledBack"
+	// IntegrationConditionExternalSecretsAvailable --.
+	IntegrationConditionExternalSecretsAvailable IntegrationConditionType = "ExternalSecretsAvailable"
 
 	// IntegrationConditionKitAvailableReason --.
 	IntegrationConditionKitAvailableReason string = "IntegrationKitAvailable"
@@ -223,6 +295,10 @@ const (
 	IntegrationConditionDeploymentAvailableReason string = "DeploymentAvailable"
 	// IntegrationConditionDeploymentNotAvailableReason --.
 	IntegrationConditionDeploymentNotAvailableReason string = "DeploymentNotAvailable"
+	// IntegrationConditionStatefulSetAvailableReason --.
+	IntegrationConditionStatefulSetAvailableReason string = "StatefulSetAvailable"
+	// IntegrationConditionStatefulSetNotAvailableReason --.
+	IntegrationConditionStatefulSetNotAvailableReason string = "StatefulSetNotAvailable"
 	// IntegrationConditionServiceAvailableReason --.
 	IntegrationConditionServiceAvailableReason string = "ServiceAvailable"
 	// IntegrationConditionServiceNotAvailableReason --.
@@ -261,6 +337,10 @@ const (
 	IntegrationConditionDeploymentReadyReason string = "DeploymentReady"
 	// IntegrationConditionDeploymentProgressingReason --.
 	IntegrationConditionDeploymentProgressingReason string = "DeploymentProgressing"
+	// IntegrationConditionStatefulSetReadyReason --.
+	IntegrationConditionStatefulSetReadyReason string = "StatefulSetReady"
+	// IntegrationConditionStatefulSetProgressingReason --.
+	IntegrationConditionStatefulSetProgressingReason string = "StatefulSetProgressing"
 	// IntegrationConditionCronJobCreatedReason --.
 	IntegrationConditionCronJobCreatedReason string = "CronJobCreated"
 	// IntegrationConditionCronJobActiveReason --.
@@ -285,6 +365,12 @@ const (
 	IntegrationConditionKameletsNotAvailableReason string = "KameletsNotAvailable"
 	// IntegrationConditionImportingKindAvailableReason used (as false) if we're trying to import an unsupported kind.
 	IntegrationConditionImportingKindAvailableReason string = "ImportingKindAvailable"
+	// IntegrationConditionAutoRollbackReason --.
+	IntegrationConditionAutoRollbackReason string = "AutoRollback"
+	// IntegrationConditionAutoRollbackImpossibleReason --.
+	IntegrationConditionAutoRollbackImpossibleReason string = "AutoRollbackImpossible"
+	// IntegrationConditionExternalSecretsNotAvailableReason --.
+	IntegrationConditionExternalSecretsNotAvailableReason string = "ExternalSecretsNotAvailable"
 )
 
 // IntegrationCondition describes the state of a resource at a certain point.
@@ -43,6 +43,22 @@ const (
 	IntegrationImportedKindLabel = "camel.apache.org/imported-from-kind"
 	// IntegrationImportedNameLabel specifies from what resource an Integration was imported.
 	IntegrationImportedNameLabel = "camel.apache.org/imported-from-name"
+	// IntegrationCanaryTrackLabel is used to tell the stable pods from the canary pods of an Integration.
+	IntegrationCanaryTrackLabel = "camel.apache.org/canary.track"
+	// IntegrationCanaryTrackStable is the track of the pods running the stable revision of an Integration.
+	IntegrationCanaryTrackStable = "stable"
+	// IntegrationCanaryTrackCanary is the track of the pods running the canary revision of an Integration.
+	IntegrationCanaryTrackCanary = "canary"
+	// IntegrationCanaryRevisionAnnotation is used to track the Integration revision the pods are running.
+	IntegrationCanaryRevisionAnnotation = "camel.apache.org/canary.revision"
+	// IntegrationRevisionLabel is used to tag the ConfigMaps storing the revision history of an Integration.
+	IntegrationRevisionLabel = "camel.apache.org/integration.revision"
+	// IntegrationRevisionOfLabel is used to tag the ConfigMaps storing the revision history of an Integration with
+	// its name. The IntegrationLabel is not used, so that the history is not garbage collected when the Integration
+	// is undeployed.
+	IntegrationRevisionOfLabel = "camel.apache.org/revision-of"
+	// IntegrationRevisionHistoryLimitAnnotation is used to set the number of revisions kept in the history of an Integration.
+	IntegrationRevisionHistoryLimitAnnotation = "camel.apache.org/revision-history-limit"
 
 	// IntegrationFlowEmbeddedSourceName --.
 	IntegrationFlowEmbeddedSourceName = "camel-k-embedded-flow.yaml"
@@ -78,6 +94,8 @@ func (in *Integration) Initialize() {
 	in.Status = IntegrationStatus{
 		Phase:   IntegrationPhaseInitialization,
 		Profile: profile,
+		// Keep track of the last healthy revision, so that a new revision can be rolled back
+		LastHealthyRevision: in.Status.LastHealthyRevision,
 	}
 }
 
@@ -362,6 +380,19 @@ func (in *Integration) IsSynthetic() bool {
 	return in.Annotations[IntegrationSyntheticLabel] == "true"
 }
 
+// IsCanaryInProgress returns true when the canary pods of an Integration revision are deployed alongside the stable ones.
+func (in *Integration) IsCanaryInProgress() bool {
+	return in.Status.Canary != nil &&
+		(in.Status.Canary.Phase == IntegrationCanaryPhaseProgressing || in.Status.Canary.Phase == IntegrationCanaryPhaseFailed)
+}
+
+// IsRolledBack returns true when the Integration runs its last healthy revision, after the current one failed.
+func (in *Integration) IsRolledBack() bool {
+	cond := in.Status.GetCondition(IntegrationConditionRolledBack)
+
+	return cond != nil && cond.Status == corev1.ConditionTrue
+}
+
 // SetBuildCompletePhase set the proper building phase and the related timestamps.
 func (in *Integration) SetBuildCompletePhase() {
 	now := metav1.Now().Rfc3339Copy()
@@ -113,6 +113,10 @@ type IntegrationKitStatus struct {
 	Digest string `json:"digest,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// the signature of the kit image (if signed)
+	Signature *ImageSignature `json:"signature,omitempty"`
+	// the vulnerabilities found in the kit dependencies (if scanned)
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
 	// failure reason (if any)
 	Failure *Failure `json:"failure,omitempty"`
 	// the runtime version for which this kit was configured
@@ -203,6 +207,8 @@ const (
 	IntegrationKitConditionPlatformAvailableReason string = "IntegrationPlatformAvailable"
 	// IntegrationKitConditionTraitInfo --.
 	IntegrationKitConditionTraitInfo IntegrationKitConditionType = "TraitInfo"
+	// IntegrationKitConditionVulnerabilityScan --.
+	IntegrationKitConditionVulnerabilityScan IntegrationKitConditionType = "VulnerabilityScan"
 )
 
 // IntegrationKitCondition describes the state of a resource at a certain point.
@@ -212,6 +212,10 @@ func (in *IntegrationKitStatus) GetConditions() []ResourceCondition {
 func (in *IntegrationKitStatus) GetDependenciesPaths() *sets.Set {
 	s := sets.NewSet()
 	for _, dep := range in.Artifacts {
+		// Artifacts that are not part of the image, like the SBOM, have no target
+		if dep.Target == "" {
+			continue
+		}
 		path := filepath.Dir(dep.Target)
 		// Solves https://github.com/apache/camel-k/issues/6498
 		if !strings.HasSuffix(path, "/quarkus") {
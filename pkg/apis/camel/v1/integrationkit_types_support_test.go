@@ -35,6 +35,7 @@ func TestGetKitDependenciesDirectories(t *testing.T) {
 				{Target: "my-dir1/lib2/mytest5.jar"},
 				{Target: "my-dir/mytest6.jar"},
 				{Target: "my-dir/mytest7.jar"},
+				{ID: "bom.cdx.json"},
 			},
 			Phase: IntegrationKitPhaseReady,
 		},
@@ -150,6 +150,18 @@ type IntegrationPlatformBuildSpec struct {
 	PublishStrategyOptions map[string]string `json:"PublishStrategyOptions,omitempty"`
 	// the maximum amount of parallel running pipelines started by this operator instance
 	MaxRunningBuilds int32 `json:"maxRunningBuilds,omitempty"`
+	// the quota limiting the amount of parallel running builds for each namespace
+	Quota *BuildQuotaSpec `json:"quota,omitempty"`
+}
+
+// BuildQuotaSpec defines the limits on the amount of parallel running builds, so that a single namespace
+// cannot take all the build capacity of the operator.
+type BuildQuotaSpec struct {
+	// the maximum amount of parallel running builds for each namespace (no limit if not set)
+	MaxRunningBuildsPerNamespace int32 `json:"maxRunningBuildsPerNamespace,omitempty"`
+	// the maximum amount of parallel running builds for the given namespaces, overriding `maxRunningBuildsPerNamespace`
+	// (a value of 0 removes the limit for the namespace)
+	Namespaces map[string]int32 `json:"namespaces,omitempty"`
 }
 
 // IntegrationPlatformKameletSpec define the behavior for all the Kamelets controller by the IntegrationPlatform.
@@ -173,12 +185,17 @@ const (
 	// IntegrationPlatformBuildPublishStrategyJib uses Jib maven plugin (https://github.com/GoogleContainerTools/jib)
 	// in order to push the incremental images to the image repository.
 	IntegrationPlatformBuildPublishStrategyJib IntegrationPlatformBuildPublishStrategy = "Jib"
+	// IntegrationPlatformBuildPublishStrategyBuildpacks uses Cloud Native Buildpacks (https://buildpacks.io)
+	// in order to build the Maven project and push the resulting image to the image repository.
+	// It requires the `pod` build strategy.
+	IntegrationPlatformBuildPublishStrategyBuildpacks IntegrationPlatformBuildPublishStrategy = "Buildpacks"
 )
 
 // IntegrationPlatformBuildPublishStrategies the list of all available publish strategies.
 var IntegrationPlatformBuildPublishStrategies = []IntegrationPlatformBuildPublishStrategy{
 	IntegrationPlatformBuildPublishStrategyS2I,
 	IntegrationPlatformBuildPublishStrategyJib,
+	IntegrationPlatformBuildPublishStrategyBuildpacks,
 }
 
 // IntegrationPlatformPhase is the phase of an IntegrationPlatform.
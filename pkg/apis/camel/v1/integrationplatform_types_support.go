@@ -229,9 +229,24 @@ func (c *IntegrationPlatformCondition) GetMessage() string {
 // Validate checks the strategy is supported.
 func (b IntegrationPlatformBuildPublishStrategy) Validate() error {
 	switch b {
-	case IntegrationPlatformBuildPublishStrategyS2I, IntegrationPlatformBuildPublishStrategyJib:
+	case IntegrationPlatformBuildPublishStrategyS2I, IntegrationPlatformBuildPublishStrategyJib, IntegrationPlatformBuildPublishStrategyBuildpacks:
 		return nil
 	default:
 		return fmt.Errorf("invalid IntegrationPlatformBuildPublishStrategy: %q", b)
 	}
 }
+
+// MaxRunningBuildsIn returns the maximum amount of parallel running builds allowed in the given namespace,
+// or 0 if the quota does not set any limit. A namespace override lower than or equal to 0 removes the limit
+// for that namespace.
+func (q *BuildQuotaSpec) MaxRunningBuildsIn(namespace string) int32 {
+	if q == nil {
+		return 0
+	}
+	limit, ok := q.Namespaces[namespace]
+	if !ok {
+		limit = q.MaxRunningBuildsPerNamespace
+	}
+
+	return max(limit, 0)
+}
@@ -31,6 +31,7 @@ func TestIntegrationPlatformBuildPublishStrategy_Validate(t *testing.T) {
 	}{
 		{"valid S2I", IntegrationPlatformBuildPublishStrategyS2I, false},
 		{"valid Jib", IntegrationPlatformBuildPublishStrategyJib, false},
+		{"valid Buildpacks", IntegrationPlatformBuildPublishStrategyBuildpacks, false},
 		{"invalid strategy", IntegrationPlatformBuildPublishStrategy("wrong"), true},
 		{"empty strategy", IntegrationPlatformBuildPublishStrategy(""), true},
 	}
@@ -46,3 +47,22 @@ func TestIntegrationPlatformBuildPublishStrategy_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildQuotaSpec_MaxRunningBuildsIn(t *testing.T) {
+	var noQuota *BuildQuotaSpec
+	assert.Equal(t, int32(0), noQuota.MaxRunningBuildsIn("ns"))
+
+	quota := &BuildQuotaSpec{
+		MaxRunningBuildsPerNamespace: 2,
+		Namespaces: map[string]int32{
+			"prod": 5,
+			"ci":   0,
+			"tmp":  -1,
+		},
+	}
+	assert.Equal(t, int32(2), quota.MaxRunningBuildsIn("dev"))
+	assert.Equal(t, int32(5), quota.MaxRunningBuildsIn("prod"))
+	assert.Equal(t, int32(0), quota.MaxRunningBuildsIn("ci"))
+	assert.Equal(t, int32(0), quota.MaxRunningBuildsIn("tmp"))
+	assert.Equal(t, int32(0), (&BuildQuotaSpec{}).MaxRunningBuildsIn("dev"))
+}
@@ -94,6 +94,9 @@ type IntegrationProfileBuildSpec struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Maven configuration used to build the Camel/Camel-Quarkus applications
 	Maven MavenSpec `json:"maven,omitempty"`
+	// the quota limiting the amount of parallel running builds for each namespace,
+	// overriding the one of the IntegrationPlatform for the builds using this profile
+	Quota *BuildQuotaSpec `json:"quota,omitempty"`
 }
 
 // IntegrationProfileKameletSpec define the behavior for all the Kamelets controller by the IntegrationProfile.
@@ -52,6 +52,51 @@ type MavenSpec struct {
 	// e.g., `-V,--no-transfer-progress,-Dstyle.color=never`.
 	// See https://maven.apache.org/ref/3.9.14/maven-embedder/cli.html.
 	CLIOptions []string `json:"cliOptions,omitempty"`
+	// The Maven local repository shared across the builder Pods.
+	// Only used for `pod` strategy.
+	Cache *MavenCacheSpec `json:"cache,omitempty"`
+}
+
+// MavenCacheSpec defines a Maven local repository, persisted in a PersistentVolumeClaim,
+// that is shared across the builder Pods to avoid downloading the same artifacts on each build.
+type MavenCacheSpec struct {
+	// Enable the shared Maven local repository.
+	Enabled bool `json:"enabled,omitempty"`
+	// The name of the PersistentVolumeClaim holding the Maven local repository (default `camel-k-maven-repository`).
+	// It is created in the builder Pod namespace when it does not exist.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// The StorageClass used to provision the PersistentVolumeClaim (default is the cluster default StorageClass).
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// The access mode used to provision the PersistentVolumeClaim (default `ReadWriteMany`).
+	// Mind that with `ReadWriteOnce` a builder Pod scheduled on a node other than the one the volume is attached to
+	// fails to start with a Multi-Attach error, until the Build times out.
+	AccessMode corev1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+	// The size used to provision the PersistentVolumeClaim (default `10Gi`).
+	Size string `json:"size,omitempty"`
+	// The size the Maven local repository is allowed to grow up to before being evicted (default 80% of the size).
+	MaxSize string `json:"maxSize,omitempty"`
+	// The policy used to evict the Maven local repository once it exceeds its maximum size (default `None`).
+	EvictionPolicy MavenCacheEvictionPolicy `json:"evictionPolicy,omitempty"`
+}
+
+// MavenCacheEvictionPolicy defines how the shared Maven local repository is evicted once it exceeds its maximum size.
+// +kubebuilder:validation:Enum=None;OldestFirst;Purge
+type MavenCacheEvictionPolicy string
+
+const (
+	// MavenCacheEvictionPolicyNone never evicts the Maven local repository.
+	MavenCacheEvictionPolicyNone MavenCacheEvictionPolicy = "None"
+	// MavenCacheEvictionPolicyOldestFirst removes the artifacts which were stored first, until the Maven local repository fits its maximum size.
+	MavenCacheEvictionPolicyOldestFirst MavenCacheEvictionPolicy = "OldestFirst"
+	// MavenCacheEvictionPolicyPurge removes the whole content of the Maven local repository.
+	MavenCacheEvictionPolicyPurge MavenCacheEvictionPolicy = "Purge"
+)
+
+// MavenCacheEvictionPolicies is the list of all available eviction policies.
+var MavenCacheEvictionPolicies = []MavenCacheEvictionPolicy{
+	MavenCacheEvictionPolicyNone,
+	MavenCacheEvictionPolicyOldestFirst,
+	MavenCacheEvictionPolicyPurge,
 }
 
 // Repository defines a Maven repository.
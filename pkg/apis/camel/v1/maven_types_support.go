@@ -19,6 +19,7 @@ package v1
 
 import (
 	"encoding/xml"
+	"fmt"
 )
 
 //nolint:nestif
@@ -42,6 +43,16 @@ func (in *MavenArtifact) GetDependencyID() string {
 	return mvn
 }
 
+// Validate checks if the eviction policy is supported.
+func (p MavenCacheEvictionPolicy) Validate() error {
+	switch p {
+	case MavenCacheEvictionPolicyNone, MavenCacheEvictionPolicyOldestFirst, MavenCacheEvictionPolicyPurge:
+		return nil
+	default:
+		return fmt.Errorf("invalid MavenCacheEvictionPolicy: %q", p)
+	}
+}
+
 type propertiesEntry struct {
 	// the name of the xml is dynamic, hence no tags are configured for the field
 	XMLName xml.Name
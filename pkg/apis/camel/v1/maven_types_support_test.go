@@ -203,3 +203,11 @@ func TestArtifactToString(t *testing.T) {
 	}
 	assert.Equal(t, "mvn:org.mygroup:my-artifact:jar", a8.GetDependencyID())
 }
+
+func TestMavenCacheEvictionPolicyValidate(t *testing.T) {
+	for _, p := range MavenCacheEvictionPolicies {
+		require.NoError(t, p.Validate())
+	}
+	require.Error(t, MavenCacheEvictionPolicy("LeastFrequentlyUsed").Validate())
+	require.Error(t, MavenCacheEvictionPolicy("").Validate())
+}
@@ -52,6 +52,10 @@ type PipeSpec struct {
 	Source Endpoint `json:"source,omitempty"`
 	// Sink is the destination of the integration defined by this Pipe
 	Sink Endpoint `json:"sink,omitempty"`
+	// Sinks is an optional list of destinations the events are routed to according to the Routing strategy, in place of the Sink
+	Sinks []PipeSink `json:"sinks,omitempty"`
+	// Routing is the strategy used to route the events to the Sinks, either broadcast (default) or content-based
+	Routing PipeRouting `json:"routing,omitempty"`
 	// ErrorHandler is an optional handler called upon an error occurring in the integration
 	ErrorHandler *ErrorHandlerSpec `json:"errorHandler,omitempty"`
 	// the traits needed to customize the depending Integration
@@ -79,6 +83,27 @@ type Endpoint struct {
 	DataTypes map[TypeSlot]DataTypeReference `json:"dataTypes,omitempty"`
 }
 
+// PipeSink represents one of the destinations of a Pipe routing the events to several sinks.
+type PipeSink struct {
+	Endpoint `json:",inline"`
+	// Filter is an expression selecting the events sent to this sink. A sink with no filter receives all the events
+	// with the broadcast routing, or the events not matching any other sink with the content-based routing
+	Filter string `json:"filter,omitempty"`
+	// FilterLanguage is the Camel language of the Filter expression (default simple)
+	FilterLanguage string `json:"filterLanguage,omitempty"`
+}
+
+// PipeRouting is the strategy used to route the events to the sinks of a Pipe.
+// +kubebuilder:validation:Enum=broadcast;content-based
+type PipeRouting string
+
+const (
+	// PipeRoutingBroadcast sends each event to all the sinks which filter matches.
+	PipeRoutingBroadcast PipeRouting = "broadcast"
+	// PipeRoutingContentBased sends each event to the first sink which filter matches.
+	PipeRoutingContentBased PipeRouting = "content-based"
+)
+
 // EndpointType represents the type (ie, source or sink).
 type EndpointType string
 
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Auto Rollback trait redeploys the last healthy revision of an Integration when a new revision keeps failing.
+//
+// When the trait is enabled, the operator records the IntegrationKit and the container image of the Integration
+// in its status, each time a new revision becomes ready. If a later revision goes in error, e.g., because its pods
+// keep crashing, for longer than `failure-window-seconds`, the operator restores the specification of the last healthy
+// revision from the revision history of the Integration, deploys its IntegrationKit again, reports it with the
+// `RolledBack` condition and emits a warning event.
+//
+// The Integration stays on the last healthy revision until it changes again.
+//
+// NOTE: when the last healthy revision is no longer in the revision history, or the Integration is managed by a Pipe,
+// only the IntegrationKit and the container image are rolled back, provided they differ from the failing ones.
+// Otherwise, the `RolledBack` condition reports the Integration cannot be rolled back.
+//
+// +camel-k:trait=auto-rollback.
+//
+//nolint:godoclint
+type AutoRollbackTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The time in seconds an Integration revision has to be in error before it is rolled back (default `120`).
+	FailureWindowSeconds *int32 `json:"failureWindowSeconds,omitempty" property:"failure-window-seconds"`
+}
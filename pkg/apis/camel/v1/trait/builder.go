@@ -40,9 +40,12 @@ type BuilderTrait struct {
 	BaseImage string `json:"baseImage,omitempty" property:"base-image"`
 	// Use the incremental image build option, to reuse existing containers (default `true`)
 	IncrementalImageBuild *bool `json:"incrementalImageBuild,omitempty" property:"incremental-image-build"`
-	// The build order strategy to use, either `dependencies`, `fifo` or `sequential` (default is the platform default)
-	// +kubebuilder:validation:Enum=dependencies;fifo;sequential
+	// The build order strategy to use, either `dependencies`, `fifo`, `sequential` or `priority` (default is the platform default)
+	// +kubebuilder:validation:Enum=dependencies;fifo;sequential;priority
 	OrderStrategy string `json:"orderStrategy,omitempty" property:"order-strategy"`
+	// The priority of the build, used by the `priority` build order strategy.
+	// Builds with a higher priority are scheduled first (default `0`).
+	Priority *int32 `json:"priority,omitempty" property:"priority"`
 	// When using `pod` strategy, the minimum amount of CPU required by the pod builder.
 	//
 	// Deprecated: use TasksRequestCPU instead with task name `builder`.
@@ -67,7 +70,7 @@ type BuilderTrait struct {
 	// A list of tasks to be executed (available only when using `pod` strategy) with format `<name>;<container-image>;<container-command>`.
 	Tasks []string `json:"tasks,omitempty" property:"tasks"`
 	// A list of tasks sorted by the order of execution in a csv format, ie, `<taskName1>,<taskName2>,...`.
-	// Mind that you must include also the operator tasks (`builder`, `quarkus-native`, `package`, `jib`, `s2i`)
+	// Mind that you must include also the operator tasks (`builder`, `quarkus-native`, `package`, `jib`, `s2i`, `buildpacks`)
 	// if you need to execute them. Useful only with `pod` strategy.
 	TasksFilter string `json:"tasksFilter,omitempty" property:"tasks-filter"`
 	// A list of request cpu configuration for the specific task with format `<task-name>:<request-cpu-conf>`.
@@ -84,4 +87,66 @@ type BuilderTrait struct {
 	Annotations map[string]string `json:"annotations,omitempty" property:"annotations"`
 	// The list of manifest platforms to use to build a container image (default `linux/amd64`).
 	ImagePlatforms []string `json:"platforms,omitempty" property:"platforms"`
+	// The strategy to use to publish the container image, either `Jib`, `S2I` or `Buildpacks` (default is the platform default).
+	// The `S2I` strategy is only available on OpenShift. The `Buildpacks` strategy requires the `pod` build strategy,
+	// which is set automatically.
+	// +kubebuilder:validation:Enum=Jib;S2I;Buildpacks
+	PublishStrategy string `json:"publishStrategy,omitempty" property:"publish-strategy"`
+	// When using `Buildpacks` publish strategy, the Cloud Native Buildpacks builder image to use
+	// (default `docker.io/paketobuildpacks/builder-jammy-base:latest`).
+	BuildpacksBuilderImage string `json:"buildpacksBuilderImage,omitempty" property:"buildpacks-builder-image"`
+	// When using `Buildpacks` publish strategy, the run image to use as a base for the application image
+	// (default is the run image provided by the builder image).
+	BuildpacksRunImage string `json:"buildpacksRunImage,omitempty" property:"buildpacks-run-image"`
+	// When using `pod` strategy, share a Maven local repository, persisted in a PersistentVolumeClaim, across the builder Pods
+	// (default is the platform Maven cache configuration).
+	MavenCache *bool `json:"mavenCache,omitempty" property:"maven-cache"`
+	// The name of the PersistentVolumeClaim holding the shared Maven local repository (default `camel-k-maven-repository`).
+	// It is created in the builder Pod namespace when it does not exist.
+	MavenCachePVC string `json:"mavenCachePVC,omitempty" property:"maven-cache-pvc"`
+	// The size used to provision the shared Maven local repository PersistentVolumeClaim (default `10Gi`).
+	MavenCacheSize string `json:"mavenCacheSize,omitempty" property:"maven-cache-size"`
+	// The size the shared Maven local repository is allowed to grow up to before being evicted (default 80% of the size).
+	MavenCacheMaxSize string `json:"mavenCacheMaxSize,omitempty" property:"maven-cache-max-size"`
+	// The policy used to evict the shared Maven local repository once it exceeds its maximum size,
+	// either `None`, `OldestFirst` or `Purge` (default `None`).
+	// +kubebuilder:validation:Enum=None;OldestFirst;Purge
+	MavenCacheEvictionPolicy string `json:"mavenCacheEvictionPolicy,omitempty" property:"maven-cache-eviction-policy"`
+	// The maximum number of attempts to recover a failed build (default `5`).
+	RetryMaxAttempts *int32 `json:"retryMaxAttempts,omitempty" property:"retry-max-attempts"`
+	// The delay before the first attempt to recover a failed build, doubled after each attempt (default `5s`).
+	RetryBackoff string `json:"retryBackoff,omitempty" property:"retry-backoff"`
+	// The maximum delay between two attempts to recover a failed build (default `5m`).
+	RetryMaxBackoff string `json:"retryMaxBackoff,omitempty" property:"retry-max-backoff"`
+	// The classes of failure to recover, any of `Network`, `Dependency`, `Compilation` or `Unknown` (default any).
+	// The class is determined by parsing the error reported by the Maven build.
+	RetryOn []string `json:"retryOn,omitempty" property:"retry-on"`
+	// Generate a Software Bill of Materials listing the Maven dependencies of the application (default `false`).
+	// It is reported as an artifact of the IntegrationKit. Not available for native builds.
+	SBOM *bool `json:"sbom,omitempty" property:"sbom"`
+	// The format of the Software Bill of Materials, either `CycloneDX` or `SPDX` (default `CycloneDX`).
+	// +kubebuilder:validation:Enum=CycloneDX;SPDX
+	SBOMFormat string `json:"sbomFormat,omitempty" property:"sbom-format"`
+	// Push the Software Bill of Materials as an OCI artifact next to the image, tagged as `sha256-<image-digest>.sbom`
+	// (default `false`). Only available with the `Jib` publish strategy.
+	SBOMPush *bool `json:"sbomPush,omitempty" property:"sbom-push"`
+	// Sign the image once published, with a cosign compatible signature pushed next to the image (default `false`).
+	// The signature is reported in the IntegrationKit status. Only available with the `Jib` publish strategy.
+	Sign *bool `json:"sign,omitempty" property:"sign"`
+	// The name of the Secret holding the private key used to sign the image, as generated by
+	// `cosign generate-key-pair k8s://<namespace>/<name>`, ie, with the `cosign.key` and `cosign.password` keys.
+	SignSecret string `json:"signSecret,omitempty" property:"sign-secret"`
+	// Attach a signed SLSA provenance attestation to the image, recording the Integration digest, the Camel runtime
+	// and the dependencies the image is built from (default `false`). Requires the image to be signed.
+	Provenance *bool `json:"provenance,omitempty" property:"provenance"`
+	// The name of the ConfigMap holding the vulnerability database the dependencies are checked against,
+	// as OSV (https://osv.dev) JSON records. Each key holds either a single record, or an array of records.
+	VulnerabilityDatabaseConfigMap string `json:"vulnerabilityDatabaseConfigMap,omitempty" property:"vulnerability-database-configmap"`
+	// The name of the PersistentVolumeClaim, in the builder Pod namespace, holding the vulnerability database the dependencies
+	// are checked against, as OSV JSON records or OSV zip dumps. It enforces the build Pod strategy.
+	VulnerabilityDatabasePVC string `json:"vulnerabilityDatabasePVC,omitempty" property:"vulnerability-database-pvc"`
+	// The lowest severity of the vulnerabilities failing the build, either `Low`, `Medium`, `High` or `Critical` (default `High`).
+	// The vulnerabilities below the threshold are only reported in the IntegrationKit status.
+	// +kubebuilder:validation:Enum=Low;Medium;High;Critical
+	VulnerabilityFailureThreshold string `json:"vulnerabilityFailureThreshold,omitempty" property:"vulnerability-failure-threshold"`
 }
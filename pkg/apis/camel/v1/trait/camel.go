@@ -32,6 +32,8 @@ type CamelTrait struct {
 	// You can use a fixed version (for example "3.2.3") or a semantic version (for example "3.x") which will try to resolve
 	// to the best matching Catalog existing on the cluster (Default, the one provided by the operator version).
 	RuntimeVersion string `json:"runtimeVersion,omitempty" property:"runtime-version"`
-	// A list of properties to be provided to the Integration runtime
+	// A list of properties to be provided to the Integration runtime.
+	// The value of a property can reference a key of a secret stored out of the cluster, with the syntax
+	// provider:path#key (ie, `my.password=vault:secret/db#password`).
 	Properties []string `json:"properties,omitempty" property:"properties"`
 }
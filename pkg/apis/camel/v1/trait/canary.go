@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Canary trait rolls out the changes of a Deployment based Integration progressively, instead of replacing
+// all its pods at once with a rolling update.
+//
+// When the Integration changes, the Deployment keeps running the stable pods, while the new revision is deployed
+// as canary pods by a separate `<integration>-canary` Deployment. The traffic is then shifted to the canary pods
+// in weighted steps: each step lasts at least `step-interval-seconds`, and the canary only moves to the next step
+// when all its pods are ready. The canary is promoted when the last step completes, i.e., the Deployment is updated
+// with the new revision, and the canary pods are removed.
+//
+// The canary is rolled back when its pods fail, e.g., they cannot be scheduled or keep crashing, or when the health
+// checks reported by their readiness probes are down. In that case, the stable pods keep running the previous revision
+// until the Integration changes again.
+//
+// The traffic is weighted either by the number of stable and canary pods behind the Integration Service
+// (`service` traffic routing), or by the weights of the HTTPRoute generated by the Gateway trait (`gateway` traffic routing).
+//
+// NOTE: the first revision of an Integration deployed with the Canary trait enabled is rolled out with a rolling update.
+//
+// +camel-k:trait=canary.
+//
+//nolint:godoclint
+type CanaryTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The percentages of the traffic routed to the canary pods at each step of the rollout,
+	// in increasing order (default `10`, `50`).
+	Steps []int32 `json:"steps,omitempty" property:"steps"`
+	// The minimum time in seconds the canary is observed at each step before moving to the next one (default `60`).
+	StepIntervalSeconds *int32 `json:"stepIntervalSeconds,omitempty" property:"step-interval-seconds"`
+	// How the traffic is shifted to the canary pods: `service` weights the traffic by the number of stable
+	// and canary pods behind the Integration Service, `gateway` sets the weights of the HTTPRoute
+	// generated by the Gateway trait (default `service`).
+	// +kubebuilder:validation:Enum=service;gateway
+	TrafficRouting CanaryTrafficRouting `json:"trafficRouting,omitempty" property:"traffic-routing"`
+	// Whether the canary is rolled back automatically when its pods fail (default `true`).
+	// Otherwise the rollout is paused until the Integration changes.
+	AutoRollback *bool `json:"autoRollback,omitempty" property:"auto-rollback"`
+}
+
+// CanaryTrafficRouting is the way the traffic is shifted to the canary pods.
+type CanaryTrafficRouting string
+
+const (
+	// CanaryTrafficRoutingService weights the traffic by the number of pods behind the Integration Service.
+	CanaryTrafficRoutingService CanaryTrafficRouting = "service"
+	// CanaryTrafficRoutingGateway weights the traffic with the HTTPRoute generated by the Gateway trait.
+	CanaryTrafficRoutingGateway CanaryTrafficRouting = "gateway"
+)
@@ -26,9 +26,9 @@ package trait
 type DeployerTrait struct {
 	PlatformBaseTrait `json:",inline" property:",squash"`
 
-	// Allows to explicitly select the desired deployment kind between `deployment`, `cron-job` or `knative-service`
+	// Allows to explicitly select the desired deployment kind between `deployment`, `stateful-set`, `cron-job` or `knative-service`
 	// when creating the resources for running the integration.
-	// +kubebuilder:validation:Enum=deployment;cron-job;knative-service
+	// +kubebuilder:validation:Enum=deployment;stateful-set;cron-job;knative-service
 	//
 	// Deprecated: this feature will be removed in future releases.
 	Kind string `json:"kind,omitempty" property:"kind"`
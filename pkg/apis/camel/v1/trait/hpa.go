@@ -0,0 +1,96 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The HPA trait generates a HorizontalPodAutoscaler (`autoscaling/v2`) that scales the Deployment of the Integration,
+// according to its CPU and memory usage, and to custom metrics.
+//
+// When the HPA is enabled, the number of replicas of the Deployment is left to the autoscaler, and the replicas
+// of the Integration, ie, as set with `kubectl scale` on the Integration or the Pipe, are the minimum number
+// of replicas, unless `min-replicas` is set.
+//
+// The HPA trait is only supported with the Deployment controller strategy, and cannot be enabled along with the KEDA trait.
+//
+// +camel-k:trait=hpa.
+//
+//nolint:godoclint
+type HPATrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The minimum number of replicas (default to the Integration replicas, or `1`).
+	MinReplicas *int32 `json:"minReplicas,omitempty" property:"min-replicas"`
+	// The maximum number of replicas (required).
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" property:"max-replicas"`
+	// The target average CPU utilization, as a percentage of the requested CPU
+	// (default `80` if no other metric is set).
+	CPUUtilization *int32 `json:"cpuUtilization,omitempty" property:"cpu-utilization"`
+	// The target average memory utilization, as a percentage of the requested memory.
+	MemoryUtilization *int32 `json:"memoryUtilization,omitempty" property:"memory-utilization"`
+	// The custom metrics the number of replicas is computed from, along with the CPU and memory utilization.
+	Metrics []HPAMetric `json:"metrics,omitempty" property:"metrics"`
+	// The scaling behavior when scaling up.
+	ScaleUp *HPAScalingRules `json:"scaleUp,omitempty" property:"scale-up"`
+	// The scaling behavior when scaling down.
+	ScaleDown *HPAScalingRules `json:"scaleDown,omitempty" property:"scale-down"`
+}
+
+// HPAMetric is a custom metric the HPA scales the Integration on.
+type HPAMetric struct {
+	// The metric source: `Pods` for a metric of the Integration pods, `Object` for a metric describing
+	// a single Kubernetes object, or `External` for a metric not related to any Kubernetes object.
+	// +kubebuilder:validation:Enum=Pods;Object;External
+	Type string `json:"type,omitempty" property:"type"`
+	// The name of the metric.
+	Name string `json:"name,omitempty" property:"name"`
+	// The labels selecting the metric series, for the metrics providers supporting it.
+	Selector map[string]string `json:"selector,omitempty" property:"selector"`
+	// The target value of the metric, as a quantity (ie, `100`, `500m`, `1k`).
+	Target string `json:"target,omitempty" property:"target"`
+	// Whether the target is compared to the metric value, or to its average value per pod (default `AverageValue`).
+	// Only `AverageValue` is supported for `Pods` metrics.
+	// +kubebuilder:validation:Enum=Value;AverageValue
+	TargetType string `json:"targetType,omitempty" property:"target-type"`
+	// The API version of the object described by an `Object` metric.
+	ObjectAPIVersion string `json:"objectAPIVersion,omitempty" property:"object-api-version"`
+	// The kind of the object described by an `Object` metric.
+	ObjectKind string `json:"objectKind,omitempty" property:"object-kind"`
+	// The name of the object described by an `Object` metric.
+	ObjectName string `json:"objectName,omitempty" property:"object-name"`
+}
+
+// HPAScalingRules configures the scaling behavior in one direction.
+type HPAScalingRules struct {
+	// The number of seconds the past recommendations are considered for, to prevent flapping.
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty" property:"stabilization-window-seconds"`
+	// Which policy is used when several are set (default `Max`), `Disabled` turns scaling off in this direction.
+	// +kubebuilder:validation:Enum=Max;Min;Disabled
+	SelectPolicy string `json:"selectPolicy,omitempty" property:"select-policy"`
+	// The policies limiting the scaling changes.
+	Policies []HPAScalingPolicy `json:"policies,omitempty" property:"policies"`
+}
+
+// HPAScalingPolicy limits the scaling changes over a period of time.
+type HPAScalingPolicy struct {
+	// Whether the policy value is a number of pods, or a percentage of the current replicas.
+	// +kubebuilder:validation:Enum=Pods;Percent
+	Type string `json:"type,omitempty" property:"type"`
+	// The maximum change allowed over the period.
+	Value int32 `json:"value,omitempty" property:"value"`
+	// The period, in seconds, the policy holds for.
+	PeriodSeconds int32 `json:"periodSeconds,omitempty" property:"period-seconds"`
+}
@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The Log Shipping trait forwards the logs of the Integration to a remote endpoint, for the clusters where no
+// node-level agent collects the logs of the pods.
+//
+// When enabled, the Integration also writes its logs in JSON format to a file shared with a log forwarder, which is
+// added as a sidecar container by the init-containers trait. The forwarder parses each Camel log record, enriches it
+// with the name of the Integration, the IntegrationKit and the digest, and ships it to an OTLP/HTTP or a syslog
+// endpoint. The console logs of the Integration are unchanged.
+//
+// The log forwarder uses a https://fluentbit.io[Fluent Bit] image by default.
+//
+// +camel-k:trait=log-shipping.
+//
+//nolint:godoclint
+type LogShippingTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The endpoint receiving the logs: an `http://host:port[/path]` or `https://host:port[/path]` URL with
+	// the `otlp-http` protocol (the path defaults to `/v1/logs`), a `tcp://host:port`, `udp://host:port`
+	// or `tls://host:port` address with the `syslog` protocol.
+	Endpoint string `json:"endpoint,omitempty" property:"endpoint"`
+	// The protocol used to ship the logs (default `otlp-http`).
+	// +kubebuilder:validation:Enum=otlp-http;syslog
+	Protocol string `json:"protocol,omitempty" property:"protocol"`
+	// Additional labels added to each log record.
+	Labels map[string]string `json:"labels,omitempty" property:"labels"`
+	// The image of the log forwarder. It must provide a Fluent Bit compatible command line.
+	Image string `json:"image,omitempty" property:"image"`
+}
@@ -28,12 +28,19 @@ type MountTrait struct {
 	// A list of configuration pointing to configmap/secret.
 	// The configuration are expected to be UTF-8 resources as they are processed by runtime Camel Context and tried to be parsed as property files.
 	// They are also made available on the classpath in order to ease their usage directly from the Route.
-	// Syntax: [configmap|secret]:name[/key], where name represents the resource name and key optionally represents the resource key to be filtered
+	// Syntax: [configmap|secret]:[namespace:]name[/key], where name represents the resource name and key optionally represents the resource key to be filtered.
+	// A resource of another namespace is copied into the Integration namespace, provided the Integration ServiceAccount is authorized to get it.
+	// The namespace is separated with a colon, as the slash already separates the key.
+	// Secrets stored out of the cluster can be referenced with the syntax provider:path[#key], where provider is either `vault`
+	// (HashiCorp Vault KV secrets engine, read with the credentials of the `camel-k-vault` Secret of the Integration namespace)
+	// or `csi` (Secrets Store CSI driver SecretProviderClass name, which cannot be filtered by key).
 	Configs []string `json:"configs,omitempty" property:"configs"`
 	// A list of resources (text or binary content) pointing to configmap/secret.
 	// The resources are expected to be any resource type (text or binary content).
 	// The destination path can be either a default location or any path specified by the user.
-	// Syntax: [configmap|secret]:name[/key][@path], where name represents the resource name, key optionally represents the resource key to be filtered and path represents the destination path
+	// Syntax: [configmap|secret]:[namespace:]name[/key][@path], where name represents the resource name, key optionally represents the resource key to be filtered and path represents the destination path.
+	// A resource of another namespace is copied into the Integration namespace, as for the configs.
+	// Secrets stored out of the cluster can be referenced with the syntax provider:path[#key][@path], as for the configs.
 	Resources []string `json:"resources,omitempty" property:"resources"`
 	// A list of Persistent Volume Claims to be mounted. Syntax: [pvcname:/container/path]. If the PVC is not found, the Integration fails.
 	// You can use the syntax [pvcname:/container/path:size:accessMode<:storageClass>] to create a dynamic PVC based on the Storage Class provided
@@ -44,7 +51,7 @@ type MountTrait struct {
 	EmptyDirs []string `json:"emptyDirs,omitempty" property:"empty-dirs"`
 	// Enable "hot reload" when a secret/configmap mounted is edited (default `false`). The configmap/secret must be
 	// marked with `camel.apache.org/integration` label to be taken in account. The resource will be watched for any kind change, also for
-	// changes in metadata.
+	// changes in metadata. The external secrets are checked for rotation every minute.
 	HotReload *bool `json:"hotReload,omitempty" property:"hot-reload"`
 	// Deprecated: no longer available since version 2.5.
 	ScanKameletsImplicitLabelSecrets *bool `json:"scanKameletsImplicitLabelSecrets,omitempty" property:"scan-kamelets-implicit-label-secrets"`
@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The NetworkPolicy trait generates a NetworkPolicy restricting the traffic of the Integration pods to what is required
+// to run the Integration.
+//
+// The ingress traffic is only allowed on the ports exposed by the container and service traits, plus from the
+// Knative components when the Integration runs as a Knative Service, and from Prometheus on the metrics port when the
+// Prometheus trait is enabled. Any other ingress traffic is denied.
+//
+// The egress traffic can optionally be restricted too, to the DNS servers and to the hosts inferred from the endpoint
+// URIs of the Integration routes, e.g., `http://my-service:8080` or the `brokers` of the Kafka endpoints.
+// In-cluster Services, named `service`, `service.namespace.svc` or `service.namespace.svc.cluster.local`, are resolved
+// to the pods they select, while the traffic to the other hosts is allowed on their port to any destination, as their
+// addresses are not resolved. Use the `egress-cidrs` option to restrict it to known IP blocks instead.
+// The endpoints configured with property placeholders cannot be inferred, and must be declared with the `egress-hosts`
+// or `egress-cidrs` options.
+//
+// NOTE: the NetworkPolicy is only enforced by the network plugins that support it.
+//
+// +camel-k:trait=network-policy.
+//
+//nolint:godoclint
+type NetworkPolicyTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The namespaces allowed to reach the ports exposed by the Integration, in addition to the Integration namespace.
+	// Any namespace is allowed when not set.
+	IngressNamespaces []string `json:"ingressNamespaces,omitempty" property:"ingress-namespaces"`
+	// The namespaces of the Knative components the Integration is reachable from and can send events to,
+	// when it runs as a Knative Service or uses Knative endpoints
+	// (default `knative-serving`, `knative-eventing` and `kourier-system`).
+	KnativeNamespaces []string `json:"knativeNamespaces,omitempty" property:"knative-namespaces"`
+	// The labels of the Prometheus pods allowed to scrape the Integration metrics, when the Prometheus trait is enabled
+	// (default `app.kubernetes.io/name=prometheus`). Syntax: [key=value]
+	PrometheusPodLabels []string `json:"prometheusPodLabels,omitempty" property:"prometheus-pod-labels"`
+	// Whether the egress traffic is restricted as well (default `false`).
+	Egress *bool `json:"egress,omitempty" property:"egress"`
+	// Additional hosts the Integration is allowed to connect to, when the egress traffic is restricted.
+	// Syntax: host[:port]
+	EgressHosts []string `json:"egressHosts,omitempty" property:"egress-hosts"`
+	// Additional IP blocks the Integration is allowed to connect to, when the egress traffic is restricted,
+	// e.g., the Kubernetes API server addresses.
+	// Syntax: CIDR (e.g., `10.0.0.0/16`)
+	EgressCIDRs []string `json:"egressCIDRs,omitempty" property:"egress-cidrs"`
+}
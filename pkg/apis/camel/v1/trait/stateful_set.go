@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// The StatefulSet trait generates a Kubernetes StatefulSet, instead of a Deployment, to run the integration.
+// Each replica has a stable identity (ie, `<integration>-0`, `<integration>-1`, ...) and its own persistent storage,
+// that is retained across restarts and rescheduling. This is required, for instance, by file based idempotent repositories
+// or by Camel consumers storing local markers, such as the file component.
+//
+// The StatefulSet controller strategy can also be selected with the deployer trait `kind` option.
+//
+// The service name, the pod management policy and the volume claim templates cannot be changed once the StatefulSet is created:
+// the StatefulSet has to be deleted, for instance with `kubectl delete statefulset <integration> --cascade=orphan`, to be re-created.
+//
+// +camel-k:trait=stateful-set.
+//
+//nolint:godoclint
+type StatefulSetTrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// The name of the headless Service governing the network identity of the pods (default to `<integration>-headless`).
+	// The Service is created along with the StatefulSet.
+	ServiceName string `json:"serviceName,omitempty" property:"service-name"`
+	// Whether the pods are created and deleted in order (`OrderedReady`), or all at once (`Parallel`). Default to `OrderedReady`.
+	// +kubebuilder:validation:Enum=OrderedReady;Parallel
+	PodManagementPolicy appsv1.PodManagementPolicyType `json:"podManagementPolicy,omitempty" property:"pod-management-policy"`
+	// The strategy to use to replace existing pods with new ones. Default to `RollingUpdate`.
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	UpdateStrategy appsv1.StatefulSetUpdateStrategyType `json:"updateStrategy,omitempty" property:"update-strategy"`
+	// The ordinal at which the StatefulSet is partitioned during a rolling update: only the pods with an ordinal
+	// greater than or equal to the partition are updated.
+	RollingUpdatePartition *int32 `json:"rollingUpdatePartition,omitempty" property:"rolling-update-partition"`
+	// A list of volumes claimed by each replica, mounted in the integration container.
+	// Syntax: name:/container/path:size[:accessMode[:storageClass]], where the access mode defaults to `ReadWriteOnce`
+	// and the storage class to the cluster default Storage Class.
+	VolumeClaimTemplates []string `json:"volumeClaimTemplates,omitempty" property:"volume-claim-templates"`
+	// What happens to the claimed volumes when the StatefulSet is deleted, either `Retain` or `Delete`. Default to `Retain`.
+	// +kubebuilder:validation:Enum=Retain;Delete
+	PersistentVolumeClaimWhenDeleted appsv1.PersistentVolumeClaimRetentionPolicyType `json:"persistentVolumeClaimWhenDeleted,omitempty" property:"persistent-volume-claim-when-deleted"`
+	// What happens to the claimed volumes when the StatefulSet is scaled down, either `Retain` or `Delete`. Default to `Retain`.
+	// +kubebuilder:validation:Enum=Retain;Delete
+	PersistentVolumeClaimWhenScaled appsv1.PersistentVolumeClaimRetentionPolicyType `json:"persistentVolumeClaimWhenScaled,omitempty" property:"persistent-volume-claim-when-scaled"`
+}
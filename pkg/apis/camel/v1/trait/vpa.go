@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+// The VPA trait generates a VerticalPodAutoscaler (`autoscaling.k8s.io/v1`) for the Deployment or the StatefulSet
+// of the Integration, which computes the resources of the Integration container according to its actual usage.
+//
+// The recommended resources are reported in the Integration status, and can be used to tune the resources
+// configured with the container trait. In the `Off` update mode (default), the VerticalPodAutoscaler only computes
+// recommendations. In the other modes, it also sets the recommended resources when the pods are created (`Initial`),
+// or evicts the pods running with outdated resources (`Recreate` and `Auto`). In these modes, the JVM maximum heap size
+// is set as a percentage of the container memory, with `-XX:MaxRAMPercentage`, so that it follows the memory set by the
+// VerticalPodAutoscaler, unless it is set with the JVM trait options.
+//
+// The VerticalPodAutoscaler must be installed in the cluster. It cannot update the resources of the pods
+// while the HPA trait scales them.
+//
+// +camel-k:trait=vpa.
+//
+//nolint:godoclint
+type VPATrait struct {
+	Trait `json:",inline" property:",squash"`
+
+	// When the recommended resources are applied to the pods: `Off` only computes recommendations, `Initial` sets
+	// the resources when the pods are created, `Recreate` and `Auto` also evict the pods with outdated resources
+	// (default `Off`).
+	// +kubebuilder:validation:Enum=Off;Initial;Recreate;Auto
+	UpdateMode string `json:"updateMode,omitempty" property:"update-mode"`
+	// The minimum CPU recommended for the Integration container (ie, `250m`).
+	MinAllowedCPU string `json:"minAllowedCPU,omitempty" property:"min-allowed-cpu"`
+	// The minimum memory recommended for the Integration container (ie, `256Mi`).
+	MinAllowedMemory string `json:"minAllowedMemory,omitempty" property:"min-allowed-memory"`
+	// The maximum CPU recommended for the Integration container (ie, `2`).
+	MaxAllowedCPU string `json:"maxAllowedCPU,omitempty" property:"max-allowed-cpu"`
+	// The maximum memory recommended for the Integration container (ie, `2Gi`).
+	MaxAllowedMemory string `json:"maxAllowedMemory,omitempty" property:"max-allowed-memory"`
+}
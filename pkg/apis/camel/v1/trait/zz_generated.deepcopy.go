@@ -51,6 +51,27 @@ func (in *AffinityTrait) DeepCopy() *AffinityTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollbackTrait) DeepCopyInto(out *AutoRollbackTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.FailureWindowSeconds != nil {
+		in, out := &in.FailureWindowSeconds, &out.FailureWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackTrait.
+func (in *AutoRollbackTrait) DeepCopy() *AutoRollbackTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollbackTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseTruststore) DeepCopyInto(out *BaseTruststore) {
 	*out = *in
@@ -85,6 +106,11 @@ func (in *BuilderTrait) DeepCopyInto(out *BuilderTrait) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	if in.MavenProfiles != nil {
 		in, out := &in.MavenProfiles, &out.MavenProfiles
 		*out = make([]string, len(*in))
@@ -134,6 +160,41 @@ func (in *BuilderTrait) DeepCopyInto(out *BuilderTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MavenCache != nil {
+		in, out := &in.MavenCache, &out.MavenCache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetryMaxAttempts != nil {
+		in, out := &in.RetryMaxAttempts, &out.RetryMaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SBOMPush != nil {
+		in, out := &in.SBOMPush, &out.SBOMPush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sign != nil {
+		in, out := &in.Sign, &out.Sign
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderTrait.
@@ -182,6 +243,37 @@ func (in *CamelTrait) DeepCopy() *CamelTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryTrait) DeepCopyInto(out *CanaryTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.StepIntervalSeconds != nil {
+		in, out := &in.StepIntervalSeconds, &out.StepIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryTrait.
+func (in *CanaryTrait) DeepCopy() *CanaryTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -482,6 +574,121 @@ func (in *GitOpsTrait) DeepCopy() *GitOpsTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPAMetric) DeepCopyInto(out *HPAMetric) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPAMetric.
+func (in *HPAMetric) DeepCopy() *HPAMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(HPAMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPAScalingPolicy) DeepCopyInto(out *HPAScalingPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPAScalingPolicy.
+func (in *HPAScalingPolicy) DeepCopy() *HPAScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HPAScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPAScalingRules) DeepCopyInto(out *HPAScalingRules) {
+	*out = *in
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]HPAScalingPolicy, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPAScalingRules.
+func (in *HPAScalingRules) DeepCopy() *HPAScalingRules {
+	if in == nil {
+		return nil
+	}
+	out := new(HPAScalingRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPATrait) DeepCopyInto(out *HPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MemoryUtilization != nil {
+		in, out := &in.MemoryUtilization, &out.MemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]HPAMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleUp != nil {
+		in, out := &in.ScaleUp, &out.ScaleUp
+		*out = new(HPAScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(HPAScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPATrait.
+func (in *HPATrait) DeepCopy() *HPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(HPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTrait) DeepCopyInto(out *HealthTrait) {
 	*out = *in
@@ -991,6 +1198,29 @@ func (in *KnativeTrait) DeepCopy() *KnativeTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogShippingTrait) DeepCopyInto(out *LogShippingTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogShippingTrait.
+func (in *LogShippingTrait) DeepCopy() *LogShippingTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(LogShippingTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingTrait) DeepCopyInto(out *LoggingTrait) {
 	*out = *in
@@ -1114,6 +1344,52 @@ func (in *MountTrait) DeepCopy() *MountTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyTrait) DeepCopyInto(out *NetworkPolicyTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.IngressNamespaces != nil {
+		in, out := &in.IngressNamespaces, &out.IngressNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KnativeNamespaces != nil {
+		in, out := &in.KnativeNamespaces, &out.KnativeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrometheusPodLabels != nil {
+		in, out := &in.PrometheusPodLabels, &out.PrometheusPodLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EgressHosts != nil {
+		in, out := &in.EgressHosts, &out.EgressHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyTrait.
+func (in *NetworkPolicyTrait) DeepCopy() *NetworkPolicyTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAPITrait) DeepCopyInto(out *OpenAPITrait) {
 	*out = *in
@@ -1482,6 +1758,32 @@ func (in *ServiceTrait) DeepCopy() *ServiceTrait {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulSetTrait) DeepCopyInto(out *StatefulSetTrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+	if in.RollingUpdatePartition != nil {
+		in, out := &in.RollingUpdatePartition, &out.RollingUpdatePartition
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulSetTrait.
+func (in *StatefulSetTrait) DeepCopy() *StatefulSetTrait {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSetTrait)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TelemetryTrait) DeepCopyInto(out *TelemetryTrait) {
 	*out = *in
@@ -1553,3 +1855,19 @@ func (in *Trait) DeepCopy() *Trait {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPATrait) DeepCopyInto(out *VPATrait) {
+	*out = *in
+	in.Trait.DeepCopyInto(&out.Trait)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPATrait.
+func (in *VPATrait) DeepCopy() *VPATrait {
+	if in == nil {
+		return nil
+	}
+	out := new(VPATrait)
+	in.DeepCopyInto(out)
+	return out
+}
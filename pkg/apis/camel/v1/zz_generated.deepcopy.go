@@ -208,6 +208,63 @@ func (in *BuildList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaSpec) DeepCopyInto(out *BuildQuotaSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaSpec.
+func (in *BuildQuotaSpec) DeepCopy() *BuildQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildRetryPolicy) DeepCopyInto(out *BuildRetryPolicy) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]BuildFailureClass, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildRetryPolicy.
+func (in *BuildRetryPolicy) DeepCopy() *BuildRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
@@ -220,6 +277,11 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	}
 	in.Configuration.DeepCopyInto(&out.Configuration)
 	out.Timeout = in.Timeout
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(BuildRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSpec.
@@ -240,6 +302,16 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]Artifact, len(*in))
 		copy(*out, *in)
 	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(ImageSignature)
+		**out = **in
+	}
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]Vulnerability, len(*in))
+		copy(*out, *in)
+	}
 	if in.Failure != nil {
 		in, out := &in.Failure, &out.Failure
 		*out = new(Failure)
@@ -318,6 +390,21 @@ func (in *BuilderTask) DeepCopyInto(out *BuilderTask) {
 		*out = new(GitConfigSpec)
 		**out = **in
 	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOMSpec)
+		**out = **in
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ProvenanceSpec)
+		**out = **in
+	}
+	if in.VulnerabilityScan != nil {
+		in, out := &in.VulnerabilityScan, &out.VulnerabilityScan
+		*out = new(VulnerabilityScanSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderTask.
@@ -330,6 +417,23 @@ func (in *BuilderTask) DeepCopy() *BuilderTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpacksTask) DeepCopyInto(out *BuildpacksTask) {
+	*out = *in
+	in.BaseTask.DeepCopyInto(&out.BaseTask)
+	out.PublishTask = in.PublishTask
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpacksTask.
+func (in *BuildpacksTask) DeepCopy() *BuildpacksTask {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpacksTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CamelArtifact) DeepCopyInto(out *CamelArtifact) {
 	*out = *in
@@ -871,6 +975,16 @@ func (in *ErrorHandlerLog) DeepCopyInto(out *ErrorHandlerLog) {
 		*out = new(ErrorHandlerParameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedeliveryPolicy != nil {
+		in, out := &in.RedeliveryPolicy, &out.RedeliveryPolicy
+		*out = new(ErrorHandlerRedeliveryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UseOriginalMessage != nil {
+		in, out := &in.UseOriginalMessage, &out.UseOriginalMessage
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorHandlerLog.
@@ -919,6 +1033,46 @@ func (in *ErrorHandlerParameters) DeepCopy() *ErrorHandlerParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorHandlerRedeliveryPolicy) DeepCopyInto(out *ErrorHandlerRedeliveryPolicy) {
+	*out = *in
+	if in.MaximumRedeliveries != nil {
+		in, out := &in.MaximumRedeliveries, &out.MaximumRedeliveries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RedeliveryDelay != nil {
+		in, out := &in.RedeliveryDelay, &out.RedeliveryDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BackOffMultiplier != nil {
+		in, out := &in.BackOffMultiplier, &out.BackOffMultiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaximumRedeliveryDelay != nil {
+		in, out := &in.MaximumRedeliveryDelay, &out.MaximumRedeliveryDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorHandlerRedeliveryPolicy.
+func (in *ErrorHandlerRedeliveryPolicy) DeepCopy() *ErrorHandlerRedeliveryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorHandlerRedeliveryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ErrorHandlerSink) DeepCopyInto(out *ErrorHandlerSink) {
 	*out = *in
@@ -1162,6 +1316,36 @@ func (in *HealthCheckResponse) DeepCopy() *HealthCheckResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSignature) DeepCopyInto(out *ImageSignature) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignature.
+func (in *ImageSignature) DeepCopy() *ImageSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSigningSpec) DeepCopyInto(out *ImageSigningSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSigningSpec.
+func (in *ImageSigningSpec) DeepCopy() *ImageSigningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSigningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integration) DeepCopyInto(out *Integration) {
 	*out = *in
@@ -1189,6 +1373,22 @@ func (in *Integration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationCanaryStatus) DeepCopyInto(out *IntegrationCanaryStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationCanaryStatus.
+func (in *IntegrationCanaryStatus) DeepCopy() *IntegrationCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationCondition) DeepCopyInto(out *IntegrationCondition) {
 	*out = *in
@@ -1217,6 +1417,27 @@ func (in *IntegrationCondition) DeepCopy() *IntegrationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationHealthyRevision) DeepCopyInto(out *IntegrationHealthyRevision) {
+	*out = *in
+	if in.IntegrationKit != nil {
+		in, out := &in.IntegrationKit, &out.IntegrationKit
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationHealthyRevision.
+func (in *IntegrationHealthyRevision) DeepCopy() *IntegrationHealthyRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationHealthyRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationKit) DeepCopyInto(out *IntegrationKit) {
 	*out = *in
@@ -1344,6 +1565,16 @@ func (in *IntegrationKitStatus) DeepCopyInto(out *IntegrationKitStatus) {
 		*out = make([]Artifact, len(*in))
 		copy(*out, *in)
 	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(ImageSignature)
+		**out = **in
+	}
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]Vulnerability, len(*in))
+		copy(*out, *in)
+	}
 	if in.Failure != nil {
 		in, out := &in.Failure, &out.Failure
 		*out = new(Failure)
@@ -1497,6 +1728,11 @@ func (in *IntegrationPlatformBuildSpec) DeepCopyInto(out *IntegrationPlatformBui
 			(*out)[key] = val
 		}
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(BuildQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPlatformBuildSpec.
@@ -1668,6 +1904,11 @@ func (in *IntegrationProfileBuildSpec) DeepCopyInto(out *IntegrationProfileBuild
 		**out = **in
 	}
 	in.Maven.DeepCopyInto(&out.Maven)
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(BuildQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationProfileBuildSpec.
@@ -1795,6 +2036,42 @@ func (in *IntegrationProfileStatus) DeepCopy() *IntegrationProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationResourcesRecommendation) DeepCopyInto(out *IntegrationResourcesRecommendation) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResourcesRecommendation.
+func (in *IntegrationResourcesRecommendation) DeepCopy() *IntegrationResourcesRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationResourcesRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 	*out = *in
@@ -1924,6 +2201,21 @@ func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 		in, out := &in.BuildTimestamp, &out.BuildTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(IntegrationCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastHealthyRevision != nil {
+		in, out := &in.LastHealthyRevision, &out.LastHealthyRevision
+		*out = new(IntegrationHealthyRevision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourcesRecommendation != nil {
+		in, out := &in.ResourcesRecommendation, &out.ResourcesRecommendation
+		*out = new(IntegrationResourcesRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationStatus.
@@ -2080,6 +2372,11 @@ func (in *JibTask) DeepCopyInto(out *JibTask) {
 	*out = *in
 	in.BaseTask.DeepCopyInto(&out.BaseTask)
 	out.PublishTask = in.PublishTask
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(ImageSigningSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JibTask.
@@ -2385,6 +2682,21 @@ func (in *MavenBuildSpec) DeepCopy() *MavenBuildSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenCacheSpec) DeepCopyInto(out *MavenCacheSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenCacheSpec.
+func (in *MavenCacheSpec) DeepCopy() *MavenCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 	*out = *in
@@ -2421,6 +2733,11 @@ func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(MavenCacheSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenSpec.
@@ -2551,6 +2868,22 @@ func (in *PipeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipeSink) DeepCopyInto(out *PipeSink) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipeSink.
+func (in *PipeSink) DeepCopy() *PipeSink {
+	if in == nil {
+		return nil
+	}
+	out := new(PipeSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipeSpec) DeepCopyInto(out *PipeSpec) {
 	*out = *in
@@ -2561,6 +2894,13 @@ func (in *PipeSpec) DeepCopyInto(out *PipeSpec) {
 	}
 	in.Source.DeepCopyInto(&out.Source)
 	in.Sink.DeepCopyInto(&out.Sink)
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]PipeSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ErrorHandler != nil {
 		in, out := &in.ErrorHandler, &out.ErrorHandler
 		*out = new(ErrorHandlerSpec)
@@ -2851,6 +3191,21 @@ func (in Properties) DeepCopy() Properties {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvenanceSpec) DeepCopyInto(out *ProvenanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvenanceSpec.
+func (in *ProvenanceSpec) DeepCopy() *ProvenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublishTask) DeepCopyInto(out *PublishTask) {
 	*out = *in
@@ -2984,6 +3339,21 @@ func (in *S2iTask) DeepCopy() *S2iTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOMSpec) DeepCopyInto(out *SBOMSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOMSpec.
+func (in *SBOMSpec) DeepCopy() *SBOMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -3115,6 +3485,11 @@ func (in *Task) DeepCopyInto(out *Task) {
 		*out = new(JibTask)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = new(BuildpacksTask)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
@@ -3191,6 +3566,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.AffinityTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(trait.AutoRollbackTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Builder != nil {
 		in, out := &in.Builder, &out.Builder
 		*out = new(trait.BuilderTrait)
@@ -3201,6 +3581,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.CamelTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(trait.CanaryTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Container != nil {
 		in, out := &in.Container, &out.Container
 		*out = new(trait.ContainerTrait)
@@ -3256,6 +3641,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.HealthTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HPA != nil {
+		in, out := &in.HPA, &out.HPA
+		*out = new(trait.HPATrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(trait.IngressTrait)
@@ -3301,6 +3691,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.KnativeServiceTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogShipping != nil {
+		in, out := &in.LogShipping, &out.LogShipping
+		*out = new(trait.LogShippingTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(trait.LoggingTrait)
@@ -3316,6 +3711,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.MountTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(trait.NetworkPolicyTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OpenAPI != nil {
 		in, out := &in.OpenAPI, &out.OpenAPI
 		*out = new(trait.OpenAPITrait)
@@ -3381,6 +3781,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.ServiceBindingTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(trait.StatefulSetTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Telemetry != nil {
 		in, out := &in.Telemetry, &out.Telemetry
 		*out = new(trait.TelemetryTrait)
@@ -3391,6 +3796,11 @@ func (in *Traits) DeepCopyInto(out *Traits) {
 		*out = new(trait.TolerationTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(trait.VPATrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
 		*out = make(map[string]AddonTrait, len(*in))
@@ -3475,3 +3885,33 @@ func (in *ValueSource) DeepCopy() *ValueSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vulnerability) DeepCopyInto(out *Vulnerability) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vulnerability.
+func (in *Vulnerability) DeepCopy() *Vulnerability {
+	if in == nil {
+		return nil
+	}
+	out := new(Vulnerability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityScanSpec) DeepCopyInto(out *VulnerabilityScanSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityScanSpec.
+func (in *VulnerabilityScanSpec) DeepCopy() *VulnerabilityScanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityScanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscaler is a specification for a VerticalPodAutoscaler resource.
+type VerticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VerticalPodAutoscalerSpec `json:"spec"`
+	// +optional
+	Status VerticalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// VerticalPodAutoscalerSpec is the spec for a VerticalPodAutoscaler resource.
+type VerticalPodAutoscalerSpec struct {
+	TargetRef *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	// +optional
+	UpdatePolicy *PodUpdatePolicy `json:"updatePolicy,omitempty"`
+	// +optional
+	ResourcePolicy *PodResourcePolicy `json:"resourcePolicy,omitempty"`
+}
+
+// PodUpdatePolicy describes the rules on how changes are applied to the pods.
+type PodUpdatePolicy struct {
+	// +optional
+	UpdateMode *UpdateMode `json:"updateMode,omitempty"`
+}
+
+// UpdateMode controls when the recommended resources are applied to the pods.
+type UpdateMode string
+
+const (
+	// UpdateModeOff means that the recommended resources are never applied.
+	UpdateModeOff UpdateMode = "Off"
+	// UpdateModeInitial means that the recommended resources are only applied when the pods are created.
+	UpdateModeInitial UpdateMode = "Initial"
+	// UpdateModeRecreate means that the pods are evicted when their resources differ from the recommended ones.
+	UpdateModeRecreate UpdateMode = "Recreate"
+	// UpdateModeAuto means that the recommended resources are applied with the best available update method.
+	UpdateModeAuto UpdateMode = "Auto"
+)
+
+// PodResourcePolicy controls how the recommended resources are computed for the containers.
+type PodResourcePolicy struct {
+	// +optional
+	ContainerPolicies []ContainerResourcePolicy `json:"containerPolicies,omitempty"`
+}
+
+// ContainerResourcePolicy controls how the recommended resources are computed for a container.
+type ContainerResourcePolicy struct {
+	// Name of the container, or `*` for the default policy.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+	// +optional
+	Mode *ContainerScalingMode `json:"mode,omitempty"`
+	// +optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// +optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+// ContainerScalingMode controls whether the autoscaler is enabled for a container.
+type ContainerScalingMode string
+
+const (
+	// ContainerScalingModeAuto means the autoscaling is enabled for the container.
+	ContainerScalingModeAuto ContainerScalingMode = "Auto"
+	// ContainerScalingModeOff means the autoscaling is disabled for the container.
+	ContainerScalingModeOff ContainerScalingMode = "Off"
+)
+
+// VerticalPodAutoscalerStatus is the status of a VerticalPodAutoscaler resource.
+type VerticalPodAutoscalerStatus struct {
+	// +optional
+	Recommendation *RecommendedPodResources `json:"recommendation,omitempty"`
+}
+
+// RecommendedPodResources is the recommended resources of the pod containers.
+type RecommendedPodResources struct {
+	// +optional
+	ContainerRecommendations []RecommendedContainerResources `json:"containerRecommendations,omitempty"`
+}
+
+// RecommendedContainerResources is the recommended resources of a container.
+type RecommendedContainerResources struct {
+	ContainerName string              `json:"containerName,omitempty"`
+	Target        corev1.ResourceList `json:"target"`
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+	// +optional
+	UncappedTarget corev1.ResourceList `json:"uncappedTarget,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerticalPodAutoscalerList contains a list of VerticalPodAutoscaler.
+type VerticalPodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VerticalPodAutoscaler `json:"items"`
+}
@@ -22,7 +22,6 @@ import (
 	"errors"
 	"os"
 	"sort"
-	"strconv"
 	"time"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
@@ -55,15 +54,27 @@ func (t *builderTask) Do(ctx context.Context) v1.BuildStatus {
 		buildDir = pwd
 	}
 
+	if t.task.Maven.Cache != nil {
+		// Prevent the shared Maven local repository from being evicted while in use
+		release, err := acquireMavenCache(ctx, MavenCacheDir)
+		if err != nil {
+			return result.Failed(err)
+		}
+		defer release()
+	}
+
+	tl := taskLogger(t.log, t.build, t.task.Name)
+
 	c := builderContext{
 		Client:    t.c,
+		Log:       tl,
 		C:         ctx,
 		Path:      buildDir,
 		Namespace: t.build.Namespace,
 		Build:     *t.task,
 		BaseImage: t.task.BaseImage,
 	}
-	t.log.Infof("running builder task %s in context directory: %s", c.Build.Name, c.Path)
+	tl.Infof("running builder task %s in context directory: %s", c.Build.Name, c.Path)
 
 	steps, err := StepsFrom(t.task.Steps...)
 	if err != nil {
@@ -95,9 +106,10 @@ steps:
 			break steps
 
 		default:
-			l := t.log.WithValues("step", step.ID(), "phase", strconv.FormatInt(int64(step.Phase()), 10), "task", t.task.Name)
-			l.Debugf("executing step")
+			l := tl.WithValues(LogStepKey, step.ID(), LogPhaseKey, PhaseName(step.Phase()))
+			l.Infof("executing step")
 
+			c.Log = l
 			start := time.Now()
 			err := step.execute(&c)
 			if err != nil {
@@ -117,6 +129,13 @@ steps:
 		return result
 	}
 
+	result.Vulnerabilities = c.Vulnerabilities
+	if len(c.Conditions) > 0 {
+		// The status conditions are patched as a whole, so the ones already reported must be preserved
+		result.Conditions = append(result.Conditions, t.build.Status.Conditions...)
+		result.SetConditions(c.Conditions...)
+	}
+
 	result.BaseImage = c.BaseImage
 	result.Artifacts = make([]v1.Artifact, 0, len(c.Artifacts))
 	result.Artifacts = append(result.Artifacts, c.Artifacts...)
@@ -0,0 +1,113 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+const (
+	// BuildpacksDefaultBuilderImage is the Cloud Native Buildpacks builder image used when none is provided.
+	BuildpacksDefaultBuilderImage = "docker.io/paketobuildpacks/builder-jammy-base:latest"
+	// BuildpacksDir is the directory used to store the Buildpacks lifecycle layers and report.
+	BuildpacksDir = "buildpacks"
+	// BuildpacksReportFile is the file where the Buildpacks lifecycle writes the result of the image export.
+	BuildpacksReportFile = "report.toml"
+	// BuildpacksProjectDescriptor is the project descriptor read by the Buildpacks lifecycle.
+	BuildpacksProjectDescriptor = "project.toml"
+
+	buildpacksCreator = "/cnb/lifecycle/creator"
+)
+
+func init() {
+	registerSteps(Buildpacks)
+}
+
+type buildpacksSteps struct {
+	GenerateProjectDescriptor Step
+}
+
+// Buildpacks used to export the steps available to prepare a Buildpacks publishing.
+var Buildpacks = buildpacksSteps{
+	GenerateProjectDescriptor: NewStep(ApplicationPackagePhase+2, generateBuildpacksProjectDescriptor),
+}
+
+// generateBuildpacksProjectDescriptor writes the project descriptor instructing the Maven buildpack
+// how to build the Maven project generated by the previous steps.
+func generateBuildpacksProjectDescriptor(ctx *builderContext) error {
+	mavenDir := filepath.Join(ctx.Path, "maven")
+
+	env := map[string]string{
+		// The Maven configuration (settings, repositories, ...) is already available in .mvn/maven.config
+		"BP_MAVEN_BUILD_ARGUMENTS": "-Dmaven.test.skip=true package",
+	}
+	quarkusApp, err := util.DirectoryExists(filepath.Join(mavenDir, "target", "quarkus-app"))
+	if err != nil {
+		return err
+	}
+	if quarkusApp {
+		env["BP_MAVEN_BUILT_ARTIFACT"] = "target/quarkus-app/"
+	}
+
+	return util.WriteFileWithContent(filepath.Join(mavenDir, BuildpacksProjectDescriptor), []byte(buildpacksProjectDescriptor(env)))
+}
+
+func buildpacksProjectDescriptor(env map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("[_]\n")
+	sb.WriteString("schema-version = \"0.2\"\n")
+	for _, k := range util.SortedStringMapKeys(env) {
+		sb.WriteString("\n[[io.buildpacks.build.env]]\n")
+		fmt.Fprintf(&sb, "name = %q\n", k)
+		fmt.Fprintf(&sb, "value = %q\n", env[k])
+	}
+
+	return sb.String()
+}
+
+// BuildpacksCreatorCommand returns the command executing the Buildpacks lifecycle on the Maven project
+// available in the workspace directory. The image digest is written to the container termination log.
+func BuildpacksCreatorCommand(task *v1.BuildpacksTask, workspaceDir string) []string {
+	buildpacksDir := filepath.Join(workspaceDir, BuildpacksDir)
+	reportFile := filepath.Join(buildpacksDir, BuildpacksReportFile)
+
+	args := make([]string, 0)
+	args = append(args, buildpacksCreator)
+	args = append(args, "-app="+filepath.Join(workspaceDir, "maven"))
+	args = append(args, "-layers="+filepath.Join(buildpacksDir, "layers"))
+	args = append(args, "-report="+reportFile)
+	if task.RunImage != "" {
+		args = append(args, "-run-image="+task.RunImage)
+	}
+	if task.Registry.Insecure && task.Registry.Address != "" {
+		args = append(args, "-insecure-registry="+task.Registry.Address)
+	}
+	args = append(args, task.Image)
+
+	return []string{
+		"/bin/bash",
+		"-c",
+		fmt.Sprintf("mkdir -p %s && %s && grep -o 'sha256:[a-f0-9]*' %s | head -1 | tr -d '\\n' > /dev/termination-log",
+			filepath.Join(buildpacksDir, "layers"), strings.Join(args, " "), reportFile),
+	}
+}
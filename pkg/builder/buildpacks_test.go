@@ -0,0 +1,92 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBuildpacksProjectDescriptor(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "maven", "target", "quarkus-app"), os.ModePerm))
+	builderContext := builderContext{
+		C:    context.TODO(),
+		Path: tmpDir,
+	}
+	err := generateBuildpacksProjectDescriptor(&builderContext)
+	require.NoError(t, err)
+
+	descriptor, err := util.ReadFile(filepath.Join(tmpDir, "maven", BuildpacksProjectDescriptor))
+	require.NoError(t, err)
+	assert.Equal(t, `[_]
+schema-version = "0.2"
+
+[[io.buildpacks.build.env]]
+name = "BP_MAVEN_BUILD_ARGUMENTS"
+value = "-Dmaven.test.skip=true package"
+
+[[io.buildpacks.build.env]]
+name = "BP_MAVEN_BUILT_ARTIFACT"
+value = "target/quarkus-app/"
+`, string(descriptor))
+}
+
+func TestGenerateBuildpacksProjectDescriptorFatJar(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "maven"), os.ModePerm))
+	builderContext := builderContext{
+		C:    context.TODO(),
+		Path: tmpDir,
+	}
+	err := generateBuildpacksProjectDescriptor(&builderContext)
+	require.NoError(t, err)
+
+	descriptor, err := util.ReadFile(filepath.Join(tmpDir, "maven", BuildpacksProjectDescriptor))
+	require.NoError(t, err)
+	assert.NotContains(t, string(descriptor), "BP_MAVEN_BUILT_ARTIFACT")
+}
+
+func TestBuildpacksCreatorCommand(t *testing.T) {
+	task := &v1.BuildpacksTask{
+		PublishTask: v1.PublishTask{
+			Image: "my-registry/my-image:1",
+			Registry: v1.RegistrySpec{
+				Address:  "my-registry",
+				Insecure: true,
+			},
+		},
+		BuilderImage: "my-builder",
+		RunImage:     "my-run-image",
+	}
+	cmd := BuildpacksCreatorCommand(task, "/builder/my-build")
+	assert.Len(t, cmd, 3)
+	assert.Equal(t, "/bin/bash", cmd[0])
+	assert.Equal(t, "-c", cmd[1])
+	assert.Equal(t, "mkdir -p /builder/my-build/buildpacks/layers && "+
+		"/cnb/lifecycle/creator -app=/builder/my-build/maven -layers=/builder/my-build/buildpacks/layers "+
+		"-report=/builder/my-build/buildpacks/report.toml -run-image=my-run-image -insecure-registry=my-registry my-registry/my-image:1 && "+
+		"grep -o 'sha256:[a-f0-9]*' /builder/my-build/buildpacks/report.toml | head -1 | tr -d '\\n' > /dev/termination-log", cmd[2])
+}
@@ -35,6 +35,21 @@ func newMavenContext(ctx *builderContext) *maven.Context {
 	mc.SettingsSecurity = ctx.Maven.SettingsSecurity
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.AdditionalArguments = ctx.Build.Maven.CLIOptions
+	mc.LogHandler = maven.NewLogHandler(ctx.Log)
+
+	if ctx.Build.Maven.Cache != nil {
+		mc.LocalRepository = MavenCacheDir
+		mc.AdditionalArguments = append([]string{}, ctx.Build.Maven.CLIOptions...)
+		// The original local repository is still used to resolve the artifacts shipped with the builder image
+		if ctx.Build.Maven.LocalRepository != "" {
+			mc.AdditionalArguments = append(mc.AdditionalArguments, "-Dmaven.repo.local.tail="+ctx.Build.Maven.LocalRepository)
+		}
+		// Synchronize the concurrent access of the builder Pods to the shared local repository with file locks
+		mc.AdditionalArguments = append(mc.AdditionalArguments,
+			"-Daether.syncContext.named.factory=file-lock",
+			"-Daether.syncContext.named.nameMapper=file-gav",
+		)
+	}
 
 	if ctx.Maven.TrustStoreName != "" {
 		mc.ExtraMavenOpts = append(mc.ExtraMavenOpts,
@@ -44,6 +44,7 @@ var _ Task = &jibTask{}
 
 func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 	status := initializeStatusFrom(t.build.Status, t.task.BaseImage)
+	l := taskLogger(log.WithName("builder"), t.build, t.task.Name).WithValues(LogPhaseKey, PhaseName(ApplicationPublishPhase))
 
 	contextDir := t.task.ContextDir
 	if contextDir == "" {
@@ -70,14 +71,14 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 		// this can only indicate that there are no more resources to add to the base image,
 		// because transitive resolution is the same even if spec differs.
 		status.Image = status.BaseImage
-		log.Infof("No new image to build, reusing existing image %s", status.Image)
+		l.Infof("No new image to build, reusing existing image %s", status.Image)
 
 		return *status
 	}
 	mavenDir := strings.ReplaceAll(contextDir, ContextDir, "maven")
 
-	log.Debugf("Registry address: %s", t.task.Registry.Address)
-	log.Debugf("Base image: %s", status.BaseImage)
+	l.Debugf("Registry address: %s", t.task.Registry.Address)
+	l.Debugf("Base image: %s", status.BaseImage)
 
 	registryConfigDir := ""
 	if t.task.Registry.Secret != "" {
@@ -88,6 +89,15 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 		}
 	}
 
+	// Jib plugin resolution relies on the shared Maven local repository, when configured
+	release, err := acquireBuildMavenCache(ctx, t.build)
+	if err != nil {
+		_ = cleanRegistryConfig(registryConfigDir)
+
+		return status.Failed(err)
+	}
+	defer release()
+
 	mavenArgs := buildJibMavenArgs(mavenDir, t.task.Image, status.BaseImage, t.task.Registry.Insecure, t.task.Configuration.ImagePlatforms)
 	mvnCmd := "./mvnw"
 	if c, ok := os.LookupEnv("MAVEN_CMD"); ok {
@@ -99,15 +109,16 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 	cmd.Env = append(cmd.Env, fmt.Sprintf("XDG_CONFIG_HOME=%s/jib", mavenDir))
 	cmd.Dir = mavenDir
 
-	myerror := util.RunAndLog(ctx, cmd, maven.LogHandler, maven.LogHandler)
+	handler := maven.NewLogHandler(l)
+	myerror := util.RunAndLog(ctx, cmd, handler, handler)
 
 	if myerror != nil {
-		log.Errorf(myerror, "jib integration image containerization did not run successfully")
+		l.Errorf(myerror, "jib integration image containerization did not run successfully")
 		_ = cleanRegistryConfig(registryConfigDir)
 
 		return status.Failed(myerror)
 	} else {
-		log.Debug("jib integration image containerization did run successfully")
+		l.Debug("jib integration image containerization did run successfully")
 		status.Image = t.task.Image
 
 		// retrieve image digest
@@ -118,6 +129,24 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 			return status.Failed(errDigest)
 		}
 		status.Digest = string(mavenDigest)
+
+		if t.task.PushSBOM {
+			if err := t.pushSBOM(ctx, l, filepath.Join(filepath.Dir(contextDir), SBOMDir), status.Digest); err != nil {
+				_ = cleanRegistryConfig(registryConfigDir)
+
+				return status.Failed(err)
+			}
+		}
+
+		if t.task.Signing != nil {
+			signature, err := signImage(ctx, l, t.c, t.build.Namespace, t.task, filepath.Dir(contextDir), status.Digest)
+			if err != nil {
+				_ = cleanRegistryConfig(registryConfigDir)
+
+				return status.Failed(err)
+			}
+			status.Signature = signature
+		}
 	}
 
 	if registryConfigDir != "" {
@@ -129,6 +158,30 @@ func (t *jibTask) Do(ctx context.Context) v1.BuildStatus {
 	return *status
 }
 
+// pushSBOM pushes the Software Bill of Materials generated by the package task as an OCI artifact,
+// tagged next to the image following the cosign convention.
+func (t *jibTask) pushSBOM(ctx context.Context, l log.Logger, sbomDir string, imageDigest string) error {
+	for _, format := range v1.SBOMFormats {
+		content, err := os.ReadFile(filepath.Join(sbomDir, SBOMFileFor(format)))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		ref, err := registry.PushArtifact(ctx, t.task.Image, registry.ArtifactTag(imageDigest, "sbom"), SBOMMediaTypeFor(format),
+			content, nil, t.task.Registry.Insecure)
+		if err != nil {
+			return err
+		}
+		l.Infof("Software Bill of Materials pushed to %s", ref)
+
+		return nil
+	}
+	l.Infof("No Software Bill of Materials found in %s, skipping push", sbomDir)
+
+	return nil
+}
+
 func cleanRegistryConfig(registryConfigDir string) error {
 	if err := os.Unsetenv(jib.JibRegistryConfigEnvVar); err != nil {
 		return err
@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/io"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+// localProjectSteps are the project generation steps that can run out of any cluster.
+var localProjectSteps = []Step{
+	Quarkus.GenerateQuarkusProject,
+	Project.GenerateProjectSettings,
+	Project.InjectDependencies,
+	Project.SanitizeDependencies,
+	Project.InjectProfiles,
+}
+
+// LocalProjectOptions holds the Maven configuration provided locally, in place of the one resolved from the cluster.
+type LocalProjectOptions struct {
+	// the Maven user settings
+	UserSettings []byte
+	// the Maven profiles, serialized as XML
+	Profiles []string
+}
+
+// GenerateLocalProject runs the project generation steps of the task against the given catalog, without
+// requiring any cluster, and writes the resulting Maven project and settings into the given directory.
+func GenerateLocalProject(ctx context.Context, task v1.BuilderTask, catalog *camel.RuntimeCatalog, dir string, options LocalProjectOptions) (*maven.Project, error) {
+	c := builderContext{
+		C:       ctx,
+		Catalog: catalog,
+		Build:   task,
+		Path:    dir,
+	}
+	c.Maven.UserSettings = options.UserSettings
+
+	for _, step := range localProjectSteps {
+		if err := step.execute(&c); err != nil {
+			return nil, fmt.Errorf("project generation step %s failed: %w", step.ID(), err)
+		}
+	}
+	for _, profile := range options.Profiles {
+		if err := addMavenProfile(&c.Maven.Project, profile); err != nil {
+			return nil, fmt.Errorf("could not load Maven profile: %w", err)
+		}
+	}
+
+	if err := os.MkdirAll(dir, io.FilePerm755); err != nil {
+		return nil, err
+	}
+	mc := maven.NewContext(dir)
+	mc.GlobalSettings = c.Maven.GlobalSettings
+	mc.UserSettings = c.Maven.UserSettings
+	mc.SettingsSecurity = c.Maven.SettingsSecurity
+	mc.AdditionalArguments = task.Maven.CLIOptions
+	cmd := c.Maven.Project.Command(mc)
+	if err := cmd.DoSettings(ctx); err != nil {
+		return nil, fmt.Errorf("failure while generating Maven settings: %w", err)
+	}
+	if err := cmd.DoPom(ctx); err != nil {
+		return nil, fmt.Errorf("failure while generating pom file: %w", err)
+	}
+
+	return &c.Maven.Project, nil
+}
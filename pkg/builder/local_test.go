@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
+
+func TestGenerateLocalProject(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	dir := filepath.Join(t.TempDir(), "project")
+	settings := []byte(`<settings><localRepository>/tmp/repo</localRepository></settings>`)
+
+	project, err := GenerateLocalProject(context.TODO(), v1.BuilderTask{
+		Runtime:      catalog.Runtime,
+		Dependencies: []string{"camel:timer", "camel:timer"},
+	}, catalog, dir, LocalProjectOptions{
+		UserSettings: settings,
+		Profiles:     []string{`<profile><id>my-profile</id></profile>`},
+	})
+	require.NoError(t, err)
+
+	// the dependencies are sanitized
+	timer := 0
+	for _, d := range project.Dependencies {
+		if d.ArtifactID == "camel-quarkus-timer" {
+			timer++
+		}
+	}
+	assert.Equal(t, 1, timer)
+	ids := make([]string, 0, len(project.Profiles))
+	for _, p := range project.Profiles {
+		ids = append(ids, p.ID)
+	}
+	assert.Contains(t, ids, "my-profile")
+
+	assert.FileExists(t, filepath.Join(dir, "pom.xml"))
+	assert.FileExists(t, filepath.Join(dir, "settings.xml"))
+	userSettings, err := os.ReadFile(filepath.Join(dir, "user-settings.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, settings, userSettings)
+	config, err := os.ReadFile(filepath.Join(dir, ".mvn", "maven.config"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "--settings\n"+filepath.Join(dir, "user-settings.xml"))
+}
+
+func TestGenerateLocalProjectInvalidProfile(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+
+	_, err = GenerateLocalProject(context.TODO(), v1.BuilderTask{
+		Runtime: catalog.Runtime,
+	}, catalog, t.TempDir(), LocalProjectOptions{
+		Profiles: []string{`<profile>`},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not load Maven profile")
+}
@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+// The keys of the values attached to the build task traces, so that they can be filtered out of the operator
+// logs when the build runs as a routine, and grouped by task and phase.
+const (
+	LogBuildKey     = "build"
+	LogNamespaceKey = "ns"
+	LogTaskKey      = "task"
+	LogPhaseKey     = "phase"
+	LogStepKey      = "step"
+)
+
+var phaseNames = []struct {
+	phase int32
+	name  string
+}{
+	{ApplicationPublishPhase, "application-publish"},
+	{ApplicationPackagePhase, "application-package"},
+	{ProjectBuildPhase, "project-build"},
+	{ProjectGenerationPhase, "project-generation"},
+	{InitPhase, "init"},
+}
+
+// PhaseName returns the name of the phase a step belongs to. The steps registered in between two phases,
+// e.g., ApplicationPackagePhase-1, belong to the preceding one.
+func PhaseName(phase int32) string {
+	for _, p := range phaseNames {
+		if phase >= p.phase {
+			return p.name
+		}
+	}
+
+	return phaseNames[len(phaseNames)-1].name
+}
+
+// taskLogger returns the logger of the given build task.
+func taskLogger(l log.Logger, build *v1.Build, task string) log.Logger {
+	return l.WithValues(LogNamespaceKey, build.Namespace, LogBuildKey, build.Name, LogTaskKey, task)
+}
@@ -0,0 +1,318 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+const (
+	// MavenCacheDir is the path where the shared Maven local repository is mounted in the builder Pod.
+	MavenCacheDir = "/builder/maven-repository"
+	// MavenCacheDefaultPersistentVolumeClaim is the default name of the PersistentVolumeClaim holding the shared Maven local repository.
+	MavenCacheDefaultPersistentVolumeClaim = "camel-k-maven-repository"
+	// MavenCacheDefaultSize is the default size of the PersistentVolumeClaim holding the shared Maven local repository.
+	MavenCacheDefaultSize = "10Gi"
+
+	// mavenCacheControlDir is the directory, within the shared Maven local repository, used to coordinate the builder Pods.
+	mavenCacheControlDir   = ".camel-k"
+	mavenCacheLeasesDir    = "leases"
+	mavenCacheEvictionLock = "eviction.lock"
+	// leases and locks older than their timeout are considered left over by a builder Pod that terminated abruptly.
+	mavenCacheLeaseTimeout        = time.Hour
+	mavenCacheEvictionLockTimeout = 15 * time.Minute
+	mavenCachePollInterval        = 2 * time.Second
+)
+
+// mavenCacheLeaseRenewInterval is the interval at which a builder Pod renews its lease while the build is running,
+// so that it does not expire for long builds.
+var mavenCacheLeaseRenewInterval = mavenCacheLeaseTimeout / 4
+
+func init() {
+	registerSteps(MavenCache)
+}
+
+type mavenCacheSteps struct {
+	EvictLocalRepository Step
+}
+
+// MavenCache contains the steps dealing with the Maven local repository shared across builder Pods.
+var MavenCache = mavenCacheSteps{
+	EvictLocalRepository: NewStep(InitPhase-1, evictMavenCache),
+}
+
+// evictMavenCache evicts the shared Maven local repository when it exceeds its maximum size.
+// The eviction is skipped when another builder Pod is either evicting, or using, the Maven local repository.
+func evictMavenCache(ctx *builderContext) error {
+	cache := ctx.Build.Maven.Cache
+	if cache == nil || cache.EvictionPolicy == "" || cache.EvictionPolicy == v1.MavenCacheEvictionPolicyNone {
+		return nil
+	}
+	maxSize, err := MavenCacheMaxSize(cache)
+	if err != nil {
+		return err
+	}
+
+	locked, err := lockMavenCache(MavenCacheDir)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		log.Infof("Maven local repository eviction already in progress, skipping")
+
+		return nil
+	}
+	defer unlockMavenCache(MavenCacheDir)
+
+	leases, err := activeMavenCacheLeases(MavenCacheDir)
+	if err != nil {
+		return err
+	}
+	if len(leases) > 0 {
+		log.Infof("Maven local repository in use by %d other builds, skipping eviction", len(leases))
+
+		return nil
+	}
+
+	freed, err := maven.EvictLocalRepository(MavenCacheDir, maxSize, cache.EvictionPolicy, mavenCacheControlDir)
+	if err != nil {
+		return err
+	}
+	if freed > 0 {
+		log.Infof("Evicted %d bytes from Maven local repository using %s policy", freed, cache.EvictionPolicy)
+	}
+
+	return nil
+}
+
+// MavenCacheMaxSize returns the size, in bytes, the shared Maven local repository is allowed to grow up to.
+func MavenCacheMaxSize(cache *v1.MavenCacheSpec) (int64, error) {
+	if cache.MaxSize != "" {
+		maxSize, err := apiresource.ParseQuantity(cache.MaxSize)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse Maven cache max size %s: %w", cache.MaxSize, err)
+		}
+
+		return maxSize.Value(), nil
+	}
+
+	size := cache.Size
+	if size == "" {
+		size = MavenCacheDefaultSize
+	}
+	sizeQty, err := apiresource.ParseQuantity(size)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse Maven cache size %s: %w", size, err)
+	}
+
+	// leave some room for the artifacts downloaded by the builds running until the next eviction
+	return sizeQty.Value() / 10 * 8, nil
+}
+
+// acquireMavenCache registers a lease on the shared Maven local repository located in dir, so that it is not evicted
+// while in use, waiting for any ongoing eviction to complete. The lease is renewed until the returned function,
+// releasing it, is called.
+func acquireMavenCache(ctx context.Context, dir string) (func(), error) {
+	lease, err := mavenCacheLease(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(lease), 0o775); err != nil {
+		return nil, err
+	}
+
+	for {
+		// the lease must be registered before checking the eviction lock, as the eviction
+		// registers the lock before checking the leases
+		if err := os.WriteFile(lease, []byte(time.Now().Format(time.RFC3339)), 0o664); err != nil {
+			return nil, err
+		}
+		locked, err := isMavenCacheLocked(dir)
+		if err != nil {
+			_ = os.Remove(lease)
+
+			return nil, err
+		}
+		if !locked {
+			stop := make(chan struct{})
+			done := make(chan struct{})
+			go renewMavenCacheLease(ctx, lease, stop, done)
+
+			return func() {
+				close(stop)
+				<-done
+				if err := os.Remove(lease); err != nil && !os.IsNotExist(err) {
+					log.Errorf(err, "could not release Maven local repository lease %s", lease)
+				}
+			}, nil
+		}
+
+		_ = os.Remove(lease)
+		log.Infof("Waiting for Maven local repository eviction to complete")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(mavenCachePollInterval):
+		}
+	}
+}
+
+// renewMavenCacheLease periodically refreshes the modification time of the lease, until stop is closed.
+func renewMavenCacheLease(ctx context.Context, lease string, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(mavenCacheLeaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			if err := os.Chtimes(lease, now, now); err != nil {
+				log.Errorf(err, "could not renew Maven local repository lease %s", lease)
+			}
+		}
+	}
+}
+
+// acquireBuildMavenCache registers a lease on the shared Maven local repository, if configured for the given Build.
+func acquireBuildMavenCache(ctx context.Context, build *v1.Build) (func(), error) {
+	task, ok := v1.FindBuilderTask(build.Spec.Tasks)
+	if !ok || task.Maven.Cache == nil {
+		return func() {}, nil
+	}
+
+	return acquireMavenCache(ctx, MavenCacheDir)
+}
+
+func mavenCacheLease(dir string) (string, error) {
+	// the hostname defaults to the builder Pod name
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, mavenCacheControlDir, mavenCacheLeasesDir, hostname), nil
+}
+
+// activeMavenCacheLeases returns the leases registered by other builder Pods, cleaning up the expired ones.
+func activeMavenCacheLeases(dir string) ([]string, error) {
+	own, err := mavenCacheLease(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, mavenCacheControlDir, mavenCacheLeasesDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	leases := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lease := filepath.Join(dir, mavenCacheControlDir, mavenCacheLeasesDir, entry.Name())
+		if lease == own {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// released in the meantime
+				continue
+			}
+
+			return nil, err
+		}
+		if time.Since(info.ModTime()) > mavenCacheLeaseTimeout {
+			log.Infof("Removing expired Maven local repository lease %s", entry.Name())
+			_ = os.Remove(lease)
+
+			continue
+		}
+		leases = append(leases, entry.Name())
+	}
+
+	return leases, nil
+}
+
+// lockMavenCache atomically creates the eviction lock, returning false if it is already held by another builder Pod.
+func lockMavenCache(dir string) (bool, error) {
+	if err := os.MkdirAll(filepath.Join(dir, mavenCacheControlDir), 0o775); err != nil {
+		return false, err
+	}
+	lock := filepath.Join(dir, mavenCacheControlDir, mavenCacheEvictionLock)
+	// an expired lock is removed by isMavenCacheLocked
+	if locked, err := isMavenCacheLocked(dir); err != nil || locked {
+		return false, err
+	}
+	f, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o664)
+	if err != nil {
+		if os.IsExist(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, f.Close()
+}
+
+func unlockMavenCache(dir string) {
+	lock := filepath.Join(dir, mavenCacheControlDir, mavenCacheEvictionLock)
+	if err := os.Remove(lock); err != nil && !os.IsNotExist(err) {
+		log.Errorf(err, "could not release Maven local repository eviction lock %s", lock)
+	}
+}
+
+func isMavenCacheLocked(dir string) (bool, error) {
+	lock := filepath.Join(dir, mavenCacheControlDir, mavenCacheEvictionLock)
+	info, err := os.Stat(lock)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+	if time.Since(info.ModTime()) > mavenCacheEvictionLockTimeout {
+		log.Infof("Removing expired Maven local repository eviction lock")
+		if err := os.Remove(lock); err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
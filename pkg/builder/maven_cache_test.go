@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMavenCacheMaxSize(t *testing.T) {
+	maxSize, err := MavenCacheMaxSize(&v1.MavenCacheSpec{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8589934592), maxSize)
+
+	maxSize, err = MavenCacheMaxSize(&v1.MavenCacheSpec{Size: "1Gi"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(858993456), maxSize)
+
+	maxSize, err = MavenCacheMaxSize(&v1.MavenCacheSpec{Size: "1Gi", MaxSize: "500Mi"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(524288000), maxSize)
+
+	_, err = MavenCacheMaxSize(&v1.MavenCacheSpec{MaxSize: "lots"})
+	require.Error(t, err)
+}
+
+func TestMavenCacheLease(t *testing.T) {
+	dir := t.TempDir()
+
+	release, err := acquireMavenCache(context.TODO(), dir)
+	require.NoError(t, err)
+	lease, err := mavenCacheLease(dir)
+	require.NoError(t, err)
+	assert.FileExists(t, lease)
+
+	// the own lease does not prevent the eviction
+	leases, err := activeMavenCacheLeases(dir)
+	require.NoError(t, err)
+	assert.Empty(t, leases)
+
+	// a lease held by another builder does
+	other := filepath.Join(dir, mavenCacheControlDir, mavenCacheLeasesDir, "camel-k-other-builder")
+	require.NoError(t, os.WriteFile(other, []byte{}, 0o600))
+	leases, err = activeMavenCacheLeases(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"camel-k-other-builder"}, leases)
+
+	// unless it is expired
+	expired := time.Now().Add(-2 * mavenCacheLeaseTimeout)
+	require.NoError(t, os.Chtimes(other, expired, expired))
+	leases, err = activeMavenCacheLeases(dir)
+	require.NoError(t, err)
+	assert.Empty(t, leases)
+	assert.NoFileExists(t, other)
+
+	release()
+	assert.NoFileExists(t, lease)
+}
+
+func TestMavenCacheLeaseRenewal(t *testing.T) {
+	dir := t.TempDir()
+	interval := mavenCacheLeaseRenewInterval
+	mavenCacheLeaseRenewInterval = 10 * time.Millisecond
+	defer func() {
+		mavenCacheLeaseRenewInterval = interval
+	}()
+
+	release, err := acquireMavenCache(context.TODO(), dir)
+	require.NoError(t, err)
+	lease, err := mavenCacheLease(dir)
+	require.NoError(t, err)
+	expired := time.Now().Add(-2 * mavenCacheLeaseTimeout)
+	require.NoError(t, os.Chtimes(lease, expired, expired))
+
+	// the lease is renewed while the build is running
+	assert.Eventually(t, func() bool {
+		info, err := os.Stat(lease)
+		return err == nil && time.Since(info.ModTime()) < mavenCacheLeaseTimeout
+	}, 5*time.Second, 10*time.Millisecond)
+
+	release()
+	assert.NoFileExists(t, lease)
+}
+
+func TestMavenCacheEvictionLock(t *testing.T) {
+	dir := t.TempDir()
+
+	locked, err := lockMavenCache(dir)
+	require.NoError(t, err)
+	assert.True(t, locked)
+
+	locked, err = lockMavenCache(dir)
+	require.NoError(t, err)
+	assert.False(t, locked)
+
+	// builds wait for the eviction to complete
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	_, err = acquireMavenCache(ctx, dir)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	lease, err := mavenCacheLease(dir)
+	require.NoError(t, err)
+	assert.NoFileExists(t, lease)
+
+	unlockMavenCache(dir)
+	release, err := acquireMavenCache(context.TODO(), dir)
+	require.NoError(t, err)
+	release()
+}
+
+func TestMavenCacheExpiredEvictionLock(t *testing.T) {
+	dir := t.TempDir()
+
+	locked, err := lockMavenCache(dir)
+	require.NoError(t, err)
+	assert.True(t, locked)
+	expired := time.Now().Add(-2 * mavenCacheEvictionLockTimeout)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, mavenCacheControlDir, mavenCacheEvictionLock), expired, expired))
+
+	locked, err = lockMavenCache(dir)
+	require.NoError(t, err)
+	assert.True(t, locked)
+	unlockMavenCache(dir)
+}
+
+func TestNewMavenContextWithCache(t *testing.T) {
+	ctx := &builderContext{
+		Path: t.TempDir(),
+		Build: v1.BuilderTask{
+			Maven: v1.MavenBuildSpec{
+				MavenSpec: v1.MavenSpec{
+					LocalRepository: "/etc/maven/m2",
+					CLIOptions:      []string{"-V"},
+					Cache:           &v1.MavenCacheSpec{Enabled: true},
+				},
+			},
+		},
+	}
+
+	mc := newMavenContext(ctx)
+	assert.Equal(t, MavenCacheDir, mc.LocalRepository)
+	assert.Equal(t, []string{
+		"-V",
+		"-Dmaven.repo.local.tail=/etc/maven/m2",
+		"-Daether.syncContext.named.factory=file-lock",
+		"-Daether.syncContext.named.nameMapper=file-gav",
+	}, mc.AdditionalArguments)
+	assert.Equal(t, []string{"-V"}, ctx.Build.Maven.CLIOptions)
+}
@@ -140,14 +140,23 @@ func injectProfiles(ctx *builderContext) error {
 				return fmt.Errorf("could not load profile : %s: %w. ", p.String(), err)
 			}
 			if val != "" {
-				profile := maven.Profile{}
-				if err := xml.Unmarshal([]byte(val), &profile); err != nil {
+				if err := addMavenProfile(&ctx.Maven.Project, val); err != nil {
 					return err
 				}
-				ctx.Maven.Project.AddProfile(profile)
 			}
 		}
 	}
 
 	return nil
 }
+
+// addMavenProfile adds the Maven profile, serialized as XML, to the project.
+func addMavenProfile(project *maven.Project, content string) error {
+	profile := maven.Profile{}
+	if err := xml.Unmarshal([]byte(content), &profile); err != nil {
+		return err
+	}
+	project.AddProfile(profile)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	utilio "github.com/apache/camel-k/v2/pkg/util/io"
+)
+
+const (
+	// ProvenanceDir is the directory used to store the provenance predicate, out of the image context.
+	ProvenanceDir = "provenance"
+	// ProvenancePredicateFile is the file name of the provenance predicate.
+	ProvenancePredicateFile = "predicate.json"
+	// SLSAProvenancePredicateType is the type of the SLSA provenance predicate.
+	SLSAProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// ProvenanceBuildType is the type of the builds described by the provenance predicate.
+	ProvenanceBuildType = "https://camel.apache.org/camel-k/build/v1"
+	// ProvenanceBuilderID is the identifier of the builder described by the provenance predicate.
+	ProvenanceBuilderID = "https://camel.apache.org/camel-k"
+)
+
+func init() {
+	registerSteps(Provenance)
+}
+
+type provenanceSteps struct {
+	GenerateProvenance Step
+}
+
+// Provenance used to export the steps available to generate a provenance attestation.
+var Provenance = provenanceSteps{
+	GenerateProvenance: NewStep(ApplicationPackagePhase+2, generateProvenance),
+}
+
+type slsaProvenance struct {
+	BuildDefinition slsaBuildDefinition `json:"buildDefinition"`
+	RunDetails      slsaRunDetails      `json:"runDetails"`
+}
+
+type slsaBuildDefinition struct {
+	BuildType            string                   `json:"buildType"`
+	ExternalParameters   map[string]any           `json:"externalParameters"`
+	InternalParameters   map[string]any           `json:"internalParameters,omitempty"`
+	ResolvedDependencies []slsaResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+type slsaResourceDescriptor struct {
+	URI    string            `json:"uri,omitempty"`
+	Name   string            `json:"name,omitempty"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+type slsaRunDetails struct {
+	Builder  slsaBuilder       `json:"builder"`
+	Metadata slsaBuildMetadata `json:"metadata"`
+}
+
+type slsaBuilder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type slsaBuildMetadata struct {
+	FinishedOn string `json:"finishedOn,omitempty"`
+}
+
+// generateProvenance writes the SLSA provenance predicate describing how the application is built, out of the
+// Integration digest, the Camel runtime and the dependencies computed by the previous steps. The predicate is completed
+// with the image digest and signed by the publishing task. It is stored out of the image context.
+func generateProvenance(ctx *builderContext) error {
+	if ctx.Build.Provenance == nil {
+		return nil
+	}
+
+	components, err := sbomComponents(ctx.Artifacts)
+	if err != nil {
+		return err
+	}
+
+	predicate := slsaProvenance{
+		BuildDefinition: slsaBuildDefinition{
+			BuildType: ProvenanceBuildType,
+			ExternalParameters: map[string]any{
+				"integrationDigest": ctx.Build.Provenance.IntegrationDigest,
+				"dependencies":      ctx.Build.Dependencies,
+			},
+			InternalParameters: map[string]any{
+				"runtimeProvider": ctx.Build.Runtime.Provider,
+				"runtimeVersion":  ctx.Build.Runtime.Version,
+				"baseImage":       ctx.BaseImage,
+			},
+			ResolvedDependencies: make([]slsaResourceDescriptor, 0, len(components)),
+		},
+		RunDetails: slsaRunDetails{
+			Builder: slsaBuilder{
+				ID:      ProvenanceBuilderID,
+				Version: map[string]string{"camel-k": defaults.Version},
+			},
+			Metadata: slsaBuildMetadata{
+				FinishedOn: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	}
+	for _, c := range components {
+		dependency := slsaResourceDescriptor{
+			URI: c.PURL(),
+		}
+		if dependency.URI == "" {
+			dependency.Name = c.ArtifactID
+		}
+		if c.SHA1 != "" {
+			dependency.Digest = map[string]string{"sha1": c.SHA1}
+		}
+		predicate.BuildDefinition.ResolvedDependencies = append(predicate.BuildDefinition.ResolvedDependencies, dependency)
+	}
+
+	data, err := json.Marshal(predicate)
+	if err != nil {
+		return err
+	}
+	provenanceDir := filepath.Join(ctx.Path, ProvenanceDir)
+	if err := os.MkdirAll(provenanceDir, utilio.FilePerm755); err != nil {
+		return err
+	}
+
+	return util.WriteFileWithContent(filepath.Join(provenanceDir, ProvenancePredicateFile), data)
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+func TestGenerateProvenance(t *testing.T) {
+	ctx := newSBOMTestContext(t, v1.SBOMFormatCycloneDX)
+	ctx.Build.Dependencies = []string{"camel:timer", "camel:log"}
+	ctx.Build.Provenance = &v1.ProvenanceSpec{IntegrationDigest: "my-digest"}
+	ctx.BaseImage = "eclipse-temurin:17"
+	require.NoError(t, generateProvenance(ctx))
+
+	content, err := util.ReadFile(filepath.Join(ctx.Path, ProvenanceDir, ProvenancePredicateFile))
+	require.NoError(t, err)
+	var predicate slsaProvenance
+	require.NoError(t, json.Unmarshal(content, &predicate))
+
+	assert.Equal(t, ProvenanceBuildType, predicate.BuildDefinition.BuildType)
+	assert.Equal(t, "my-digest", predicate.BuildDefinition.ExternalParameters["integrationDigest"])
+	assert.Equal(t, []any{"camel:timer", "camel:log"}, predicate.BuildDefinition.ExternalParameters["dependencies"])
+	assert.Equal(t, "3.15.3", predicate.BuildDefinition.InternalParameters["runtimeVersion"])
+	assert.Equal(t, "eclipse-temurin:17", predicate.BuildDefinition.InternalParameters["baseImage"])
+	assert.Equal(t, ProvenanceBuilderID, predicate.RunDetails.Builder.ID)
+
+	require.Len(t, predicate.BuildDefinition.ResolvedDependencies, 2)
+	var uris []string
+	for _, d := range predicate.BuildDefinition.ResolvedDependencies {
+		assert.Len(t, d.Digest["sha1"], 40)
+		uris = append(uris, d.URI+d.Name)
+	}
+	assert.ElementsMatch(t, []string{"pkg:maven/org.apache.camel/camel-core@4.8.0", "com.example.no-pom"}, uris)
+}
+
+func TestGenerateProvenanceNotRequired(t *testing.T) {
+	ctx := newSBOMTestContext(t, v1.SBOMFormatCycloneDX)
+	require.NoError(t, generateProvenance(ctx))
+
+	exists, err := util.DirectoryExists(filepath.Join(ctx.Path, ProvenanceDir))
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
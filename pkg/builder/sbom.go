@@ -0,0 +1,436 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"archive/zip"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/magiconair/properties"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	utilio "github.com/apache/camel-k/v2/pkg/util/io"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+const (
+	// SBOMDir is the directory used to store the Software Bill of Materials, out of the image context.
+	SBOMDir = "sbom"
+	// SBOMCycloneDXFile is the file name of the CycloneDX Software Bill of Materials.
+	SBOMCycloneDXFile = "bom.cdx.json"
+	// SBOMSPDXFile is the file name of the SPDX Software Bill of Materials.
+	SBOMSPDXFile = "bom.spdx.json"
+	// SBOMCycloneDXMediaType is the media type of the CycloneDX Software Bill of Materials.
+	SBOMCycloneDXMediaType = "application/vnd.cyclonedx+json"
+	// SBOMSPDXMediaType is the media type of the SPDX Software Bill of Materials.
+	SBOMSPDXMediaType = "application/spdx+json"
+)
+
+// the Maven version starts with a digit, ie, <artifactId>-<version>.jar.
+var jarVersionSeparator = regexp.MustCompile(`-\d`)
+
+func init() {
+	registerSteps(SBOM)
+}
+
+type sbomSteps struct {
+	GenerateSBOM Step
+}
+
+// SBOM used to export the steps available to generate a Software Bill of Materials.
+var SBOM = sbomSteps{
+	GenerateSBOM: NewStep(ApplicationPackagePhase+2, generateSBOM),
+}
+
+// sbomComponent is a Maven dependency listed in the Software Bill of Materials.
+type sbomComponent struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+	// hex encoded SHA-1 of the jar
+	SHA1 string
+}
+
+// PURL returns the package URL of the component.
+func (c sbomComponent) PURL() string {
+	if c.GroupID == "" || c.Version == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("pkg:maven/%s/%s@%s", c.GroupID, c.ArtifactID, c.Version)
+}
+
+// SBOMFileFor returns the file name of the Software Bill of Materials in the given format.
+func SBOMFileFor(format v1.SBOMFormat) string {
+	if format == v1.SBOMFormatSPDX {
+		return SBOMSPDXFile
+	}
+
+	return SBOMCycloneDXFile
+}
+
+// SBOMMediaTypeFor returns the media type of the Software Bill of Materials in the given format.
+func SBOMMediaTypeFor(format v1.SBOMFormat) string {
+	if format == v1.SBOMFormatSPDX {
+		return SBOMSPDXMediaType
+	}
+
+	return SBOMCycloneDXMediaType
+}
+
+// generateSBOM writes the Software Bill of Materials listing the dependencies computed by the previous steps,
+// and adds it to the build artifacts. It is stored out of the image context, so that it is not part of the image.
+func generateSBOM(ctx *builderContext) error {
+	if ctx.Build.SBOM == nil {
+		return nil
+	}
+	format := ctx.Build.SBOM.Format
+	if format == "" {
+		format = v1.SBOMFormatCycloneDX
+	}
+	if err := format.Validate(); err != nil {
+		return err
+	}
+
+	components, err := sbomComponents(ctx.Artifacts)
+	if err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		log.Infof("No dependency found, skipping the Software Bill of Materials generation for %s", ctx.Build.Name)
+
+		return nil
+	}
+
+	var content any
+	switch format {
+	case v1.SBOMFormatSPDX:
+		content = spdxDocument(ctx, components)
+	default:
+		content = cycloneDXDocument(ctx, components)
+	}
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	sbomDir := filepath.Join(ctx.Path, SBOMDir)
+	if err := os.MkdirAll(sbomDir, utilio.FilePerm755); err != nil {
+		return err
+	}
+	file := SBOMFileFor(format)
+	if err := util.WriteFileWithContent(filepath.Join(sbomDir, file), data); err != nil {
+		return err
+	}
+	sha1, err := digest.ComputeSHA1(sbomDir, file)
+	if err != nil {
+		return err
+	}
+
+	// The SBOM has no target, as it is not part of the image
+	ctx.Artifacts = append(ctx.Artifacts, v1.Artifact{
+		ID:       file,
+		Location: filepath.Join(sbomDir, file),
+		Checksum: "sha1:" + sha1,
+	})
+
+	return nil
+}
+
+// sbomComponents returns the Maven dependencies out of the libraries shipped in the image.
+func sbomComponents(artifacts []v1.Artifact) ([]sbomComponent, error) {
+	libDir := filepath.Join(DependenciesDir, "lib") + string(filepath.Separator)
+	components := make([]sbomComponent, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		if !strings.HasPrefix(artifact.Target, libDir) || !strings.HasSuffix(artifact.ID, ".jar") {
+			continue
+		}
+		component, err := sbomComponentFor(artifact)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+func sbomComponentFor(artifact v1.Artifact) (sbomComponent, error) {
+	component := sbomComponent{}
+	if checksum, ok := strings.CutPrefix(artifact.Checksum, "sha1:"); ok {
+		sum, err := base64.StdEncoding.DecodeString(checksum)
+		if err != nil {
+			return component, fmt.Errorf("invalid checksum for artifact %s: %w", artifact.ID, err)
+		}
+		component.SHA1 = hex.EncodeToString(sum)
+	}
+
+	pom, err := readPomProperties(artifact)
+	if err != nil {
+		return component, err
+	}
+	if pom != nil {
+		component.GroupID = pom.GetString("groupId", "")
+		component.ArtifactID = pom.GetString("artifactId", "")
+		component.Version = pom.GetString("version", "")
+
+		return component, nil
+	}
+
+	// Fallback to the jar file name, ie, <groupId>.<artifactId>-<version>.jar, where the group cannot be told apart
+	component.ArtifactID = strings.TrimSuffix(artifact.ID, ".jar")
+	if loc := jarVersionSeparator.FindStringIndex(component.ArtifactID); loc != nil {
+		component.Version = component.ArtifactID[loc[0]+1:]
+		component.ArtifactID = component.ArtifactID[:loc[0]]
+	}
+
+	return component, nil
+}
+
+// readPomProperties reads the Maven coordinates embedded into the jar, if any.
+// As shaded jars may embed the coordinates of several projects, the one matching the jar file name is preferred.
+func readPomProperties(artifact v1.Artifact) (*properties.Properties, error) {
+	if artifact.Location == "" {
+		return nil, nil
+	}
+	jar, err := zip.OpenReader(artifact.Location)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read artifact %s: %w", artifact.ID, err)
+	}
+	defer jar.Close()
+
+	var found *properties.Properties
+	for _, f := range jar.File {
+		if !strings.HasPrefix(f.Name, "META-INF/maven/") || !strings.HasSuffix(f.Name, "/pom.properties") {
+			continue
+		}
+		p, err := loadProperties(f)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read Maven coordinates of artifact %s: %w", artifact.ID, err)
+		}
+		if strings.HasPrefix(artifact.ID, p.GetString("groupId", "")+"."+p.GetString("artifactId", "")+"-") {
+			return p, nil
+		}
+		if found == nil {
+			found = p
+		}
+	}
+
+	return found, nil
+}
+
+func loadProperties(f *zip.File) (*properties.Properties, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return properties.Load(data, properties.ISO_8859_1)
+}
+
+type cycloneDXBOM struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp  string              `json:"timestamp"`
+	Tools      cycloneDXTools      `json:"tools"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cycloneDXComponent struct {
+	Type    string          `json:"type"`
+	BOMRef  string          `json:"bom-ref,omitempty"`
+	Group   string          `json:"group,omitempty"`
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	PURL    string          `json:"purl,omitempty"`
+	Hashes  []cycloneDXHash `json:"hashes,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+func cycloneDXDocument(ctx *builderContext, components []sbomComponent) cycloneDXBOM {
+	bom := cycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + uuid.NewString(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Tools: cycloneDXTools{
+				Components: []cycloneDXComponent{{
+					Type:    "application",
+					Group:   "org.apache.camel.k",
+					Name:    "camel-k",
+					Version: defaults.Version,
+				}},
+			},
+		},
+		Components: make([]cycloneDXComponent, 0, len(components)),
+	}
+	if ctx.Build.Runtime.Version != "" {
+		bom.Metadata.Properties = append(bom.Metadata.Properties,
+			cycloneDXProperty{Name: "camel-k:runtime.provider", Value: string(ctx.Build.Runtime.Provider)},
+			cycloneDXProperty{Name: "camel-k:runtime.version", Value: ctx.Build.Runtime.Version},
+		)
+	}
+
+	for i, c := range components {
+		component := cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  c.PURL(),
+			Group:   c.GroupID,
+			Name:    c.ArtifactID,
+			Version: c.Version,
+			PURL:    c.PURL(),
+		}
+		if component.BOMRef == "" {
+			component.BOMRef = fmt.Sprintf("component-%d", i+1)
+		}
+		if c.SHA1 != "" {
+			component.Hashes = []cycloneDXHash{{Algorithm: "SHA-1", Content: c.SHA1}}
+		}
+		bom.Components = append(bom.Components, component)
+	}
+
+	return bom
+}
+
+type spdxDoc struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	Supplier         string            `json:"supplier"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	Category string `json:"referenceCategory"`
+	Type     string `json:"referenceType"`
+	Locator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	Element        string `json:"spdxElementId"`
+	Type           string `json:"relationshipType"`
+	RelatedElement string `json:"relatedSpdxElement"`
+}
+
+func spdxDocument(ctx *builderContext, components []sbomComponent) spdxDoc {
+	name := "camel-k-integration"
+	if ctx.Build.Runtime.Version != "" {
+		name = fmt.Sprintf("%s-%s-%s", name, ctx.Build.Runtime.Provider, ctx.Build.Runtime.Version)
+	}
+	doc := spdxDoc{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: "https://camel.apache.org/spdx/" + name + "-" + uuid.NewString(),
+		CreationInfo: spdxCreationInfo{
+			Created:  time.Now().UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: camel-k-" + defaults.Version},
+		},
+		Packages:      make([]spdxPackage, 0, len(components)),
+		Relationships: make([]spdxRelationship, 0, len(components)),
+	}
+
+	for i, c := range components {
+		pkg := spdxPackage{
+			Name:             c.ArtifactID,
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", i+1),
+			VersionInfo:      c.Version,
+			Supplier:         "NOASSERTION",
+			DownloadLocation: "NOASSERTION",
+		}
+		if c.SHA1 != "" {
+			pkg.Checksums = []spdxChecksum{{Algorithm: "SHA1", Value: c.SHA1}}
+		}
+		if purl := c.PURL(); purl != "" {
+			pkg.ExternalRefs = []spdxExternalRef{{Category: "PACKAGE-MANAGER", Type: "purl", Locator: purl}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			Element:        doc.SPDXID,
+			Type:           "DESCRIBES",
+			RelatedElement: pkg.SPDXID,
+		})
+	}
+
+	return doc
+}
@@ -0,0 +1,170 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util"
+)
+
+func writeTestJar(t *testing.T, path string, entries map[string]string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range entries {
+		e, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = e.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+}
+
+func newSBOMTestContext(t *testing.T, format v1.SBOMFormat) *builderContext {
+	t.Helper()
+	tmpDir := t.TempDir()
+	quarkusAppDir := filepath.Join(tmpDir, "maven", "target", "quarkus-app")
+	writeTestJar(t, filepath.Join(quarkusAppDir, "lib", "main", "org.apache.camel.camel-core-4.8.0.jar"), map[string]string{
+		"META-INF/maven/org.apache.camel/camel-core/pom.properties": "groupId=org.apache.camel\nartifactId=camel-core\nversion=4.8.0\n",
+		// a shaded dependency
+		"META-INF/maven/org.shaded/shaded/pom.properties": "groupId=org.shaded\nartifactId=shaded\nversion=1.0\n",
+	})
+	writeTestJar(t, filepath.Join(quarkusAppDir, "lib", "boot", "com.example.no-pom-1.2.3.Final.jar"), map[string]string{})
+	writeTestJar(t, filepath.Join(quarkusAppDir, "app", "camel-k-integration-2.11.0.jar"), map[string]string{})
+
+	artifacts, err := processQuarkusTransitiveDependencies(quarkusAppDir)
+	require.NoError(t, err)
+
+	return &builderContext{
+		C:    context.TODO(),
+		Path: tmpDir,
+		Build: v1.BuilderTask{
+			Runtime: v1.RuntimeSpec{
+				Version:  "3.15.3",
+				Provider: v1.RuntimeProviderQuarkus,
+			},
+			SBOM: &v1.SBOMSpec{Format: format},
+		},
+		Artifacts: artifacts,
+	}
+}
+
+func TestGenerateSBOMCycloneDX(t *testing.T) {
+	ctx := newSBOMTestContext(t, v1.SBOMFormatCycloneDX)
+	dependencies := len(ctx.Artifacts)
+	require.NoError(t, generateSBOM(ctx))
+
+	require.Len(t, ctx.Artifacts, dependencies+1)
+	sbom := ctx.Artifacts[dependencies]
+	assert.Equal(t, SBOMCycloneDXFile, sbom.ID)
+	assert.Equal(t, filepath.Join(ctx.Path, SBOMDir, SBOMCycloneDXFile), sbom.Location)
+	assert.Empty(t, sbom.Target)
+	assert.Contains(t, sbom.Checksum, "sha1:")
+
+	content, err := util.ReadFile(sbom.Location)
+	require.NoError(t, err)
+	var bom cycloneDXBOM
+	require.NoError(t, json.Unmarshal(content, &bom))
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Contains(t, bom.Metadata.Properties, cycloneDXProperty{Name: "camel-k:runtime.version", Value: "3.15.3"})
+	require.Len(t, bom.Components, 2)
+
+	components := map[string]cycloneDXComponent{}
+	for _, c := range bom.Components {
+		components[c.Name] = c
+	}
+	core := components["camel-core"]
+	assert.Equal(t, "org.apache.camel", core.Group)
+	assert.Equal(t, "4.8.0", core.Version)
+	assert.Equal(t, "pkg:maven/org.apache.camel/camel-core@4.8.0", core.PURL)
+	require.Len(t, core.Hashes, 1)
+	assert.Equal(t, "SHA-1", core.Hashes[0].Algorithm)
+	assert.Len(t, core.Hashes[0].Content, 40)
+
+	// without embedded Maven coordinates, the group cannot be told apart from the artifact
+	noPom := components["com.example.no-pom"]
+	assert.Empty(t, noPom.Group)
+	assert.Equal(t, "1.2.3.Final", noPom.Version)
+	assert.Empty(t, noPom.PURL)
+	assert.NotEmpty(t, noPom.BOMRef)
+}
+
+func TestGenerateSBOMSPDX(t *testing.T) {
+	ctx := newSBOMTestContext(t, v1.SBOMFormatSPDX)
+	require.NoError(t, generateSBOM(ctx))
+
+	sbom := ctx.Artifacts[len(ctx.Artifacts)-1]
+	assert.Equal(t, SBOMSPDXFile, sbom.ID)
+
+	content, err := util.ReadFile(sbom.Location)
+	require.NoError(t, err)
+	var doc spdxDoc
+	require.NoError(t, json.Unmarshal(content, &doc))
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Equal(t, "camel-k-integration-quarkus-3.15.3", doc.Name)
+	require.Len(t, doc.Packages, 2)
+	require.Len(t, doc.Relationships, 2)
+	for _, p := range doc.Packages {
+		if p.Name == "camel-core" {
+			assert.Equal(t, "4.8.0", p.VersionInfo)
+			assert.Equal(t, []spdxExternalRef{{Category: "PACKAGE-MANAGER", Type: "purl", Locator: "pkg:maven/org.apache.camel/camel-core@4.8.0"}}, p.ExternalRefs)
+		}
+	}
+}
+
+func TestGenerateSBOMNotRequired(t *testing.T) {
+	ctx := newSBOMTestContext(t, v1.SBOMFormatCycloneDX)
+	ctx.Build.SBOM = nil
+	dependencies := len(ctx.Artifacts)
+	require.NoError(t, generateSBOM(ctx))
+
+	assert.Len(t, ctx.Artifacts, dependencies)
+	exists, err := util.DirectoryExists(filepath.Join(ctx.Path, SBOMDir))
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGenerateSBOMWithoutDependencies(t *testing.T) {
+	ctx := &builderContext{
+		C:    context.TODO(),
+		Path: t.TempDir(),
+		Build: v1.BuilderTask{
+			SBOM: &v1.SBOMSpec{},
+		},
+	}
+	require.NoError(t, generateSBOM(ctx))
+	assert.Empty(t, ctx.Artifacts)
+}
+
+func TestGenerateSBOMInvalidFormat(t *testing.T) {
+	ctx := newSBOMTestContext(t, "SWID")
+	require.Error(t, generateSBOM(ctx))
+}
@@ -0,0 +1,126 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/cosign"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/registry"
+)
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	Subject       []inTotoSubject `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// signImage signs the published image with the cosign key stored in the signing Secret, and eventually attaches
+// the provenance attestation generated by the package task. Both are pushed next to the image, following the cosign convention.
+func signImage(ctx context.Context, l log.Logger, c client.Client, namespace string, task *v1.JibTask, buildDir string, imageDigest string) (*v1.ImageSignature, error) {
+	signing := task.Signing
+	if signing.Secret == "" {
+		return nil, fmt.Errorf("no Secret holding the signing key configured for image %s", task.Image)
+	}
+	secret, err := c.CoreV1().Secrets(namespace).Get(ctx, signing.Secret, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	key, ok := secret.Data[cosign.PrivateKeySecretKey]
+	if !ok {
+		return nil, fmt.Errorf("no %s key found in Secret %s", cosign.PrivateKeySecretKey, signing.Secret)
+	}
+	signer, err := cosign.LoadPrivateKey(key, secret.Data[cosign.PasswordSecretKey])
+	if err != nil {
+		return nil, fmt.Errorf("cannot load the signing key from Secret %s: %w", signing.Secret, err)
+	}
+
+	repository, err := registry.Repository(task.Image, task.Registry.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	signature := &v1.ImageSignature{}
+
+	payload, err := cosign.SimpleSigningPayload(repository, imageDigest)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := cosign.Sign(signer, payload)
+	if err != nil {
+		return nil, err
+	}
+	signature.Signature, err = registry.PushArtifact(ctx, task.Image, registry.ArtifactTag(imageDigest, cosign.SignatureTagSuffix),
+		cosign.SimpleSigningMediaType, payload, map[string]string{cosign.SignatureAnnotation: sig}, task.Registry.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	l.Infof("Image signature pushed to %s", signature.Signature)
+
+	if !signing.Provenance {
+		return signature, nil
+	}
+
+	predicate, err := os.ReadFile(filepath.Join(buildDir, ProvenanceDir, ProvenancePredicateFile))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the provenance predicate: %w", err)
+	}
+	algorithm, hex, _ := strings.Cut(imageDigest, ":")
+	statement, err := json.Marshal(inTotoStatement{
+		Type: "https://in-toto.io/Statement/v1",
+		Subject: []inTotoSubject{{
+			Name:   repository,
+			Digest: map[string]string{algorithm: hex},
+		}},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate:     predicate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := cosign.SignEnvelope(signer, cosign.InTotoPayloadType, statement)
+	if err != nil {
+		return nil, err
+	}
+	signature.Provenance, err = registry.PushArtifact(ctx, task.Image, registry.ArtifactTag(imageDigest, cosign.AttestationTagSuffix),
+		cosign.DSSEMediaType, envelope, map[string]string{
+			cosign.SignatureAnnotation:     "",
+			cosign.PredicateTypeAnnotation: SLSAProvenancePredicateType,
+		}, task.Registry.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	l.Infof("Image provenance attestation pushed to %s", signature.Provenance)
+
+	return signature, nil
+}
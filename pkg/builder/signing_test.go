@@ -0,0 +1,144 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/cosign"
+	logutil "github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+func readPushedLayer(t *testing.T, ref string) ([]byte, map[string]string) {
+	t.Helper()
+	digest, err := name.NewDigest(ref, name.Insecure)
+	require.NoError(t, err)
+	img, err := remote.Image(digest)
+	require.NoError(t, err)
+	manifest, err := img.Manifest()
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	r, err := layers[0].Uncompressed()
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return content, manifest.Layers[0].Annotations
+}
+
+func TestSignImage(t *testing.T) {
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	c, err := internal.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cosign"},
+		Data: map[string][]byte{
+			cosign.PrivateKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		},
+	})
+	require.NoError(t, err)
+
+	buildDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(buildDir, ProvenanceDir), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(buildDir, ProvenanceDir, ProvenancePredicateFile), []byte(`{"buildDefinition":{}}`), 0o600))
+
+	imageDigest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	task := &v1.JibTask{
+		PublishTask: v1.PublishTask{
+			Image:    u.Host + "/camel-k/camel-k-kit-123:456",
+			Registry: v1.RegistrySpec{Insecure: true},
+		},
+		Signing: &v1.ImageSigningSpec{Secret: "cosign", Provenance: true},
+	}
+	signature, err := signImage(context.TODO(), logutil.Log, c, "ns", task, buildDir, imageDigest)
+	require.NoError(t, err)
+	require.NotNil(t, signature)
+
+	payload, annotations := readPushedLayer(t, signature.Signature)
+	var simpleSigning map[string]map[string]any
+	require.NoError(t, json.Unmarshal(payload, &simpleSigning))
+	assert.Equal(t, map[string]any{"docker-manifest-digest": imageDigest}, simpleSigning["critical"]["image"])
+	sig, err := base64.StdEncoding.DecodeString(annotations[cosign.SignatureAnnotation])
+	require.NoError(t, err)
+	hash := sha256.Sum256(payload)
+	assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, hash[:], sig))
+
+	attestation, annotations := readPushedLayer(t, signature.Provenance)
+	assert.Equal(t, SLSAProvenancePredicateType, annotations[cosign.PredicateTypeAnnotation])
+	var envelope cosign.Envelope
+	require.NoError(t, json.Unmarshal(attestation, &envelope))
+	statement, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"_type": "https://in-toto.io/Statement/v1",
+		"subject": [{
+			"name": "`+u.Host+`/camel-k/camel-k-kit-123",
+			"digest": {"sha256": "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"}
+		}],
+		"predicateType": "https://slsa.dev/provenance/v1",
+		"predicate": {"buildDefinition": {}}
+	}`, string(statement))
+}
+
+func TestSignImageMissingKey(t *testing.T) {
+	c, err := internal.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cosign"},
+	})
+	require.NoError(t, err)
+
+	task := &v1.JibTask{
+		PublishTask: v1.PublishTask{Image: "registry/camel-k/camel-k-kit-123:456"},
+		Signing:     &v1.ImageSigningSpec{Secret: "cosign"},
+	}
+	_, err = signImage(context.TODO(), logutil.Log, c, "ns", task, t.TempDir(), "sha256:abc")
+	require.Error(t, err)
+	assert.Equal(t, "no cosign.key key found in Secret cosign", err.Error())
+}
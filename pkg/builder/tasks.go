@@ -68,6 +68,12 @@ func (b *Build) Task(task v1.Task) Task {
 			build: b.build,
 			task:  task.Jib,
 		}
+	// Buildpacks tasks are executed by the lifecycle provided in the builder image, not supported in routines
+	case task.Buildpacks != nil:
+		return &unsupportedTask{
+			build: b.build,
+			name:  task.Buildpacks.Name,
+		}
 	}
 
 	return &emptyTask{
@@ -148,6 +154,11 @@ func (b *Build) TaskByName(name string) Task {
 				build: b.build,
 				task:  task.Jib,
 			}
+		case task.Buildpacks != nil && task.Buildpacks.Name == name:
+			return &unsupportedTask{
+				build: b.build,
+				name:  task.Buildpacks.Name,
+			}
 		}
 	}
 
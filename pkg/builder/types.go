@@ -77,6 +77,7 @@ type builderContext struct {
 	client.Client
 
 	C                 context.Context
+	Log               log.Logger
 	Catalog           *camel.RuntimeCatalog
 	Build             v1.BuilderTask
 	BaseImage         string
@@ -85,6 +86,8 @@ type builderContext struct {
 	Artifacts         []v1.Artifact
 	SelectedArtifacts []v1.Artifact
 	Resources         []resource
+	Vulnerabilities   []v1.Vulnerability
+	Conditions        []v1.BuildCondition
 	Maven             struct {
 		Project          maven.Project
 		UserSettings     []byte
@@ -0,0 +1,596 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"archive/zip"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+)
+
+const (
+	// VulnerabilityDatabaseDir is the path where the PersistentVolumeClaim holding the vulnerability database is mounted in the builder Pod.
+	VulnerabilityDatabaseDir = "/builder/vulnerability-database"
+
+	osvEcosystemMaven = "Maven"
+)
+
+func init() {
+	registerSteps(Vulnerabilities)
+}
+
+type vulnerabilitySteps struct {
+	ScanDependencies Step
+}
+
+// Vulnerabilities used to export the steps available to check the dependencies against a vulnerability database.
+var Vulnerabilities = vulnerabilitySteps{
+	ScanDependencies: NewStep(ApplicationPackagePhase-1, scanDependencies),
+}
+
+// osvRecord is the subset of the Open Source Vulnerability format (https://ossf.github.io/osv-schema/) used to match the dependencies.
+type osvRecord struct {
+	ID               string          `json:"id"`
+	Summary          string          `json:"summary,omitempty"`
+	Withdrawn        string          `json:"withdrawn,omitempty"`
+	Affected         []osvAffected   `json:"affected,omitempty"`
+	Severity         []osvSeverity   `json:"severity,omitempty"`
+	DatabaseSpecific osvDatabaseInfo `json:"database_specific,omitempty"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Ranges   []osvRange `json:"ranges,omitempty"`
+	Versions []string   `json:"versions,omitempty"`
+}
+
+type osvRange struct {
+	Type   string     `json:"type"`
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvDatabaseInfo struct {
+	Severity string `json:"severity,omitempty"`
+}
+
+// scanDependencies checks the dependencies computed by the previous steps against the vulnerability database.
+// The vulnerabilities found are reported in the Build status, and the step fails if any of them reaches the failure threshold.
+func scanDependencies(ctx *builderContext) error {
+	scan := ctx.Build.VulnerabilityScan
+	if scan == nil {
+		return nil
+	}
+	threshold := scan.FailureThreshold
+	if threshold == "" {
+		threshold = v1.VulnerabilitySeverityHigh
+	}
+	if err := threshold.Validate(); err != nil {
+		return err
+	}
+
+	components, err := sbomComponents(ctx.Artifacts)
+	if err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		log.Infof("No dependency found, skipping the vulnerability scan for %s", ctx.Build.Name)
+
+		return nil
+	}
+	dependencies := make(map[string][]sbomComponent, len(components))
+	for _, c := range components {
+		if c.GroupID == "" || c.Version == "" {
+			// the coordinates of the dependency cannot be told from the jar
+			continue
+		}
+		name := c.GroupID + ":" + c.ArtifactID
+		dependencies[name] = append(dependencies[name], c)
+	}
+
+	records, err := loadVulnerabilityDatabase(ctx, scan, dependencies)
+	if err != nil {
+		return fmt.Errorf("cannot load the vulnerability database: %w", err)
+	}
+
+	ctx.Vulnerabilities = matchVulnerabilities(records, dependencies)
+
+	failing := 0
+	for _, v := range ctx.Vulnerabilities {
+		// a vulnerability whose severity is unknown cannot be ruled out, but at the lowest threshold
+		if (v.Severity == "" && threshold == v1.VulnerabilitySeverityLow) || (v.Severity != "" && v.Severity.AtLeast(threshold)) {
+			failing++
+		}
+	}
+
+	if failing > 0 {
+		msg := fmt.Sprintf("found %d vulnerabilities in the dependencies, %d of which with a severity of %s or more",
+			len(ctx.Vulnerabilities), failing, threshold)
+		ctx.Conditions = append(ctx.Conditions, v1.BuildCondition{
+			Type:    v1.BuildConditionVulnerabilityScan,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1.BuildConditionVulnerabilitiesFoundReason,
+			Message: msg,
+		})
+
+		return fmt.Errorf("vulnerability scan failed: %s", msg)
+	}
+
+	ctx.Conditions = append(ctx.Conditions, v1.BuildCondition{
+		Type:    v1.BuildConditionVulnerabilityScan,
+		Status:  corev1.ConditionTrue,
+		Reason:  v1.BuildConditionVulnerabilityScanPassedReason,
+		Message: fmt.Sprintf("found %d vulnerabilities in the dependencies, none with a severity of %s or more", len(ctx.Vulnerabilities), threshold),
+	})
+
+	return nil
+}
+
+// loadVulnerabilityDatabase returns the records of the vulnerability database affecting any of the given dependencies.
+func loadVulnerabilityDatabase(ctx *builderContext, scan *v1.VulnerabilityScanSpec, dependencies map[string][]sbomComponent) ([]osvRecord, error) {
+	records := make([]osvRecord, 0)
+	collect := func(source string, data []byte) error {
+		r, err := parseOSVRecords(data)
+		if err != nil {
+			return fmt.Errorf("invalid OSV records in %s: %w", source, err)
+		}
+		for _, record := range r {
+			if record.Withdrawn == "" && record.affects(dependencies) {
+				records = append(records, record)
+			}
+		}
+
+		return nil
+	}
+
+	if scan.ConfigMap != "" {
+		cm := corev1.ConfigMap{}
+		err := ctx.Client.Get(ctx.C, types.NamespacedName{Namespace: ctx.Namespace, Name: scan.ConfigMap}, &cm)
+		if err != nil && k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("ConfigMap %s not found in namespace %s", scan.ConfigMap, ctx.Namespace)
+		} else if err != nil {
+			return nil, err
+		}
+		for key, value := range cm.Data {
+			if err := collect(key, []byte(value)); err != nil {
+				return nil, err
+			}
+		}
+		for key, value := range cm.BinaryData {
+			if err := collect(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if scan.PersistentVolumeClaim != "" {
+		err := filepath.WalkDir(VulnerabilityDatabaseDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch {
+			case d.IsDir():
+				return nil
+			case strings.HasSuffix(path, ".json"):
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+
+				return collect(path, data)
+			case strings.HasSuffix(path, ".zip"):
+				// the OSV database dumps are distributed as zip archives, ie, <ecosystem>/all.zip
+				return readOSVArchive(path, collect)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+func readOSVArchive(path string, collect func(string, []byte) error) error {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	for _, f := range archive.File {
+		if !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+		if err := collect(path+"!"+f.Name, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseOSVRecords parses either a single OSV record, or an array of OSV records.
+func parseOSVRecords(data []byte) ([]osvRecord, error) {
+	trimmed := strings.TrimSpace(string(data))
+	if trimmed == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(trimmed, "[") {
+		var records []osvRecord
+		err := json.Unmarshal([]byte(trimmed), &records)
+
+		return records, err
+	}
+	var record osvRecord
+	if err := json.Unmarshal([]byte(trimmed), &record); err != nil {
+		return nil, err
+	}
+
+	return []osvRecord{record}, nil
+}
+
+// affects tells if the record relates to any of the dependencies, regardless of their version.
+func (r osvRecord) affects(dependencies map[string][]sbomComponent) bool {
+	for _, a := range r.Affected {
+		if _, ok := dependencies[a.Package.Name]; ok && a.Package.Ecosystem == osvEcosystemMaven {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchVulnerabilities returns the vulnerabilities affecting the given dependencies, sorted by decreasing severity.
+func matchVulnerabilities(records []osvRecord, dependencies map[string][]sbomComponent) []v1.Vulnerability {
+	vulnerabilities := make([]v1.Vulnerability, 0)
+	seen := make(map[string]bool)
+	for _, record := range records {
+		for _, a := range record.Affected {
+			if a.Package.Ecosystem != osvEcosystemMaven {
+				continue
+			}
+			for _, c := range dependencies[a.Package.Name] {
+				dependency := a.Package.Name + ":" + c.Version
+				if seen[record.ID+"/"+dependency] || !a.affectsVersion(c.Version) {
+					continue
+				}
+				seen[record.ID+"/"+dependency] = true
+				vulnerabilities = append(vulnerabilities, v1.Vulnerability{
+					ID:         record.ID,
+					Dependency: dependency,
+					Severity:   record.severity(),
+					Summary:    record.Summary,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(vulnerabilities, func(i, j int) bool {
+		si, sj := severityRank(vulnerabilities[i].Severity), severityRank(vulnerabilities[j].Severity)
+		if si != sj {
+			return si > sj
+		}
+		if vulnerabilities[i].Dependency != vulnerabilities[j].Dependency {
+			return vulnerabilities[i].Dependency < vulnerabilities[j].Dependency
+		}
+
+		return vulnerabilities[i].ID < vulnerabilities[j].ID
+	})
+
+	return vulnerabilities
+}
+
+// severityRank ranks the severities, the unknown severity being ranked first as it cannot be ruled out.
+func severityRank(severity v1.VulnerabilitySeverity) int {
+	if severity == "" {
+		return len(v1.VulnerabilitySeverities)
+	}
+
+	for i, s := range v1.VulnerabilitySeverities {
+		if s == severity {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// affectsVersion tells if the given version is listed as affected, or is within any of the affected ranges.
+func (a osvAffected) affectsVersion(version string) bool {
+	for _, v := range a.Versions {
+		if v == version {
+			return true
+		}
+	}
+
+	for _, r := range a.Ranges {
+		if r.Type != "ECOSYSTEM" {
+			continue
+		}
+		events := make([]osvEvent, len(r.Events))
+		copy(events, r.Events)
+		sort.SliceStable(events, func(i, j int) bool {
+			return compareMavenVersions(events[i].version(), events[j].version()) < 0
+		})
+
+		affected := false
+		for _, e := range events {
+			switch {
+			case e.Introduced != "":
+				if e.Introduced == "0" || compareMavenVersions(version, e.Introduced) >= 0 {
+					affected = true
+				}
+			case e.Fixed != "":
+				if compareMavenVersions(version, e.Fixed) >= 0 {
+					affected = false
+				}
+			case e.LastAffected != "":
+				if compareMavenVersions(version, e.LastAffected) > 0 {
+					affected = false
+				}
+			}
+		}
+		if affected {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e osvEvent) version() string {
+	switch {
+	case e.Introduced != "":
+		return e.Introduced
+	case e.Fixed != "":
+		return e.Fixed
+	default:
+		return e.LastAffected
+	}
+}
+
+// severity returns the severity of the record, either as qualified by the database, or out of its CVSS v3 score.
+func (r osvRecord) severity() v1.VulnerabilitySeverity {
+	switch strings.ToUpper(r.DatabaseSpecific.Severity) {
+	case "LOW":
+		return v1.VulnerabilitySeverityLow
+	case "MODERATE", "MEDIUM":
+		return v1.VulnerabilitySeverityMedium
+	case "HIGH":
+		return v1.VulnerabilitySeverityHigh
+	case "CRITICAL":
+		return v1.VulnerabilitySeverityCritical
+	}
+
+	for _, s := range r.Severity {
+		if s.Type != "CVSS_V3" {
+			continue
+		}
+		score, ok := cvss3BaseScore(s.Score)
+		if !ok {
+			continue
+		}
+		switch {
+		case score >= 9.0:
+			return v1.VulnerabilitySeverityCritical
+		case score >= 7.0:
+			return v1.VulnerabilitySeverityHigh
+		case score >= 4.0:
+			return v1.VulnerabilitySeverityMedium
+		case score > 0:
+			return v1.VulnerabilitySeverityLow
+		}
+	}
+
+	return ""
+}
+
+// cvss3BaseScore computes the base score out of a CVSS v3 vector, ie, CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H.
+func cvss3BaseScore(vector string) (float64, bool) {
+	metrics := make(map[string]string)
+	for _, part := range strings.Split(vector, "/") {
+		if k, v, ok := strings.Cut(part, ":"); ok {
+			metrics[k] = v
+		}
+	}
+	if !strings.HasPrefix(metrics["CVSS"], "3") {
+		return 0, false
+	}
+
+	scopeChanged := metrics["S"] == "C"
+	weights := map[string]map[string]float64{
+		"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+		"AC": {"L": 0.77, "H": 0.44},
+		"PR": {"N": 0.85, "L": 0.62, "H": 0.27},
+		"UI": {"N": 0.85, "R": 0.62},
+		"C":  {"H": 0.56, "L": 0.22, "N": 0},
+		"I":  {"H": 0.56, "L": 0.22, "N": 0},
+		"A":  {"H": 0.56, "L": 0.22, "N": 0},
+	}
+	if scopeChanged {
+		weights["PR"]["L"] = 0.68
+		weights["PR"]["H"] = 0.5
+	}
+	w := make(map[string]float64, len(weights))
+	for metric, values := range weights {
+		value, ok := values[metrics[metric]]
+		if !ok {
+			return 0, false
+		}
+		w[metric] = value
+	}
+
+	iss := 1 - (1-w["C"])*(1-w["I"])*(1-w["A"])
+	impact := 6.42 * iss
+	if scopeChanged {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, true
+	}
+	exploitability := 8.22 * w["AV"] * w["AC"] * w["PR"] * w["UI"]
+	score := impact + exploitability
+	if scopeChanged {
+		score *= 1.08
+	}
+
+	return math.Ceil(math.Min(score, 10)*10) / 10, true
+}
+
+// compareMavenVersions compares two Maven versions, following a simplified version of the Maven ordering,
+// where the numeric parts are compared numerically, and the qualifiers according to their well-known ordering.
+func compareMavenVersions(a, b string) int {
+	ta, tb := mavenVersionTokens(a), mavenVersionTokens(b)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		x, y := "", ""
+		if i < len(ta) {
+			x = ta[i]
+		}
+		if i < len(tb) {
+			y = tb[i]
+		}
+		if c := compareMavenVersionTokens(x, y); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func mavenVersionTokens(version string) []string {
+	tokens := make([]string, 0)
+	current := strings.Builder{}
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, strings.ToLower(current.String()))
+			current.Reset()
+		}
+	}
+	for i, r := range version {
+		switch {
+		case r == '.' || r == '-' || r == '_':
+			flush()
+		case i > 0 && isDigit(r) != isDigit(rune(version[i-1])) && version[i-1] != '.' && version[i-1] != '-' && version[i-1] != '_':
+			// the transitions between digits and characters are separators, ie, 1.0rc1
+			flush()
+			current.WriteRune(r)
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// mavenQualifiers ranks the well-known qualifiers, the release being ranked as the empty qualifier.
+var mavenQualifiers = map[string]int{
+	"alpha":     1,
+	"a":         1,
+	"beta":      2,
+	"b":         2,
+	"milestone": 3,
+	"m":         3,
+	"rc":        4,
+	"cr":        4,
+	"snapshot":  5,
+	"":          6,
+	"ga":        6,
+	"final":     6,
+	"release":   6,
+	"sp":        7,
+}
+
+// compareMavenVersionTokens compares two version tokens, the missing tokens being considered as 0 when compared
+// to a number, or as the release otherwise.
+func compareMavenVersionTokens(a, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return cmp.Compare(na, nb)
+	case errA == nil && b == "":
+		return cmp.Compare(na, 0)
+	case errB == nil && a == "":
+		return cmp.Compare(0, nb)
+	case errA == nil:
+		// a number is more recent than a qualifier
+		return 1
+	case errB == nil:
+		return -1
+	}
+
+	qa, knownA := mavenQualifiers[a]
+	qb, knownB := mavenQualifiers[b]
+	switch {
+	case knownA && knownB:
+		return cmp.Compare(qa, qb)
+	case knownA:
+		// unknown qualifiers are more recent than the well-known ones
+		return -1
+	case knownB:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
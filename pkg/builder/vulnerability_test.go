@@ -0,0 +1,254 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const testOSVRecords = `[
+  {
+    "id": "GHSA-0001",
+    "summary": "Remote code execution in camel-core",
+    "affected": [{
+      "package": {"ecosystem": "Maven", "name": "org.apache.camel:camel-core"},
+      "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "4.0.0"}, {"fixed": "4.8.1"}]}]
+    }],
+    "database_specific": {"severity": "%s"}
+  },
+  {
+    "id": "GHSA-0002",
+    "summary": "Fixed vulnerability in camel-core",
+    "affected": [{
+      "package": {"ecosystem": "Maven", "name": "org.apache.camel:camel-core"},
+      "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "4.8.0"}]}]
+    }],
+    "database_specific": {"severity": "CRITICAL"}
+  },
+  {
+    "id": "GHSA-0003",
+    "summary": "Vulnerability in another dependency",
+    "affected": [{
+      "package": {"ecosystem": "Maven", "name": "org.example:other"},
+      "versions": ["4.8.0"]
+    }]
+  }
+]`
+
+func newVulnerabilityTestContext(t *testing.T, severity string, threshold v1.VulnerabilitySeverity) *builderContext {
+	t.Helper()
+	c, err := internal.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "osv",
+		},
+		Data: map[string]string{
+			"records.json": fmt.Sprintf(testOSVRecords, severity),
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := newSBOMTestContext(t, v1.SBOMFormatCycloneDX)
+	ctx.Client = c
+	ctx.Namespace = "ns"
+	ctx.Build.VulnerabilityScan = &v1.VulnerabilityScanSpec{
+		ConfigMap:        "osv",
+		FailureThreshold: threshold,
+	}
+
+	return ctx
+}
+
+func TestScanDependenciesFailure(t *testing.T) {
+	ctx := newVulnerabilityTestContext(t, "HIGH", v1.VulnerabilitySeverityHigh)
+
+	err := scanDependencies(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "found 1 vulnerabilities in the dependencies, 1 of which with a severity of High or more")
+	assert.Equal(t, []v1.Vulnerability{
+		{
+			ID:         "GHSA-0001",
+			Dependency: "org.apache.camel:camel-core:4.8.0",
+			Severity:   v1.VulnerabilitySeverityHigh,
+			Summary:    "Remote code execution in camel-core",
+		},
+	}, ctx.Vulnerabilities)
+	require.Len(t, ctx.Conditions, 1)
+	assert.Equal(t, v1.BuildConditionVulnerabilityScan, ctx.Conditions[0].Type)
+	assert.Equal(t, corev1.ConditionFalse, ctx.Conditions[0].Status)
+	assert.Equal(t, v1.BuildConditionVulnerabilitiesFoundReason, ctx.Conditions[0].Reason)
+}
+
+func TestScanDependenciesBelowThreshold(t *testing.T) {
+	ctx := newVulnerabilityTestContext(t, "MODERATE", v1.VulnerabilitySeverityHigh)
+
+	require.NoError(t, scanDependencies(ctx))
+	require.Len(t, ctx.Vulnerabilities, 1)
+	assert.Equal(t, v1.VulnerabilitySeverityMedium, ctx.Vulnerabilities[0].Severity)
+	require.Len(t, ctx.Conditions, 1)
+	assert.Equal(t, corev1.ConditionTrue, ctx.Conditions[0].Status)
+	assert.Equal(t, v1.BuildConditionVulnerabilityScanPassedReason, ctx.Conditions[0].Reason)
+}
+
+func TestScanDependenciesUnknownSeverity(t *testing.T) {
+	ctx := newVulnerabilityTestContext(t, "UNSCORED", v1.VulnerabilitySeverityMedium)
+
+	require.NoError(t, scanDependencies(ctx))
+	require.Len(t, ctx.Vulnerabilities, 1)
+	assert.Empty(t, ctx.Vulnerabilities[0].Severity)
+	require.Len(t, ctx.Conditions, 1)
+	assert.Equal(t, corev1.ConditionTrue, ctx.Conditions[0].Status)
+
+	ctx = newVulnerabilityTestContext(t, "UNSCORED", v1.VulnerabilitySeverityLow)
+	require.Error(t, scanDependencies(ctx))
+	require.Len(t, ctx.Conditions, 1)
+	assert.Equal(t, corev1.ConditionFalse, ctx.Conditions[0].Status)
+}
+
+func TestScanDependenciesMissingConfigMap(t *testing.T) {
+	ctx := newVulnerabilityTestContext(t, "HIGH", v1.VulnerabilitySeverityHigh)
+	ctx.Build.VulnerabilityScan.ConfigMap = "missing"
+
+	err := scanDependencies(ctx)
+	require.Error(t, err)
+	assert.Equal(t, "cannot load the vulnerability database: ConfigMap missing not found in namespace ns", err.Error())
+	assert.Empty(t, ctx.Conditions)
+}
+
+func TestBuilderTaskVulnerabilityScanConditions(t *testing.T) {
+	ctx := newVulnerabilityTestContext(t, "CRITICAL", v1.VulnerabilitySeverityCritical)
+
+	steps := struct {
+		Scan Step
+	}{
+		Scan: NewStep(ApplicationPackagePhase-1, func(c *builderContext) error {
+			c.Artifacts = ctx.Artifacts
+
+			return scanDependencies(c)
+		}),
+	}
+	registerSteps(steps)
+
+	task := ctx.Build
+	task.Name = "builder"
+	task.BuildDir = ctx.Path
+	task.Steps = StepIDsFor(steps.Scan)
+	build := &v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "build",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{{Builder: &task}},
+		},
+	}
+	build.Status.SetCondition(v1.BuildConditionScheduled, corev1.ConditionTrue, v1.BuildConditionReadyReason, "scheduled")
+
+	status := New(ctx.Client).Build(build).TaskByName("builder").Do(newContext())
+	assert.Equal(t, v1.BuildPhaseFailed, status.Phase)
+	assert.Len(t, status.Vulnerabilities, 1)
+	// the conditions already reported are preserved
+	assert.NotNil(t, status.GetCondition(v1.BuildConditionScheduled))
+	condition := status.GetCondition(v1.BuildConditionVulnerabilityScan)
+	require.NotNil(t, condition)
+	assert.Equal(t, v1.BuildConditionVulnerabilitiesFoundReason, condition.Reason)
+}
+
+func TestOSVSeverity(t *testing.T) {
+	tests := []struct {
+		record   osvRecord
+		severity v1.VulnerabilitySeverity
+	}{
+		{osvRecord{DatabaseSpecific: osvDatabaseInfo{Severity: "LOW"}}, v1.VulnerabilitySeverityLow},
+		{osvRecord{DatabaseSpecific: osvDatabaseInfo{Severity: "MODERATE"}}, v1.VulnerabilitySeverityMedium},
+		{osvRecord{DatabaseSpecific: osvDatabaseInfo{Severity: "critical"}}, v1.VulnerabilitySeverityCritical},
+		{osvRecord{Severity: []osvSeverity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}}}, v1.VulnerabilitySeverityCritical},
+		{osvRecord{Severity: []osvSeverity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:N/A:N"}}}, v1.VulnerabilitySeverityMedium},
+		{osvRecord{Severity: []osvSeverity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:H/I:N/A:N"}}}, v1.VulnerabilitySeverityHigh},
+		{osvRecord{Severity: []osvSeverity{{Type: "CVSS_V4", Score: "CVSS:4.0/AV:N"}}}, ""},
+		{osvRecord{}, ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.severity, test.record.severity())
+	}
+}
+
+func TestCVSS3BaseScore(t *testing.T) {
+	score, ok := cvss3BaseScore("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	assert.True(t, ok)
+	assert.InDelta(t, 9.8, score, 0.001)
+	score, ok = cvss3BaseScore("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H")
+	assert.True(t, ok)
+	assert.InDelta(t, 10.0, score, 0.001)
+	score, ok = cvss3BaseScore("CVSS:3.0/AV:L/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.0, score, 0.001)
+	_, ok = cvss3BaseScore("CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	assert.False(t, ok)
+}
+
+func TestCompareMavenVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0.0", 0},
+		{"1.0.1", "1.0", 1},
+		{"1.10", "1.9", 1},
+		{"2.0.0-RC1", "2.0.0", -1},
+		{"2.0.0-alpha1", "2.0.0-beta1", -1},
+		{"2.0.0rc1", "2.0.0-RC2", -1},
+		{"3.2.1.Final", "3.2.1", 0},
+		{"3.2.1.SP1", "3.2.1.Final", 1},
+		{"1.0-SNAPSHOT", "1.0", -1},
+		{"1.0-jre", "1.0", 1},
+		{"4.8.0", "4.8.1", -1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, compareMavenVersions(test.a, test.b), "%s <=> %s", test.a, test.b)
+	}
+}
+
+func TestOSVAffectsVersion(t *testing.T) {
+	affected := osvAffected{
+		Ranges: []osvRange{
+			{Type: "ECOSYSTEM", Events: []osvEvent{{Introduced: "2.0.0"}, {Fixed: "2.17.1"}, {Introduced: "3.0.0"}, {LastAffected: "3.1.0"}}},
+		},
+		Versions: []string{"1.2.17"},
+	}
+
+	assert.True(t, affected.affectsVersion("1.2.17"))
+	assert.False(t, affected.affectsVersion("1.2.16"))
+	assert.True(t, affected.affectsVersion("2.0.0"))
+	assert.True(t, affected.affectsVersion("2.17.0"))
+	assert.False(t, affected.affectsVersion("2.17.1"))
+	assert.True(t, affected.affectsVersion("3.1.0"))
+	assert.False(t, affected.affectsVersion("3.1.1"))
+}
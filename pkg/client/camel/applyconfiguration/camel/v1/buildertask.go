@@ -41,6 +41,12 @@ type BuilderTaskApplyConfiguration struct {
 	Sources []SourceSpecApplyConfiguration `json:"sources,omitempty"`
 	// the configuration of the project to build on Git
 	Git *GitConfigSpecApplyConfiguration `json:"git,omitempty"`
+	// the configuration of the Software Bill of Materials to generate
+	SBOM *SBOMSpecApplyConfiguration `json:"sbom,omitempty"`
+	// the configuration of the provenance attestation to generate
+	Provenance *ProvenanceSpecApplyConfiguration `json:"provenance,omitempty"`
+	// the configuration of the scan of the dependencies against a vulnerability database
+	VulnerabilityScan *VulnerabilityScanSpecApplyConfiguration `json:"vulnerabilityScan,omitempty"`
 }
 
 // BuilderTaskApplyConfiguration constructs a declarative configuration of the BuilderTask type for use with
@@ -137,3 +143,27 @@ func (b *BuilderTaskApplyConfiguration) WithGit(value *GitConfigSpecApplyConfigu
 	b.Git = value
 	return b
 }
+
+// WithSBOM sets the SBOM field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SBOM field is set to the value of the last call.
+func (b *BuilderTaskApplyConfiguration) WithSBOM(value *SBOMSpecApplyConfiguration) *BuilderTaskApplyConfiguration {
+	b.SBOM = value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *BuilderTaskApplyConfiguration) WithProvenance(value *ProvenanceSpecApplyConfiguration) *BuilderTaskApplyConfiguration {
+	b.Provenance = value
+	return b
+}
+
+// WithVulnerabilityScan sets the VulnerabilityScan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VulnerabilityScan field is set to the value of the last call.
+func (b *BuilderTaskApplyConfiguration) WithVulnerabilityScan(value *VulnerabilityScanSpecApplyConfiguration) *BuilderTaskApplyConfiguration {
+	b.VulnerabilityScan = value
+	return b
+}
@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// BuildpacksTaskApplyConfiguration represents a declarative configuration of the BuildpacksTask type for use
+// with apply.
+//
+// BuildpacksTask is used to configure Cloud Native Buildpacks.
+type BuildpacksTaskApplyConfiguration struct {
+	BaseTaskApplyConfiguration    `json:",inline"`
+	PublishTaskApplyConfiguration `json:",inline"`
+	// the Cloud Native Buildpacks builder image providing the lifecycle and the buildpacks
+	BuilderImage *string `json:"builderImage,omitempty"`
+	// the run image to use as a base for the application image (default, the one provided by the builder)
+	RunImage *string `json:"runImage,omitempty"`
+}
+
+// BuildpacksTaskApplyConfiguration constructs a declarative configuration of the BuildpacksTask type for use with
+// apply.
+func BuildpacksTask() *BuildpacksTaskApplyConfiguration {
+	return &BuildpacksTaskApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithName(value string) *BuildpacksTaskApplyConfiguration {
+	b.BaseTaskApplyConfiguration.Name = &value
+	return b
+}
+
+// WithConfiguration sets the Configuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Configuration field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithConfiguration(value *BuildConfigurationApplyConfiguration) *BuildpacksTaskApplyConfiguration {
+	b.BaseTaskApplyConfiguration.Configuration = value
+	return b
+}
+
+// WithContextDir sets the ContextDir field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContextDir field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithContextDir(value string) *BuildpacksTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.ContextDir = &value
+	return b
+}
+
+// WithBaseImage sets the BaseImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseImage field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithBaseImage(value string) *BuildpacksTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.BaseImage = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithImage(value string) *BuildpacksTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Image = &value
+	return b
+}
+
+// WithRegistry sets the Registry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Registry field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyConfiguration) *BuildpacksTaskApplyConfiguration {
+	b.PublishTaskApplyConfiguration.Registry = value
+	return b
+}
+
+// WithBuilderImage sets the BuilderImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BuilderImage field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithBuilderImage(value string) *BuildpacksTaskApplyConfiguration {
+	b.BuilderImage = &value
+	return b
+}
+
+// WithRunImage sets the RunImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunImage field is set to the value of the last call.
+func (b *BuildpacksTaskApplyConfiguration) WithRunImage(value string) *BuildpacksTaskApplyConfiguration {
+	b.RunImage = &value
+	return b
+}
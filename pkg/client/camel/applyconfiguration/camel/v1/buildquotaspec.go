@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// BuildQuotaSpecApplyConfiguration represents a declarative configuration of the BuildQuotaSpec type for use
+// with apply.
+//
+// BuildQuotaSpec defines the limits on the amount of parallel running builds, so that a single namespace
+// cannot take all the build capacity of the operator.
+type BuildQuotaSpecApplyConfiguration struct {
+	// the maximum amount of parallel running builds for each namespace (no limit if not set)
+	MaxRunningBuildsPerNamespace *int32 `json:"maxRunningBuildsPerNamespace,omitempty"`
+	// the maximum amount of parallel running builds for the given namespaces, overriding `maxRunningBuildsPerNamespace`
+	// (a value of 0 removes the limit for the namespace)
+	Namespaces map[string]int32 `json:"namespaces,omitempty"`
+}
+
+// BuildQuotaSpecApplyConfiguration constructs a declarative configuration of the BuildQuotaSpec type for use with
+// apply.
+func BuildQuotaSpec() *BuildQuotaSpecApplyConfiguration {
+	return &BuildQuotaSpecApplyConfiguration{}
+}
+
+// WithMaxRunningBuildsPerNamespace sets the MaxRunningBuildsPerNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunningBuildsPerNamespace field is set to the value of the last call.
+func (b *BuildQuotaSpecApplyConfiguration) WithMaxRunningBuildsPerNamespace(value int32) *BuildQuotaSpecApplyConfiguration {
+	b.MaxRunningBuildsPerNamespace = &value
+	return b
+}
+
+// WithNamespaces puts the entries into the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Namespaces field,
+// overwriting an existing map entries in Namespaces field with the same key.
+func (b *BuildQuotaSpecApplyConfiguration) WithNamespaces(entries map[string]int32) *BuildQuotaSpecApplyConfiguration {
+	if b.Namespaces == nil && len(entries) > 0 {
+		b.Namespaces = make(map[string]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.Namespaces[k] = v
+	}
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildRetryPolicyApplyConfiguration represents a declarative configuration of the BuildRetryPolicy type for use
+// with apply.
+//
+// BuildRetryPolicy defines how a failed Build is retried.
+type BuildRetryPolicyApplyConfiguration struct {
+	// the maximum number of attempts to recover a failed Build, `0` disabling the recovery (default 5)
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// the delay before the first recovery attempt, doubled after each attempt (default 5s)
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// the maximum delay between two recovery attempts (default 5m)
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// the classes of failure which are retried (default any)
+	RetryOn []camelv1.BuildFailureClass `json:"retryOn,omitempty"`
+}
+
+// BuildRetryPolicyApplyConfiguration constructs a declarative configuration of the BuildRetryPolicy type for use with
+// apply.
+func BuildRetryPolicy() *BuildRetryPolicyApplyConfiguration {
+	return &BuildRetryPolicyApplyConfiguration{}
+}
+
+// WithMaxAttempts sets the MaxAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAttempts field is set to the value of the last call.
+func (b *BuildRetryPolicyApplyConfiguration) WithMaxAttempts(value int32) *BuildRetryPolicyApplyConfiguration {
+	b.MaxAttempts = &value
+	return b
+}
+
+// WithBackoff sets the Backoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backoff field is set to the value of the last call.
+func (b *BuildRetryPolicyApplyConfiguration) WithBackoff(value metav1.Duration) *BuildRetryPolicyApplyConfiguration {
+	b.Backoff = &value
+	return b
+}
+
+// WithMaxBackoff sets the MaxBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxBackoff field is set to the value of the last call.
+func (b *BuildRetryPolicyApplyConfiguration) WithMaxBackoff(value metav1.Duration) *BuildRetryPolicyApplyConfiguration {
+	b.MaxBackoff = &value
+	return b
+}
+
+// WithRetryOn adds the given value to the RetryOn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetryOn field.
+func (b *BuildRetryPolicyApplyConfiguration) WithRetryOn(values ...camelv1.BuildFailureClass) *BuildRetryPolicyApplyConfiguration {
+	for i := range values {
+		b.RetryOn = append(b.RetryOn, values[i])
+	}
+	return b
+}
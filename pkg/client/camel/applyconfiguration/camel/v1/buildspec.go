@@ -50,6 +50,11 @@ type BuildSpecApplyConfiguration struct {
 	// If the Build deadline is exceeded, the Build context is canceled,
 	// and its phase set to BuildPhaseFailed.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// The priority of the Build, used by the `priority` build order strategy.
+	// Builds with a higher priority are scheduled first (default 0).
+	Priority *int32 `json:"priority,omitempty"`
+	// The policy used to retry the Build when it fails (default is to retry any failure up to 5 times).
+	RetryPolicy *BuildRetryPolicyApplyConfiguration `json:"retryPolicy,omitempty"`
 	// the maximum amount of parallel running builds started by this operator instance.
 	//
 	// Deprecated: no longer in use in Camel K 2 - maintained for backward compatibility
@@ -107,6 +112,22 @@ func (b *BuildSpecApplyConfiguration) WithTimeout(value metav1.Duration) *BuildS
 	return b
 }
 
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *BuildSpecApplyConfiguration) WithPriority(value int32) *BuildSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *BuildSpecApplyConfiguration) WithRetryPolicy(value *BuildRetryPolicyApplyConfiguration) *BuildSpecApplyConfiguration {
+	b.RetryPolicy = value
+	return b
+}
+
 // WithMaxRunningBuilds sets the MaxRunningBuilds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaxRunningBuilds field is set to the value of the last call.
@@ -43,6 +43,10 @@ type BuildStatusApplyConfiguration struct {
 	BaseImage *string `json:"baseImage,omitempty"`
 	// a list of artifacts contained in the build
 	Artifacts []ArtifactApplyConfiguration `json:"artifacts,omitempty"`
+	// the signature of the image (if signed)
+	Signature *ImageSignatureApplyConfiguration `json:"signature,omitempty"`
+	// the vulnerabilities found in the dependencies (if scanned)
+	Vulnerabilities []VulnerabilityApplyConfiguration `json:"vulnerabilities,omitempty"`
 	// the error description (if any)
 	Error *string `json:"error,omitempty"`
 	// the reason of the failure (if any)
@@ -124,6 +128,27 @@ func (b *BuildStatusApplyConfiguration) WithArtifacts(values ...*ArtifactApplyCo
 	return b
 }
 
+// WithSignature sets the Signature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signature field is set to the value of the last call.
+func (b *BuildStatusApplyConfiguration) WithSignature(value *ImageSignatureApplyConfiguration) *BuildStatusApplyConfiguration {
+	b.Signature = value
+	return b
+}
+
+// WithVulnerabilities adds the given value to the Vulnerabilities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Vulnerabilities field.
+func (b *BuildStatusApplyConfiguration) WithVulnerabilities(values ...*VulnerabilityApplyConfiguration) *BuildStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVulnerabilities")
+		}
+		b.Vulnerabilities = append(b.Vulnerabilities, *values[i])
+	}
+	return b
+}
+
 // WithError sets the Error field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Error field is set to the value of the last call.
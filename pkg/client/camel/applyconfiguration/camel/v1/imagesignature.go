@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ImageSignatureApplyConfiguration represents a declarative configuration of the ImageSignature type for use
+// with apply.
+//
+// ImageSignature reports the signature and the attestations pushed next to an image.
+type ImageSignatureApplyConfiguration struct {
+	// the reference of the image signature
+	Signature *string `json:"signature,omitempty"`
+	// the reference of the SLSA provenance attestation
+	Provenance *string `json:"provenance,omitempty"`
+}
+
+// ImageSignatureApplyConfiguration constructs a declarative configuration of the ImageSignature type for use with
+// apply.
+func ImageSignature() *ImageSignatureApplyConfiguration {
+	return &ImageSignatureApplyConfiguration{}
+}
+
+// WithSignature sets the Signature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signature field is set to the value of the last call.
+func (b *ImageSignatureApplyConfiguration) WithSignature(value string) *ImageSignatureApplyConfiguration {
+	b.Signature = &value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *ImageSignatureApplyConfiguration) WithProvenance(value string) *ImageSignatureApplyConfiguration {
+	b.Provenance = &value
+	return b
+}
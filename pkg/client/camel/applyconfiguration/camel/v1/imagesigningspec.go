@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ImageSigningSpecApplyConfiguration represents a declarative configuration of the ImageSigningSpec type for use
+// with apply.
+//
+// ImageSigningSpec defines how the image is signed once published.
+type ImageSigningSpecApplyConfiguration struct {
+	// the Secret holding the cosign private key (`cosign.key`) and its password (`cosign.password`)
+	Secret *string `json:"secret,omitempty"`
+	// attach the SLSA provenance attestation generated by the package task, signed with the same key
+	Provenance *bool `json:"provenance,omitempty"`
+}
+
+// ImageSigningSpecApplyConfiguration constructs a declarative configuration of the ImageSigningSpec type for use with
+// apply.
+func ImageSigningSpec() *ImageSigningSpecApplyConfiguration {
+	return &ImageSigningSpecApplyConfiguration{}
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *ImageSigningSpecApplyConfiguration) WithSecret(value string) *ImageSigningSpecApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithProvenance sets the Provenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provenance field is set to the value of the last call.
+func (b *ImageSigningSpecApplyConfiguration) WithProvenance(value bool) *ImageSigningSpecApplyConfiguration {
+	b.Provenance = &value
+	return b
+}
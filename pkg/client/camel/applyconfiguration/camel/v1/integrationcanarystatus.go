@@ -0,0 +1,138 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IntegrationCanaryStatusApplyConfiguration represents a declarative configuration of the IntegrationCanaryStatus type for use
+// with apply.
+//
+// IntegrationCanaryStatus reports the progress of the canary rollout of an Integration revision.
+type IntegrationCanaryStatusApplyConfiguration struct {
+	// the phase of the canary rollout
+	Phase *camelv1.IntegrationCanaryPhase `json:"phase,omitempty"`
+	// the Integration digest the canary is rolling out
+	Revision *string `json:"revision,omitempty"`
+	// the container image run by the stable pods
+	StableImage *string `json:"stableImage,omitempty"`
+	// the container image run by the canary pods
+	CanaryImage *string `json:"canaryImage,omitempty"`
+	// the index of the current rollout step
+	Step *int32 `json:"step,omitempty"`
+	// the percentage of the traffic routed to the canary pods
+	Weight *int32 `json:"weight,omitempty"`
+	// the number of desired canary pods
+	Replicas *int32 `json:"replicas,omitempty"`
+	// the number of ready canary pods
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+	// the last time the canary moved to its current step or phase
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// a human-readable message indicating details about the rollout
+	Message *string `json:"message,omitempty"`
+}
+
+// IntegrationCanaryStatusApplyConfiguration constructs a declarative configuration of the IntegrationCanaryStatus type for use with
+// apply.
+func IntegrationCanaryStatus() *IntegrationCanaryStatusApplyConfiguration {
+	return &IntegrationCanaryStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithPhase(value camelv1.IntegrationCanaryPhase) *IntegrationCanaryStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithRevision(value string) *IntegrationCanaryStatusApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithStableImage sets the StableImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StableImage field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithStableImage(value string) *IntegrationCanaryStatusApplyConfiguration {
+	b.StableImage = &value
+	return b
+}
+
+// WithCanaryImage sets the CanaryImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CanaryImage field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithCanaryImage(value string) *IntegrationCanaryStatusApplyConfiguration {
+	b.CanaryImage = &value
+	return b
+}
+
+// WithStep sets the Step field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Step field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithStep(value int32) *IntegrationCanaryStatusApplyConfiguration {
+	b.Step = &value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithWeight(value int32) *IntegrationCanaryStatusApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithReplicas(value int32) *IntegrationCanaryStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithReadyReplicas(value int32) *IntegrationCanaryStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithLastTransitionTime(value metav1.Time) *IntegrationCanaryStatusApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *IntegrationCanaryStatusApplyConfiguration) WithMessage(value string) *IntegrationCanaryStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,78 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IntegrationHealthyRevisionApplyConfiguration represents a declarative configuration of the IntegrationHealthyRevision type for use
+// with apply.
+//
+// IntegrationHealthyRevision is a revision of an Integration which was ready.
+type IntegrationHealthyRevisionApplyConfiguration struct {
+	// the digest of the Integration revision
+	Digest *string `json:"digest,omitempty"`
+	// the reference of the `IntegrationKit` used by the Integration revision
+	IntegrationKit *corev1.ObjectReference `json:"integrationKit,omitempty"`
+	// the container image used by the Integration revision
+	Image *string `json:"image,omitempty"`
+	// the timestamp representing the last time when the Integration revision was ready
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+// IntegrationHealthyRevisionApplyConfiguration constructs a declarative configuration of the IntegrationHealthyRevision type for use with
+// apply.
+func IntegrationHealthyRevision() *IntegrationHealthyRevisionApplyConfiguration {
+	return &IntegrationHealthyRevisionApplyConfiguration{}
+}
+
+// WithDigest sets the Digest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Digest field is set to the value of the last call.
+func (b *IntegrationHealthyRevisionApplyConfiguration) WithDigest(value string) *IntegrationHealthyRevisionApplyConfiguration {
+	b.Digest = &value
+	return b
+}
+
+// WithIntegrationKit sets the IntegrationKit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IntegrationKit field is set to the value of the last call.
+func (b *IntegrationHealthyRevisionApplyConfiguration) WithIntegrationKit(value corev1.ObjectReference) *IntegrationHealthyRevisionApplyConfiguration {
+	b.IntegrationKit = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *IntegrationHealthyRevisionApplyConfiguration) WithImage(value string) *IntegrationHealthyRevisionApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithTimestamp sets the Timestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timestamp field is set to the value of the last call.
+func (b *IntegrationHealthyRevisionApplyConfiguration) WithTimestamp(value metav1.Time) *IntegrationHealthyRevisionApplyConfiguration {
+	b.Timestamp = &value
+	return b
+}
@@ -42,6 +42,10 @@ type IntegrationKitStatusApplyConfiguration struct {
 	Digest *string `json:"digest,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []ArtifactApplyConfiguration `json:"artifacts,omitempty"`
+	// the signature of the kit image (if signed)
+	Signature *ImageSignatureApplyConfiguration `json:"signature,omitempty"`
+	// the vulnerabilities found in the kit dependencies (if scanned)
+	Vulnerabilities []VulnerabilityApplyConfiguration `json:"vulnerabilities,omitempty"`
 	// failure reason (if any)
 	Failure *FailureApplyConfiguration `json:"failure,omitempty"`
 	// the runtime version for which this kit was configured
@@ -125,6 +129,27 @@ func (b *IntegrationKitStatusApplyConfiguration) WithArtifacts(values ...*Artifa
 	return b
 }
 
+// WithSignature sets the Signature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signature field is set to the value of the last call.
+func (b *IntegrationKitStatusApplyConfiguration) WithSignature(value *ImageSignatureApplyConfiguration) *IntegrationKitStatusApplyConfiguration {
+	b.Signature = value
+	return b
+}
+
+// WithVulnerabilities adds the given value to the Vulnerabilities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Vulnerabilities field.
+func (b *IntegrationKitStatusApplyConfiguration) WithVulnerabilities(values ...*VulnerabilityApplyConfiguration) *IntegrationKitStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVulnerabilities")
+		}
+		b.Vulnerabilities = append(b.Vulnerabilities, *values[i])
+	}
+	return b
+}
+
 // WithFailure sets the Failure field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Failure field is set to the value of the last call.
@@ -58,6 +58,8 @@ type IntegrationPlatformBuildSpecApplyConfiguration struct {
 	PublishStrategyOptions map[string]string `json:"PublishStrategyOptions,omitempty"`
 	// the maximum amount of parallel running pipelines started by this operator instance
 	MaxRunningBuilds *int32 `json:"maxRunningBuilds,omitempty"`
+	// the quota limiting the amount of parallel running builds for each namespace
+	Quota *BuildQuotaSpecApplyConfiguration `json:"quota,omitempty"`
 }
 
 // IntegrationPlatformBuildSpecApplyConfiguration constructs a declarative configuration of the IntegrationPlatformBuildSpec type for use with
@@ -167,3 +169,11 @@ func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithMaxRunningBuilds(va
 	b.MaxRunningBuilds = &value
 	return b
 }
+
+// WithQuota sets the Quota field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quota field is set to the value of the last call.
+func (b *IntegrationPlatformBuildSpecApplyConfiguration) WithQuota(value *BuildQuotaSpecApplyConfiguration) *IntegrationPlatformBuildSpecApplyConfiguration {
+	b.Quota = value
+	return b
+}
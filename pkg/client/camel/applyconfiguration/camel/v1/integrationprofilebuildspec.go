@@ -43,6 +43,9 @@ type IntegrationProfileBuildSpecApplyConfiguration struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Maven configuration used to build the Camel/Camel-Quarkus applications
 	Maven *MavenSpecApplyConfiguration `json:"maven,omitempty"`
+	// the quota limiting the amount of parallel running builds for each namespace,
+	// overriding the one of the IntegrationPlatform for the builds using this profile
+	Quota *BuildQuotaSpecApplyConfiguration `json:"quota,omitempty"`
 }
 
 // IntegrationProfileBuildSpecApplyConfiguration constructs a declarative configuration of the IntegrationProfileBuildSpec type for use with
@@ -98,3 +101,11 @@ func (b *IntegrationProfileBuildSpecApplyConfiguration) WithMaven(value *MavenSp
 	b.Maven = value
 	return b
 }
+
+// WithQuota sets the Quota field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quota field is set to the value of the last call.
+func (b *IntegrationProfileBuildSpecApplyConfiguration) WithQuota(value *BuildQuotaSpecApplyConfiguration) *IntegrationProfileBuildSpecApplyConfiguration {
+	b.Quota = value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// IntegrationResourcesRecommendationApplyConfiguration represents a declarative configuration of the IntegrationResourcesRecommendation type for use
+// with apply.
+//
+// IntegrationResourcesRecommendation is the resources recommended for the Integration container by the VerticalPodAutoscaler.
+type IntegrationResourcesRecommendationApplyConfiguration struct {
+	// the recommended resources
+	Target *corev1.ResourceList `json:"target,omitempty"`
+	// the minimum recommended resources
+	LowerBound *corev1.ResourceList `json:"lowerBound,omitempty"`
+	// the maximum recommended resources
+	UpperBound *corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// IntegrationResourcesRecommendationApplyConfiguration constructs a declarative configuration of the IntegrationResourcesRecommendation type for use with
+// apply.
+func IntegrationResourcesRecommendation() *IntegrationResourcesRecommendationApplyConfiguration {
+	return &IntegrationResourcesRecommendationApplyConfiguration{}
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *IntegrationResourcesRecommendationApplyConfiguration) WithTarget(value corev1.ResourceList) *IntegrationResourcesRecommendationApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithLowerBound sets the LowerBound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LowerBound field is set to the value of the last call.
+func (b *IntegrationResourcesRecommendationApplyConfiguration) WithLowerBound(value corev1.ResourceList) *IntegrationResourcesRecommendationApplyConfiguration {
+	b.LowerBound = &value
+	return b
+}
+
+// WithUpperBound sets the UpperBound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpperBound field is set to the value of the last call.
+func (b *IntegrationResourcesRecommendationApplyConfiguration) WithUpperBound(value corev1.ResourceList) *IntegrationResourcesRecommendationApplyConfiguration {
+	b.UpperBound = &value
+	return b
+}
@@ -78,6 +78,12 @@ type IntegrationStatusApplyConfiguration struct {
 	DeploymentTimestamp *metav1.Time `json:"lastDeploymentTimestamp,omitempty"`
 	// the timestamp representing the last time when this integration was built.
 	BuildTimestamp *metav1.Time `json:"lastBuildTimestamp,omitempty"`
+	// the progress of the canary rollout, when the canary trait is enabled
+	Canary *IntegrationCanaryStatusApplyConfiguration `json:"canary,omitempty"`
+	// the last revision of the Integration which was ready, when the auto-rollback trait is enabled
+	LastHealthyRevision *IntegrationHealthyRevisionApplyConfiguration `json:"lastHealthyRevision,omitempty"`
+	// the resources recommended for the Integration container, when the vpa trait is enabled
+	ResourcesRecommendation *IntegrationResourcesRecommendationApplyConfiguration `json:"resourcesRecommendation,omitempty"`
 }
 
 // IntegrationStatusApplyConfiguration constructs a declarative configuration of the IntegrationStatus type for use with
@@ -288,3 +294,27 @@ func (b *IntegrationStatusApplyConfiguration) WithBuildTimestamp(value metav1.Ti
 	b.BuildTimestamp = &value
 	return b
 }
+
+// WithCanary sets the Canary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Canary field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithCanary(value *IntegrationCanaryStatusApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.Canary = value
+	return b
+}
+
+// WithLastHealthyRevision sets the LastHealthyRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastHealthyRevision field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithLastHealthyRevision(value *IntegrationHealthyRevisionApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.LastHealthyRevision = value
+	return b
+}
+
+// WithResourcesRecommendation sets the ResourcesRecommendation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourcesRecommendation field is set to the value of the last call.
+func (b *IntegrationStatusApplyConfiguration) WithResourcesRecommendation(value *IntegrationResourcesRecommendationApplyConfiguration) *IntegrationStatusApplyConfiguration {
+	b.ResourcesRecommendation = value
+	return b
+}
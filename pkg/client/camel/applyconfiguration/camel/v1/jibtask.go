@@ -26,6 +26,10 @@ package v1
 type JibTaskApplyConfiguration struct {
 	BaseTaskApplyConfiguration    `json:",inline"`
 	PublishTaskApplyConfiguration `json:",inline"`
+	// push the Software Bill of Materials generated by the package task as an OCI artifact next to the image
+	PushSBOM *bool `json:"pushSBOM,omitempty"`
+	// the configuration used to sign the image
+	Signing *ImageSigningSpecApplyConfiguration `json:"signing,omitempty"`
 }
 
 // JibTaskApplyConfiguration constructs a declarative configuration of the JibTask type for use with
@@ -81,3 +85,19 @@ func (b *JibTaskApplyConfiguration) WithRegistry(value *RegistrySpecApplyConfigu
 	b.PublishTaskApplyConfiguration.Registry = value
 	return b
 }
+
+// WithPushSBOM sets the PushSBOM field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PushSBOM field is set to the value of the last call.
+func (b *JibTaskApplyConfiguration) WithPushSBOM(value bool) *JibTaskApplyConfiguration {
+	b.PushSBOM = &value
+	return b
+}
+
+// WithSigning sets the Signing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Signing field is set to the value of the last call.
+func (b *JibTaskApplyConfiguration) WithSigning(value *ImageSigningSpecApplyConfiguration) *JibTaskApplyConfiguration {
+	b.Signing = value
+	return b
+}
@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MavenCacheSpecApplyConfiguration represents a declarative configuration of the MavenCacheSpec type for use
+// with apply.
+//
+// MavenCacheSpec defines a Maven local repository, persisted in a PersistentVolumeClaim,
+// that is shared across the builder Pods to avoid downloading the same artifacts on each build.
+type MavenCacheSpecApplyConfiguration struct {
+	// Enable the shared Maven local repository.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The name of the PersistentVolumeClaim holding the Maven local repository (default `camel-k-maven-repository`).
+	// It is created in the builder Pod namespace when it does not exist.
+	PersistentVolumeClaim *string `json:"persistentVolumeClaim,omitempty"`
+	// The StorageClass used to provision the PersistentVolumeClaim (default is the cluster default StorageClass).
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// The access mode used to provision the PersistentVolumeClaim (default `ReadWriteMany`).
+	// Mind that with `ReadWriteOnce` a builder Pod scheduled on a node other than the one the volume is attached to
+	// fails to start with a Multi-Attach error, until the Build times out.
+	AccessMode *corev1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+	// The size used to provision the PersistentVolumeClaim (default `10Gi`).
+	Size *string `json:"size,omitempty"`
+	// The size the Maven local repository is allowed to grow up to before being evicted (default 80% of the size).
+	MaxSize *string `json:"maxSize,omitempty"`
+	// The policy used to evict the Maven local repository once it exceeds its maximum size (default `None`).
+	EvictionPolicy *camelv1.MavenCacheEvictionPolicy `json:"evictionPolicy,omitempty"`
+}
+
+// MavenCacheSpecApplyConfiguration constructs a declarative configuration of the MavenCacheSpec type for use with
+// apply.
+func MavenCacheSpec() *MavenCacheSpecApplyConfiguration {
+	return &MavenCacheSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithEnabled(value bool) *MavenCacheSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithPersistentVolumeClaim sets the PersistentVolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaim field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithPersistentVolumeClaim(value string) *MavenCacheSpecApplyConfiguration {
+	b.PersistentVolumeClaim = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithStorageClassName(value string) *MavenCacheSpecApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithAccessMode sets the AccessMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessMode field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithAccessMode(value corev1.PersistentVolumeAccessMode) *MavenCacheSpecApplyConfiguration {
+	b.AccessMode = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithSize(value string) *MavenCacheSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithMaxSize sets the MaxSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSize field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithMaxSize(value string) *MavenCacheSpecApplyConfiguration {
+	b.MaxSize = &value
+	return b
+}
+
+// WithEvictionPolicy sets the EvictionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EvictionPolicy field is set to the value of the last call.
+func (b *MavenCacheSpecApplyConfiguration) WithEvictionPolicy(value camelv1.MavenCacheEvictionPolicy) *MavenCacheSpecApplyConfiguration {
+	b.EvictionPolicy = &value
+	return b
+}
@@ -55,6 +55,9 @@ type MavenSpecApplyConfiguration struct {
 	// e.g., `-V,--no-transfer-progress,-Dstyle.color=never`.
 	// See https://maven.apache.org/ref/3.9.14/maven-embedder/cli.html.
 	CLIOptions []string `json:"cliOptions,omitempty"`
+	// The Maven local repository shared across the builder Pods.
+	// Only used for `pod` strategy.
+	Cache *MavenCacheSpecApplyConfiguration `json:"cache,omitempty"`
 }
 
 // MavenSpecApplyConfiguration constructs a declarative configuration of the MavenSpec type for use with
@@ -146,3 +149,11 @@ func (b *MavenSpecApplyConfiguration) WithCLIOptions(values ...string) *MavenSpe
 	}
 	return b
 }
+
+// WithCache sets the Cache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cache field is set to the value of the last call.
+func (b *MavenSpecApplyConfiguration) WithCache(value *MavenCacheSpecApplyConfiguration) *MavenSpecApplyConfiguration {
+	b.Cache = value
+	return b
+}
@@ -0,0 +1,98 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PipeSinkApplyConfiguration represents a declarative configuration of the PipeSink type for use
+// with apply.
+//
+// PipeSink represents one of the destinations of a Pipe routing the events to several sinks.
+type PipeSinkApplyConfiguration struct {
+	EndpointApplyConfiguration `json:",inline"`
+	// Filter is an expression selecting the events sent to this sink. A sink with no filter receives all the events
+	// with the broadcast routing, or the events not matching any other sink with the content-based routing
+	Filter *string `json:"filter,omitempty"`
+	// FilterLanguage is the Camel language of the Filter expression (default simple)
+	FilterLanguage *string `json:"filterLanguage,omitempty"`
+}
+
+// PipeSinkApplyConfiguration constructs a declarative configuration of the PipeSink type for use with
+// apply.
+func PipeSink() *PipeSinkApplyConfiguration {
+	return &PipeSinkApplyConfiguration{}
+}
+
+// WithRef sets the Ref field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ref field is set to the value of the last call.
+func (b *PipeSinkApplyConfiguration) WithRef(value corev1.ObjectReference) *PipeSinkApplyConfiguration {
+	b.EndpointApplyConfiguration.Ref = &value
+	return b
+}
+
+// WithURI sets the URI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URI field is set to the value of the last call.
+func (b *PipeSinkApplyConfiguration) WithURI(value string) *PipeSinkApplyConfiguration {
+	b.EndpointApplyConfiguration.URI = &value
+	return b
+}
+
+// WithProperties sets the Properties field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Properties field is set to the value of the last call.
+func (b *PipeSinkApplyConfiguration) WithProperties(value *EndpointPropertiesApplyConfiguration) *PipeSinkApplyConfiguration {
+	b.EndpointApplyConfiguration.Properties = value
+	return b
+}
+
+// WithDataTypes puts the entries into the DataTypes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the DataTypes field,
+// overwriting an existing map entries in DataTypes field with the same key.
+func (b *PipeSinkApplyConfiguration) WithDataTypes(entries map[camelv1.TypeSlot]DataTypeReferenceApplyConfiguration) *PipeSinkApplyConfiguration {
+	if b.EndpointApplyConfiguration.DataTypes == nil && len(entries) > 0 {
+		b.EndpointApplyConfiguration.DataTypes = make(map[camelv1.TypeSlot]DataTypeReferenceApplyConfiguration, len(entries))
+	}
+	for k, v := range entries {
+		b.EndpointApplyConfiguration.DataTypes[k] = v
+	}
+	return b
+}
+
+// WithFilter sets the Filter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filter field is set to the value of the last call.
+func (b *PipeSinkApplyConfiguration) WithFilter(value string) *PipeSinkApplyConfiguration {
+	b.Filter = &value
+	return b
+}
+
+// WithFilterLanguage sets the FilterLanguage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FilterLanguage field is set to the value of the last call.
+func (b *PipeSinkApplyConfiguration) WithFilterLanguage(value string) *PipeSinkApplyConfiguration {
+	b.FilterLanguage = &value
+	return b
+}
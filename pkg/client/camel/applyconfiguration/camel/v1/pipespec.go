@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1
 
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
 // PipeSpecApplyConfiguration represents a declarative configuration of the PipeSpec type for use
 // with apply.
 //
@@ -31,6 +35,10 @@ type PipeSpecApplyConfiguration struct {
 	Source *EndpointApplyConfiguration `json:"source,omitempty"`
 	// Sink is the destination of the integration defined by this Pipe
 	Sink *EndpointApplyConfiguration `json:"sink,omitempty"`
+	// Sinks is an optional list of destinations the events are routed to according to the Routing strategy, in place of the Sink
+	Sinks []PipeSinkApplyConfiguration `json:"sinks,omitempty"`
+	// Routing is the strategy used to route the events to the Sinks, either broadcast (default) or content-based
+	Routing *camelv1.PipeRouting `json:"routing,omitempty"`
 	// ErrorHandler is an optional handler called upon an error occurring in the integration
 	ErrorHandler *ErrorHandlerSpecApplyConfiguration `json:"errorHandler,omitempty"`
 	// the traits needed to customize the depending Integration
@@ -75,6 +83,27 @@ func (b *PipeSpecApplyConfiguration) WithSink(value *EndpointApplyConfiguration)
 	return b
 }
 
+// WithSinks adds the given value to the Sinks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sinks field.
+func (b *PipeSpecApplyConfiguration) WithSinks(values ...*PipeSinkApplyConfiguration) *PipeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSinks")
+		}
+		b.Sinks = append(b.Sinks, *values[i])
+	}
+	return b
+}
+
+// WithRouting sets the Routing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Routing field is set to the value of the last call.
+func (b *PipeSpecApplyConfiguration) WithRouting(value camelv1.PipeRouting) *PipeSpecApplyConfiguration {
+	b.Routing = &value
+	return b
+}
+
 // WithErrorHandler sets the ErrorHandler field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ErrorHandler field is set to the value of the last call.
@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ProvenanceSpecApplyConfiguration represents a declarative configuration of the ProvenanceSpec type for use
+// with apply.
+//
+// ProvenanceSpec defines the build parameters recorded by the SLSA provenance attestation.
+type ProvenanceSpecApplyConfiguration struct {
+	// the digest of the Integration the image is built for
+	IntegrationDigest *string `json:"integrationDigest,omitempty"`
+}
+
+// ProvenanceSpecApplyConfiguration constructs a declarative configuration of the ProvenanceSpec type for use with
+// apply.
+func ProvenanceSpec() *ProvenanceSpecApplyConfiguration {
+	return &ProvenanceSpecApplyConfiguration{}
+}
+
+// WithIntegrationDigest sets the IntegrationDigest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IntegrationDigest field is set to the value of the last call.
+func (b *ProvenanceSpecApplyConfiguration) WithIntegrationDigest(value string) *ProvenanceSpecApplyConfiguration {
+	b.IntegrationDigest = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// SBOMSpecApplyConfiguration represents a declarative configuration of the SBOMSpec type for use
+// with apply.
+//
+// SBOMSpec defines the Software Bill of Materials generated out of the project dependencies.
+type SBOMSpecApplyConfiguration struct {
+	// the format of the Software Bill of Materials
+	Format *camelv1.SBOMFormat `json:"format,omitempty"`
+}
+
+// SBOMSpecApplyConfiguration constructs a declarative configuration of the SBOMSpec type for use with
+// apply.
+func SBOMSpec() *SBOMSpecApplyConfiguration {
+	return &SBOMSpecApplyConfiguration{}
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *SBOMSpecApplyConfiguration) WithFormat(value camelv1.SBOMFormat) *SBOMSpecApplyConfiguration {
+	b.Format = &value
+	return b
+}
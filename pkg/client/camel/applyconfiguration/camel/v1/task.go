@@ -49,6 +49,8 @@ type TaskApplyConfiguration struct {
 	S2i *S2iTaskApplyConfiguration `json:"s2i,omitempty"`
 	// a JibTask, for Jib strategy
 	Jib *JibTaskApplyConfiguration `json:"jib,omitempty"`
+	// a BuildpacksTask, for Buildpacks strategy
+	Buildpacks *BuildpacksTaskApplyConfiguration `json:"buildpacks,omitempty"`
 }
 
 // TaskApplyConfiguration constructs a declarative configuration of the Task type for use with
@@ -120,3 +122,11 @@ func (b *TaskApplyConfiguration) WithJib(value *JibTaskApplyConfiguration) *Task
 	b.Jib = value
 	return b
 }
+
+// WithBuildpacks sets the Buildpacks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Buildpacks field is set to the value of the last call.
+func (b *TaskApplyConfiguration) WithBuildpacks(value *BuildpacksTaskApplyConfiguration) *TaskApplyConfiguration {
+	b.Buildpacks = value
+	return b
+}
@@ -30,10 +30,14 @@ import (
 type TraitsApplyConfiguration struct {
 	// The configuration of Affinity trait
 	Affinity *trait.AffinityTrait `json:"affinity,omitempty"`
+	// The configuration of Auto Rollback trait
+	AutoRollback *trait.AutoRollbackTrait `json:"auto-rollback,omitempty"`
 	// The configuration of Builder trait
 	Builder *trait.BuilderTrait `json:"builder,omitempty"`
 	// The configuration of Camel trait
 	Camel *trait.CamelTrait `json:"camel,omitempty"`
+	// The configuration of Canary trait
+	Canary *trait.CanaryTrait `json:"canary,omitempty"`
 	// The configuration of Container trait
 	Container *trait.ContainerTrait `json:"container,omitempty"`
 	// The configuration of Cron trait
@@ -58,6 +62,8 @@ type TraitsApplyConfiguration struct {
 	GitOps *trait.GitOpsTrait `json:"gitops,omitempty"`
 	// The configuration of Health trait
 	Health *trait.HealthTrait `json:"health,omitempty"`
+	// The configuration of HPA trait
+	HPA *trait.HPATrait `json:"hpa,omitempty"`
 	// The configuration of Ingress trait
 	Ingress *trait.IngressTrait `json:"ingress,omitempty"`
 	// The configuration of Init Containers trait
@@ -78,12 +84,16 @@ type TraitsApplyConfiguration struct {
 	Knative *trait.KnativeTrait `json:"knative,omitempty"`
 	// The configuration of Knative Service trait
 	KnativeService *trait.KnativeServiceTrait `json:"knative-service,omitempty"`
+	// The configuration of Log Shipping trait
+	LogShipping *trait.LogShippingTrait `json:"log-shipping,omitempty"`
 	// The configuration of Logging trait
 	Logging *trait.LoggingTrait `json:"logging,omitempty"`
 	// The configuration of Master trait
 	Master *trait.MasterTrait `json:"master,omitempty"`
 	// The configuration of Mount trait
 	Mount *trait.MountTrait `json:"mount,omitempty"`
+	// The configuration of NetworkPolicy trait
+	NetworkPolicy *trait.NetworkPolicyTrait `json:"network-policy,omitempty"`
 	// The configuration of OpenAPI trait.
 	//
 	// Deprecated: no longer in use.
@@ -120,10 +130,14 @@ type TraitsApplyConfiguration struct {
 	//
 	// Deprecated: no longer in use.
 	ServiceBinding *trait.ServiceBindingTrait `json:"service-binding,omitempty"`
+	// The configuration of StatefulSet trait
+	StatefulSet *trait.StatefulSetTrait `json:"stateful-set,omitempty"`
 	// The configuration of Telemetry trait
 	Telemetry *trait.TelemetryTrait `json:"telemetry,omitempty"`
 	// The configuration of Toleration trait
 	Toleration *trait.TolerationTrait `json:"toleration,omitempty"`
+	// The configuration of VPA trait
+	VPA *trait.VPATrait `json:"vpa,omitempty"`
 	// Deprecated: no longer in use.
 	Addons map[string]AddonTraitApplyConfiguration `json:"addons,omitempty"`
 	// Deprecated: no longer in use.
@@ -148,6 +162,14 @@ func (b *TraitsApplyConfiguration) WithAffinity(value trait.AffinityTrait) *Trai
 	return b
 }
 
+// WithAutoRollback sets the AutoRollback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRollback field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithAutoRollback(value trait.AutoRollbackTrait) *TraitsApplyConfiguration {
+	b.AutoRollback = &value
+	return b
+}
+
 // WithBuilder sets the Builder field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Builder field is set to the value of the last call.
@@ -164,6 +186,14 @@ func (b *TraitsApplyConfiguration) WithCamel(value trait.CamelTrait) *TraitsAppl
 	return b
 }
 
+// WithCanary sets the Canary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Canary field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithCanary(value trait.CanaryTrait) *TraitsApplyConfiguration {
+	b.Canary = &value
+	return b
+}
+
 // WithContainer sets the Container field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Container field is set to the value of the last call.
@@ -252,6 +282,14 @@ func (b *TraitsApplyConfiguration) WithHealth(value trait.HealthTrait) *TraitsAp
 	return b
 }
 
+// WithHPA sets the HPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithHPA(value trait.HPATrait) *TraitsApplyConfiguration {
+	b.HPA = &value
+	return b
+}
+
 // WithIngress sets the Ingress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Ingress field is set to the value of the last call.
@@ -324,6 +362,14 @@ func (b *TraitsApplyConfiguration) WithKnativeService(value trait.KnativeService
 	return b
 }
 
+// WithLogShipping sets the LogShipping field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogShipping field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithLogShipping(value trait.LogShippingTrait) *TraitsApplyConfiguration {
+	b.LogShipping = &value
+	return b
+}
+
 // WithLogging sets the Logging field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Logging field is set to the value of the last call.
@@ -348,6 +394,14 @@ func (b *TraitsApplyConfiguration) WithMount(value trait.MountTrait) *TraitsAppl
 	return b
 }
 
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithNetworkPolicy(value trait.NetworkPolicyTrait) *TraitsApplyConfiguration {
+	b.NetworkPolicy = &value
+	return b
+}
+
 // WithOpenAPI sets the OpenAPI field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OpenAPI field is set to the value of the last call.
@@ -452,6 +506,14 @@ func (b *TraitsApplyConfiguration) WithServiceBinding(value trait.ServiceBinding
 	return b
 }
 
+// WithStatefulSet sets the StatefulSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StatefulSet field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithStatefulSet(value trait.StatefulSetTrait) *TraitsApplyConfiguration {
+	b.StatefulSet = &value
+	return b
+}
+
 // WithTelemetry sets the Telemetry field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Telemetry field is set to the value of the last call.
@@ -468,6 +530,14 @@ func (b *TraitsApplyConfiguration) WithToleration(value trait.TolerationTrait) *
 	return b
 }
 
+// WithVPA sets the VPA field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VPA field is set to the value of the last call.
+func (b *TraitsApplyConfiguration) WithVPA(value trait.VPATrait) *TraitsApplyConfiguration {
+	b.VPA = &value
+	return b
+}
+
 // WithAddons puts the entries into the Addons field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the Addons field,
@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// VulnerabilityApplyConfiguration represents a declarative configuration of the Vulnerability type for use
+// with apply.
+//
+// Vulnerability is a vulnerability affecting a dependency.
+type VulnerabilityApplyConfiguration struct {
+	// the identifier of the vulnerability, ie, the OSV record identifier
+	ID *string `json:"id,omitempty"`
+	// the vulnerable dependency, ie, `<groupId>:<artifactId>:<version>`
+	Dependency *string `json:"dependency,omitempty"`
+	// the severity of the vulnerability
+	Severity *camelv1.VulnerabilitySeverity `json:"severity,omitempty"`
+	// a short description of the vulnerability
+	Summary *string `json:"summary,omitempty"`
+}
+
+// VulnerabilityApplyConfiguration constructs a declarative configuration of the Vulnerability type for use with
+// apply.
+func Vulnerability() *VulnerabilityApplyConfiguration {
+	return &VulnerabilityApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *VulnerabilityApplyConfiguration) WithID(value string) *VulnerabilityApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithDependency sets the Dependency field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Dependency field is set to the value of the last call.
+func (b *VulnerabilityApplyConfiguration) WithDependency(value string) *VulnerabilityApplyConfiguration {
+	b.Dependency = &value
+	return b
+}
+
+// WithSeverity sets the Severity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Severity field is set to the value of the last call.
+func (b *VulnerabilityApplyConfiguration) WithSeverity(value camelv1.VulnerabilitySeverity) *VulnerabilityApplyConfiguration {
+	b.Severity = &value
+	return b
+}
+
+// WithSummary sets the Summary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Summary field is set to the value of the last call.
+func (b *VulnerabilityApplyConfiguration) WithSummary(value string) *VulnerabilityApplyConfiguration {
+	b.Summary = &value
+	return b
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// VulnerabilityScanSpecApplyConfiguration represents a declarative configuration of the VulnerabilityScanSpec type for use
+// with apply.
+//
+// VulnerabilityScanSpec defines how the dependencies are checked against an offline database of OSV vulnerability records.
+type VulnerabilityScanSpecApplyConfiguration struct {
+	// the ConfigMap holding the OSV vulnerability records, as JSON documents
+	ConfigMap *string `json:"configMap,omitempty"`
+	// the PersistentVolumeClaim holding the OSV vulnerability records, as JSON files (requires the `pod` build strategy)
+	PersistentVolumeClaim *string `json:"persistentVolumeClaim,omitempty"`
+	// the lowest severity of the vulnerabilities failing the build, the other vulnerabilities are only reported
+	FailureThreshold *camelv1.VulnerabilitySeverity `json:"failureThreshold,omitempty"`
+}
+
+// VulnerabilityScanSpecApplyConfiguration constructs a declarative configuration of the VulnerabilityScanSpec type for use with
+// apply.
+func VulnerabilityScanSpec() *VulnerabilityScanSpecApplyConfiguration {
+	return &VulnerabilityScanSpecApplyConfiguration{}
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *VulnerabilityScanSpecApplyConfiguration) WithConfigMap(value string) *VulnerabilityScanSpecApplyConfiguration {
+	b.ConfigMap = &value
+	return b
+}
+
+// WithPersistentVolumeClaim sets the PersistentVolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaim field is set to the value of the last call.
+func (b *VulnerabilityScanSpecApplyConfiguration) WithPersistentVolumeClaim(value string) *VulnerabilityScanSpecApplyConfiguration {
+	b.PersistentVolumeClaim = &value
+	return b
+}
+
+// WithFailureThreshold sets the FailureThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureThreshold field is set to the value of the last call.
+func (b *VulnerabilityScanSpecApplyConfiguration) WithFailureThreshold(value camelv1.VulnerabilitySeverity) *VulnerabilityScanSpecApplyConfiguration {
+	b.FailureThreshold = &value
+	return b
+}
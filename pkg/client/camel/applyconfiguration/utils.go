@@ -49,6 +49,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.BuildConfigurationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BuilderTask"):
 		return &camelv1.BuilderTaskApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BuildpacksTask"):
+		return &camelv1.BuildpacksTaskApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BuildQuotaSpec"):
+		return &camelv1.BuildQuotaSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("BuildRetryPolicy"):
+		return &camelv1.BuildRetryPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BuildSpec"):
 		return &camelv1.BuildSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("BuildStatus"):
@@ -111,10 +117,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.HeaderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HealthCheckResponse"):
 		return &camelv1.HealthCheckResponseApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImageSignature"):
+		return &camelv1.ImageSignatureApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ImageSigningSpec"):
+		return &camelv1.ImageSigningSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Integration"):
 		return &camelv1.IntegrationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationCanaryStatus"):
+		return &camelv1.IntegrationCanaryStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationCondition"):
 		return &camelv1.IntegrationConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationHealthyRevision"):
+		return &camelv1.IntegrationHealthyRevisionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationKit"):
 		return &camelv1.IntegrationKitApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationKitCondition"):
@@ -149,6 +163,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.IntegrationProfileSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationProfileStatus"):
 		return &camelv1.IntegrationProfileStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationResourcesRecommendation"):
+		return &camelv1.IntegrationResourcesRecommendationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationSpec"):
 		return &camelv1.IntegrationSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IntegrationStatus"):
@@ -183,12 +199,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.MavenArtifactApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenBuildSpec"):
 		return &camelv1.MavenBuildSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MavenCacheSpec"):
+		return &camelv1.MavenCacheSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MavenSpec"):
 		return &camelv1.MavenSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Pipe"):
 		return &camelv1.PipeApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeCondition"):
 		return &camelv1.PipeConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PipeSink"):
+		return &camelv1.PipeSinkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeSpec"):
 		return &camelv1.PipeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PipeStatus"):
@@ -199,6 +219,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.PodSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodSpecTemplate"):
 		return &camelv1.PodSpecTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvenanceSpec"):
+		return &camelv1.ProvenanceSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PublishTask"):
 		return &camelv1.PublishTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RegistrySpec"):
@@ -211,6 +233,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.RuntimeSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("S2iTask"):
 		return &camelv1.S2iTaskApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SBOMSpec"):
+		return &camelv1.SBOMSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Server"):
 		return &camelv1.ServerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SourceSpec"):
@@ -231,6 +255,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &camelv1.UserTaskApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ValueSource"):
 		return &camelv1.ValueSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Vulnerability"):
+		return &camelv1.VulnerabilityApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VulnerabilityScanSpec"):
+		return &camelv1.VulnerabilityScanSpecApplyConfiguration{}
 
 	}
 	return nil
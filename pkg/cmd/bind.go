@@ -22,13 +22,16 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 
 	cclient "github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/reference"
 	"github.com/apache/camel-k/v2/pkg/util/uri"
@@ -37,6 +40,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -55,13 +59,19 @@ func newCmdBind(rootCmdOptions *RootCmdOptions) (*cobra.Command, *bindCmdOptions
 		Annotations:       make(map[string]string),
 	}
 
-	cmd.Flags().String("error-handler", "", `Add error handler (none|log|sink:<endpoint>). Sink endpoints are expected in the format "[[apigroup/]version:]kind:[namespace/]name", plain Camel URIs or Kamelet name.`)
+	cmd.Flags().String("error-handler", "", `Add error handler (none|log|sink:<endpoint>), optionally followed by comma separated redelivery options `+
+		`(maximumRedeliveries|redeliveryDelay|backOffMultiplier|maximumRedeliveryDelay|retryOn|useOriginalMessage)=<value>, `+
+		`ie "sink:my-kamelet,maximumRedeliveries=3,retryOn=java.io.IOException". `+
+		`Sink endpoints are expected in the format "[[apigroup/]version:]kind:[namespace/]name", plain Camel URIs or Kamelet name.`)
 	cmd.Flags().String("name", "", "Name for the binding")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
 	cmd.Flags().StringArrayP("property", "p", nil, `Add a binding property in the form of "source.<key>=<value>", "sink.<key>=<value>", "error-handler.<key>=<value>" or "step-<n>.<key>=<value> where <n> is the step order starting from 1"`)
 	cmd.Flags().Bool("skip-checks", false, "Do not verify the binding for compliance with Kamelets and other Kubernetes resources")
 	cmd.Flags().StringArray("step", nil, `Add binding steps as Kubernetes resources. Endpoints are expected in the format "[[apigroup/]version:]kind:[namespace/]name", plain Camel URIs or Kamelet name.`)
 	cmd.Flags().StringArrayP("trait", "t", nil, `Add a trait to the corresponding Integration.`)
+	cmd.Flags().Bool("validate", false, "Validate the Kamelet endpoint properties and data types against the Kamelet definitions")
+	cmd.Flags().String("operator-namespace", "", "The namespace of the operator, where the Kamelets are looked up by the validation "+
+		"when they are not found in the Pipe namespace")
 	cmd.Flags().StringP("operator-id", "x", "camel-k", "Operator id selected to manage this Pipe.")
 	cmd.Flags().StringArray("annotation", nil, "Add an annotation to the Pipe. E.g. \"--annotation my.company=hello\"")
 	cmd.Flags().String("service-account", "", "The SA to use to run this binding")
@@ -75,22 +85,40 @@ const (
 	sinkKey         = "sink"
 	stepKeyPrefix   = "step-"
 	errorHandlerKey = "error-handler"
+
+	errorHandlerMaximumRedeliveries    = "maximumRedeliveries"
+	errorHandlerRedeliveryDelay        = "redeliveryDelay"
+	errorHandlerBackOffMultiplier      = "backOffMultiplier"
+	errorHandlerMaximumRedeliveryDelay = "maximumRedeliveryDelay"
+	errorHandlerRetryOn                = "retryOn"
+	errorHandlerUseOriginalMessage     = "useOriginalMessage"
 )
 
+var errorHandlerOptions = []string{
+	errorHandlerMaximumRedeliveries,
+	errorHandlerRedeliveryDelay,
+	errorHandlerBackOffMultiplier,
+	errorHandlerMaximumRedeliveryDelay,
+	errorHandlerRetryOn,
+	errorHandlerUseOriginalMessage,
+}
+
 type bindCmdOptions struct {
 	*RootCmdOptions
 
-	ErrorHandler   string   `mapstructure:"error-handler"   yaml:",omitempty"`
-	Name           string   `mapstructure:"name"            yaml:",omitempty"`
-	OutputFormat   string   `mapstructure:"output"          yaml:",omitempty"`
-	Properties     []string `mapstructure:"properties"      yaml:",omitempty"`
-	SkipChecks     bool     `mapstructure:"skip-checks"     yaml:",omitempty"`
-	Steps          []string `mapstructure:"steps"           yaml:",omitempty"`
-	Traits         []string `mapstructure:"traits"          yaml:",omitempty"`
-	OperatorID     string   `mapstructure:"operator-id"     yaml:",omitempty"`
-	Annotations    []string `mapstructure:"annotations"     yaml:",omitempty"`
-	ServiceAccount string   `mapstructure:"service-account" yaml:",omitempty"`
-	Dependencies   []string `mapstructure:"dependencies"    yaml:",omitempty"`
+	ErrorHandler      string   `mapstructure:"error-handler"      yaml:",omitempty"`
+	Name              string   `mapstructure:"name"               yaml:",omitempty"`
+	OutputFormat      string   `mapstructure:"output"             yaml:",omitempty"`
+	Properties        []string `mapstructure:"properties"         yaml:",omitempty"`
+	SkipChecks        bool     `mapstructure:"skip-checks"        yaml:",omitempty"`
+	Steps             []string `mapstructure:"steps"              yaml:",omitempty"`
+	Traits            []string `mapstructure:"traits"             yaml:",omitempty"`
+	Validate          bool     `mapstructure:"validate"           yaml:",omitempty"`
+	OperatorNamespace string   `mapstructure:"operator-namespace" yaml:",omitempty"`
+	OperatorID        string   `mapstructure:"operator-id"        yaml:",omitempty"`
+	Annotations       []string `mapstructure:"annotations"        yaml:",omitempty"`
+	ServiceAccount    string   `mapstructure:"service-account"    yaml:",omitempty"`
+	Dependencies      []string `mapstructure:"dependencies"       yaml:",omitempty"`
 }
 
 func (o *bindCmdOptions) preRunE(cmd *cobra.Command, args []string) error {
@@ -137,6 +165,12 @@ func (o *bindCmdOptions) validate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if o.ErrorHandler != "" {
+		if _, err := o.parseErrorHandler(); err != nil {
+			return err
+		}
+	}
+
 	if !o.SkipChecks {
 		source, err := o.decode(args[0], sourceKey)
 		if err != nil {
@@ -166,6 +200,12 @@ func (o *bindCmdOptions) validate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if o.Validate {
+		if err := o.validatePipe(cmd, args); err != nil {
+			return err
+		}
+	}
+
 	var client cclient.Client
 	var err error
 	if !isOfflineCommand(cmd) {
@@ -185,22 +225,46 @@ func (o *bindCmdOptions) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	source, err := o.decode(args[0], sourceKey)
+	pipe, err := o.newPipe(client, args)
 	if err != nil {
 		return err
 	}
+	name := pipe.Name
 
-	sink, err := o.decode(args[1], sinkKey)
+	if o.OutputFormat != "" {
+		return showPipeOutput(cmd, pipe, o.OutputFormat, client.GetScheme())
+	}
+
+	replaced, err := kubernetes.ReplaceResource(o.Context, client, pipe)
 	if err != nil {
 		return err
 	}
 
-	name := o.nameFor(source, sink)
+	if !replaced {
+		fmt.Fprintln(cmd.OutOrStdout(), `binding "`+name+`" created`)
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), `binding "`+name+`" updated`)
+	}
+
+	return nil
+}
+
+// newPipe creates the Pipe with the endpoints provided as arguments, steps, error handler, traits and annotations.
+func (o *bindCmdOptions) newPipe(c cclient.Client, args []string) (*v1.Pipe, error) {
+	source, err := o.decode(args[0], sourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := o.decode(args[1], sinkKey)
+	if err != nil {
+		return nil, err
+	}
 
 	pipe := v1.Pipe{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: o.Namespace,
-			Name:      name,
+			Name:      o.nameFor(source, sink),
 		},
 		Spec: v1.PipeSpec{
 			Source: source,
@@ -208,16 +272,12 @@ func (o *bindCmdOptions) run(cmd *cobra.Command, args []string) error {
 		},
 	}
 
-	if o.Dependencies != nil {
-		pipe.Spec.Dependencies = o.Dependencies
-	}
-
 	if o.ErrorHandler != "" {
-		if errorHandler, err := o.parseErrorHandler(); err == nil {
-			pipe.Spec.ErrorHandler = errorHandler
-		} else {
-			return err
+		errorHandler, err := o.parseErrorHandler()
+		if err != nil {
+			return nil, err
 		}
+		pipe.Spec.ErrorHandler = errorHandler
 	}
 
 	if len(o.Steps) > 0 {
@@ -227,16 +287,20 @@ func (o *bindCmdOptions) run(cmd *cobra.Command, args []string) error {
 			stepKey := fmt.Sprintf("%s%d", stepKeyPrefix, stepIndex)
 			step, err := o.decode(stepDesc, stepKey)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			pipe.Spec.Steps = append(pipe.Spec.Steps, step)
 		}
 	}
 
+	if o.Dependencies != nil {
+		pipe.Spec.Dependencies = o.Dependencies
+	}
+
 	if len(o.Traits) > 0 {
-		catalog := trait.NewCatalog(client)
+		catalog := trait.NewCatalog(c)
 		if err := trait.ConfigureTraits(o.Traits, &pipe.Spec.Traits, catalog); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -254,19 +318,35 @@ func (o *bindCmdOptions) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if o.OutputFormat != "" {
-		return showPipeOutput(cmd, &pipe, o.OutputFormat, client.GetScheme())
-	}
+	return &pipe, nil
+}
 
-	replaced, err := kubernetes.ReplaceResource(o.Context, client, &pipe)
+// validatePipe checks the Kamelet endpoints against the Kamelet definitions, as the Pipe validating webhook does.
+func (o *bindCmdOptions) validatePipe(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	pipe, err := o.newPipe(c, args)
 	if err != nil {
 		return err
 	}
 
-	if !replaced {
-		fmt.Fprintln(cmd.OutOrStdout(), `binding "`+name+`" created`)
-	} else {
-		fmt.Fprintln(cmd.OutOrStdout(), `binding "`+name+`" updated`)
+	operatorNamespace := o.OperatorNamespace
+	if operatorNamespace == "" {
+		operatorNamespace = platform.GetOperatorNamespace()
+	}
+	var fallbackNamespaces []string
+	if operatorNamespace != "" {
+		fallbackNamespaces = append(fallbackNamespaces, operatorNamespace)
+	}
+
+	warnings, errs := bindings.ValidatePipe(o.Context, pipe, bindings.NewKameletLookup(c, fallbackNamespaces...))
+	for _, warning := range warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid Pipe %q: %w", pipe.Name, errs.ToAggregate())
 	}
 
 	return nil
@@ -283,22 +363,35 @@ func showPipeOutput(cmd *cobra.Command, binding *v1.Pipe, outputFormat string, s
 
 func (o *bindCmdOptions) parseErrorHandler() (*v1.ErrorHandlerSpec, error) {
 	errHandlMap := make(map[string]any)
-	errHandlType, errHandlValue, err := parseErrorHandlerByType(o.ErrorHandler)
+	errHandl, errHandlOptions := splitErrorHandlerOptions(o.ErrorHandler)
+	errHandlType, errHandlValue, err := parseErrorHandlerByType(errHandl)
+	if err != nil {
+		return nil, err
+	}
+	errHandlLog, err := parseErrorHandlerOptions(errHandlOptions)
 	if err != nil {
 		return nil, err
 	}
 	switch errHandlType {
 	case "none":
+		if len(errHandlOptions) > 0 {
+			return nil, fmt.Errorf("error handler type none does not accept any option, provided %s", strings.Join(errHandlOptions, ","))
+		}
 		errHandlMap["none"] = nil
 	case "log":
-		errHandlMap["log"] = nil
+		if len(errHandlOptions) > 0 {
+			errHandlMap["log"] = errHandlLog
+		} else {
+			errHandlMap["log"] = nil
+		}
 	case "sink":
 		sinkSpec, err := o.decode(errHandlValue, errorHandlerKey)
 		if err != nil {
 			return nil, err
 		}
-		errHandlMap["sink"] = map[string]any{
-			"endpoint": sinkSpec,
+		errHandlMap["sink"] = v1.ErrorHandlerSink{
+			ErrorHandlerLog: errHandlLog,
+			DLCEndpoint:     &sinkSpec,
 		}
 	default:
 		return nil, fmt.Errorf("invalid error handler type %s", o.ErrorHandler)
@@ -311,6 +404,70 @@ func (o *bindCmdOptions) parseErrorHandler() (*v1.ErrorHandlerSpec, error) {
 	return &v1.ErrorHandlerSpec{RawMessage: errHandlMarshalled}, nil
 }
 
+// splitErrorHandlerOptions splits the error handler from the redelivery options appended as comma separated "<option>=<value>".
+func splitErrorHandlerOptions(value string) (string, []string) {
+	parts := strings.Split(value, ",")
+	idx := len(parts)
+	for idx > 1 {
+		key, _, found := strings.Cut(parts[idx-1], "=")
+		if !found || !slices.Contains(errorHandlerOptions, key) {
+			break
+		}
+		idx--
+	}
+
+	return strings.Join(parts[:idx], ","), parts[idx:]
+}
+
+// parseErrorHandlerOptions returns the redelivery configuration shared by the log and sink error handlers.
+func parseErrorHandlerOptions(options []string) (v1.ErrorHandlerLog, error) {
+	errHandlLog := v1.ErrorHandlerLog{}
+	policy := v1.ErrorHandlerRedeliveryPolicy{}
+	hasPolicy := false
+	for _, option := range options {
+		key, value, _ := strings.Cut(option, "=")
+		var err error
+		switch key {
+		case errorHandlerMaximumRedeliveries:
+			var v int64
+			if v, err = strconv.ParseInt(value, 10, 32); err == nil {
+				policy.MaximumRedeliveries = ptr.To(int32(v))
+			}
+		case errorHandlerRedeliveryDelay:
+			var v int64
+			if v, err = strconv.ParseInt(value, 10, 64); err == nil {
+				policy.RedeliveryDelay = &v
+			}
+		case errorHandlerBackOffMultiplier:
+			var v float64
+			if v, err = strconv.ParseFloat(value, 64); err == nil {
+				policy.BackOffMultiplier = &v
+			}
+		case errorHandlerMaximumRedeliveryDelay:
+			var v int64
+			if v, err = strconv.ParseInt(value, 10, 64); err == nil {
+				policy.MaximumRedeliveryDelay = &v
+			}
+		case errorHandlerRetryOn:
+			policy.RetryOn = append(policy.RetryOn, value)
+		case errorHandlerUseOriginalMessage:
+			var v bool
+			if v, err = strconv.ParseBool(value); err == nil {
+				errHandlLog.UseOriginalMessage = &v
+			}
+		}
+		if err != nil {
+			return errHandlLog, fmt.Errorf("invalid error handler option %s: %w", option, err)
+		}
+		hasPolicy = hasPolicy || key != errorHandlerUseOriginalMessage
+	}
+	if hasPolicy {
+		errHandlLog.RedeliveryPolicy = &policy
+	}
+
+	return errHandlLog, nil
+}
+
 func parseErrorHandlerByType(value string) (string, string, error) {
 	errHandlSplit := strings.SplitN(value, ":", 2)
 	if (errHandlSplit[0] == "sink") && len(errHandlSplit) != 2 {
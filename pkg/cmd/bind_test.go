@@ -21,10 +21,12 @@ import (
 	"os"
 	"testing"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const cmdBind = "bind"
@@ -166,6 +168,66 @@ status: {}
 `, output)
 }
 
+func TestBindErrorHandlerRedeliveryPolicy(t *testing.T) {
+	_, bindCmd, _ := initializeBindCmdOptions(t)
+	output, err := ExecuteCommand(bindCmd, cmdBind, "my:src", "my:dst", "-o", "yaml",
+		"--error-handler", "sink:log:error?showAll=true,maximumRedeliveries=3,redeliveryDelay=2000,backOffMultiplier=1.5,"+
+			"retryOn=java.io.IOException,retryOn=java.net.ConnectException,useOriginalMessage=true")
+
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: camel.apache.org/v1
+kind: Pipe
+metadata:
+  annotations:
+    camel.apache.org/operator.id: camel-k
+  name: my-to-my
+spec:
+  errorHandler:
+    sink:
+      endpoint:
+        uri: log:error?showAll=true
+      redeliveryPolicy:
+        backOffMultiplier: 1.5
+        maximumRedeliveries: 3
+        redeliveryDelay: 2000
+        retryOn:
+        - java.io.IOException
+        - java.net.ConnectException
+      useOriginalMessage: true
+  sink:
+    uri: my:dst
+  source:
+    uri: my:src
+status: {}
+`, output)
+}
+
+func TestBindErrorHandlerLogRedeliveryPolicy(t *testing.T) {
+	_, bindCmd, _ := initializeBindCmdOptions(t)
+	output, err := ExecuteCommand(bindCmd, cmdBind, "my:src", "my:dst", "-o", "yaml",
+		"--error-handler", "log,maximumRedeliveries=-1")
+
+	require.NoError(t, err)
+	assert.Contains(t, output, `  errorHandler:
+    log:
+      redeliveryPolicy:
+        maximumRedeliveries: -1
+`)
+}
+
+func TestBindErrorHandlerInvalidOptions(t *testing.T) {
+	_, bindCmd, _ := initializeBindCmdOptions(t)
+	_, err := ExecuteCommand(bindCmd, cmdBind, "my:src", "my:dst", "-o", "yaml",
+		"--error-handler", "log,maximumRedeliveries=many")
+	require.Error(t, err)
+	assert.Equal(t, `invalid error handler option maximumRedeliveries=many: strconv.ParseInt: parsing "many": invalid syntax`, err.Error())
+
+	_, err = ExecuteCommand(bindCmd, cmdBind, "my:src", "my:dst", "-o", "yaml",
+		"--error-handler", "none,maximumRedeliveries=3")
+	require.Error(t, err)
+	assert.Equal(t, "error handler type none does not accept any option, provided maximumRedeliveries=3", err.Error())
+}
+
 func TestBindTraits(t *testing.T) {
 	buildCmdOptions, bindCmd, _ := initializeBindCmdOptions(t)
 	output, err := ExecuteCommand(bindCmd, cmdBind, "my:src", "my:dst", "-o", "yaml",
@@ -297,3 +359,73 @@ spec:
 status: {}
 `, output)
 }
+
+func TestBindValidate(t *testing.T) {
+	kamelet := &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-kamelet", Namespace: "default"},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Definition: &v1.JSONSchemaProps{
+					Required: []string{"topic"},
+					Properties: map[string]v1.JSONSchemaProp{
+						"topic":  {Type: "string"},
+						"period": {Type: "integer"},
+					},
+				},
+			},
+		},
+	}
+	fakeClient, err := internal.NewFakeClient(kamelet)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	addTestBindCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	_, err = ExecuteCommand(rootCmd, cmdBind, "my-kamelet", "log:info", "-o", "yaml",
+		"--validate", "-p", "source.period=1s")
+	require.Error(t, err)
+	assert.Equal(t, `invalid Pipe "my-kamelet-to-log": spec.source.properties.period: Invalid value: "1s": `+
+		`must be of type integer as defined by Kamelet "my-kamelet"`, err.Error())
+
+	output, err := ExecuteCommand(rootCmd, cmdBind, "my-kamelet", "log:info", "-o", "yaml",
+		"--validate", "-p", "source.topic=orders", "-p", "source.period=1000")
+	require.NoError(t, err)
+	assert.Contains(t, output, "topic: orders")
+
+	// the required property can be configured by the Integration
+	options, rootCmd = kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	addTestBindCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+	output, err = ExecuteCommand(rootCmd, cmdBind, "my-kamelet", "log:info", "-o", "yaml",
+		"--validate", "-t", "camel.properties=camel.kamelet.my-kamelet.source.topic=orders")
+	require.NoError(t, err)
+	assert.NotContains(t, output, "Warning")
+}
+
+func TestBindValidateOperatorNamespace(t *testing.T) {
+	kamelet := &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-kamelet", Namespace: "operator"},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Definition: &v1.JSONSchemaProps{
+					Properties: map[string]v1.JSONSchemaProp{
+						"period": {Type: "integer"},
+					},
+				},
+			},
+		},
+	}
+	fakeClient, err := internal.NewFakeClient(kamelet)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	addTestBindCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	_, err = ExecuteCommand(rootCmd, cmdBind, "my-kamelet", "log:info", "-o", "yaml", "--skip-checks",
+		"--validate", "--operator-namespace", "operator", "-p", "source.period=1s")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `spec.source.properties.period: Invalid value: "1s"`)
+}
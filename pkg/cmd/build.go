@@ -0,0 +1,184 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/cmd/source"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/trait"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+	"github.com/apache/camel-k/v2/pkg/util/sets"
+)
+
+func newCmdBuild(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildCmdOptions) {
+	options := buildCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "build [sources...] --local-output <dir>",
+		Short: "Generate the Maven project of an Integration locally",
+		Long: `Generate the Maven project of an Integration locally, by running the project generation steps of the build out of any cluster. ` +
+			`The resulting pom.xml and Maven settings are written in the output directory, so that the dependency resolution can be debugged, ` +
+			`and the build reproduced, with a local Maven installation.`,
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().String("local-output", "", "The directory where the Maven project is generated")
+	cmd.Flags().StringArrayP("dependency", "d", nil, "A dependency that should be included, e.g., \"camel:mail\" for a Camel component, "+
+		"\"mvn:org.my:app:1.0\" for a Maven dependency")
+	cmd.Flags().StringArray("maven-repository", nil, "Add a maven repository")
+	cmd.Flags().String("maven-settings", "", "A local file holding the Maven user settings")
+	cmd.Flags().StringArray("maven-profile", nil, "A local file holding a Maven profile to add to the project")
+
+	cmd.AddCommand(cmdOnly(newCmdBuildLogs(rootCmdOptions)))
+
+	return &cmd, &options
+}
+
+type buildCmdOptions struct {
+	*RootCmdOptions
+
+	LocalOutput   string   `mapstructure:"local-output"`
+	Dependencies  []string `mapstructure:"dependencies"`
+	Repositories  []string `mapstructure:"maven-repositories"`
+	MavenSettings string   `mapstructure:"maven-settings"`
+	MavenProfiles []string `mapstructure:"maven-profiles"`
+}
+
+func (o *buildCmdOptions) validate(args []string) error {
+	if o.LocalOutput == "" {
+		return errors.New("the --local-output flag is required, as the Integrations are built in the cluster by kamel run")
+	}
+	if len(args) == 0 {
+		return errors.New("build expects at least 1 source file")
+	}
+
+	return nil
+}
+
+func (o *buildCmdOptions) run(cmd *cobra.Command, args []string) error {
+	catalog, err := createCamelCatalog()
+	if err != nil {
+		return err
+	}
+
+	resolvedSources, err := source.Resolve(o.Context, args, false, cmd)
+	if err != nil {
+		return err
+	}
+	sources := make([]v1.SourceSpec, 0, len(resolvedSources))
+	for _, s := range resolvedSources {
+		sources = append(sources, v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    s.Name,
+				Content: s.Content,
+			},
+		})
+	}
+
+	dependencies, err := o.dependencies(cmd, catalog, sources)
+	if err != nil {
+		return err
+	}
+
+	task := v1.BuilderTask{
+		BaseTask: v1.BaseTask{
+			Name: "builder",
+		},
+		Runtime:      catalog.Runtime,
+		Dependencies: dependencies,
+	}
+	for _, repo := range o.Repositories {
+		task.Maven.Repositories = append(task.Maven.Repositories, maven.NewRepository(repo))
+	}
+
+	options := builder.LocalProjectOptions{}
+	if o.MavenSettings != "" {
+		if options.UserSettings, err = os.ReadFile(o.MavenSettings); err != nil {
+			return fmt.Errorf("could not read Maven settings: %w", err)
+		}
+	}
+	for _, p := range o.MavenProfiles {
+		profile, err := os.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("could not read Maven profile: %w", err)
+		}
+		options.Profiles = append(options.Profiles, string(profile))
+	}
+
+	dir, err := filepath.Abs(o.LocalOutput)
+	if err != nil {
+		return err
+	}
+	if _, err := builder.GenerateLocalProject(o.Context, task, catalog, dir, options); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Maven project generated in %s with %d dependencies, run \"mvn -f %s package\" to build it\n",
+		dir, len(dependencies), filepath.Join(dir, "pom.xml"))
+
+	return nil
+}
+
+// dependencies computes the dependencies of the Integration, as the dependencies trait does, out of the
+// dependencies explicitly required, the runtime and the sources.
+func (o *buildCmdOptions) dependencies(cmd *cobra.Command, catalog *camel.RuntimeCatalog, sources []v1.SourceSpec) ([]string, error) {
+	dependencies := sets.NewSet()
+	for _, d := range o.Dependencies {
+		normalized := camel.NormalizeDependency(d)
+		camel.ValidateDependency(catalog, normalized, cmd.ErrOrStderr())
+		dependencies.Add(normalized)
+	}
+	for _, d := range catalog.Runtime.Dependencies {
+		dependencies.Add(d.GetDependencyID())
+	}
+	for _, s := range sources {
+		dependencies.Merge(trait.ExtractSourceLoaderDependencies(s, catalog))
+	}
+	meta, err := metadata.ExtractAll(catalog, sources)
+	if err != nil {
+		return nil, err
+	}
+	dependencies.Merge(meta.Dependencies)
+
+	list := dependencies.List()
+	slices.Sort(list)
+
+	return list, nil
+}
@@ -0,0 +1,555 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
+)
+
+const (
+	buildLogsPollInterval = 2 * time.Second
+	// The grace period given to the operator log stream to catch up, once the Build is finished.
+	buildLogsGracePeriod = 2 * time.Second
+)
+
+func newCmdBuildLogs(rootCmdOptions *RootCmdOptions) (*cobra.Command, *buildLogsCmdOptions) {
+	options := buildLogsCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "logs <integration|kit>",
+		Short: "Print the logs of the Build of an Integration or an Integration Kit",
+		Long: `Print the logs of the Build of an Integration or an Integration Kit, grouped by build task and phase. ` +
+			`The active Build is followed until it completes, whether it runs as a routine in the operator or in a builder Pod. ` +
+			`With the --failed-only flag, a summary of the failed Build is printed instead, with the Maven errors it reported.`,
+		Args:    options.validate,
+		PreRunE: decode(&options, options.Flags),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().Bool("failed-only", false, "Print a summary of the failed Build, with the Maven errors it reported")
+	cmd.Flags().String("operator-namespace", "", "The namespace of the operator running the Build as a routine, "+
+		"defaults to the Build namespace, then to any namespace")
+
+	return &cmd, &options
+}
+
+type buildLogsCmdOptions struct {
+	*RootCmdOptions
+
+	FailedOnly        bool   `mapstructure:"failed-only"`
+	OperatorNamespace string `mapstructure:"operator-namespace"`
+}
+
+func (o *buildLogsCmdOptions) validate(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("build logs expects an integration or integration kit name argument")
+	}
+
+	return nil
+}
+
+func (o *buildLogsCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	if o.FailedOnly {
+		build, err := o.resolveBuild(o.Context, c, args[0])
+		if err != nil {
+			return err
+		}
+		if build == nil {
+			return fmt.Errorf("no Build found for %s", args[0])
+		}
+
+		return o.printFailure(o.Context, c, build, cmd.OutOrStdout())
+	}
+
+	var build *v1.Build
+	err = wait.PollUntilContextCancel(o.Context, buildLogsPollInterval, true, func(ctx context.Context) (bool, error) {
+		build, err = o.resolveBuild(ctx, c, args[0])
+
+		return build != nil, err
+	})
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if build.BuilderConfiguration().Strategy == v1.BuildStrategyPod {
+		fmt.Fprintf(out, "Following Build %s in builder Pod %s\n", build.Name, builderPodName(build))
+
+		return o.followPod(o.Context, c, build, out)
+	}
+	fmt.Fprintf(out, "Following Build %s in the operator\n", build.Name)
+
+	return o.followRoutine(o.Context, c, build, out)
+}
+
+// resolveBuild returns the Build of the Integration or Integration Kit with the given name, or nil when it is not created yet.
+func (o *buildLogsCmdOptions) resolveBuild(ctx context.Context, c client.Client, name string) (*v1.Build, error) {
+	key := ctrl.ObjectKey{Namespace: o.Namespace, Name: name}
+
+	it := v1.Integration{}
+	if err := c.Get(ctx, key, &it); err == nil {
+		if it.Status.IntegrationKit == nil {
+			return nil, nil
+		}
+		key = ctrl.ObjectKey{Namespace: it.Status.IntegrationKit.Namespace, Name: it.Status.IntegrationKit.Name}
+		if key.Namespace == "" {
+			key.Namespace = it.Namespace
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	kit := v1.IntegrationKit{}
+	if err := c.Get(ctx, key, &kit); err != nil {
+		switch {
+		case !k8serrors.IsNotFound(err):
+			return nil, err
+		case it.Name != "":
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("no Integration or IntegrationKit named %s in namespace %s", name, o.Namespace)
+		}
+	}
+
+	// The Build of an Integration Kit has the same name
+	build := v1.Build{}
+	if err := c.Get(ctx, key, &build); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if kit.Status.Phase == v1.IntegrationKitPhaseReady || kit.Status.Phase == v1.IntegrationKitPhaseError {
+			return nil, fmt.Errorf("no Build found for IntegrationKit %s in namespace %s", kit.Name, kit.Namespace)
+		}
+
+		return nil, nil
+	}
+
+	return &build, nil
+}
+
+// followPod prints the logs of the builder Pod containers, one build task after the other.
+func (o *buildLogsCmdOptions) followPod(ctx context.Context, c client.Client, build *v1.Build, out io.Writer) error {
+	printer := newBuildLogPrinter(out)
+
+	var pod *corev1.Pod
+	for i := 0; ; i++ {
+		var container *corev1.ContainerStatus
+		err := wait.PollUntilContextCancel(ctx, buildLogsPollInterval, true, func(ctx context.Context) (bool, error) {
+			var err error
+			pod, err = getBuilderPod(ctx, c, build)
+			if err != nil {
+				return false, err
+			}
+			if pod == nil {
+				// The builder Pod is not created yet, or already deleted
+				return buildFinished(ctx, c, build)
+			}
+			statuses := builderPodContainerStatuses(pod)
+			if i >= len(statuses) {
+				return true, nil
+			}
+			container = &statuses[i]
+
+			// Stop waiting for a task that will never run
+			return container.State.Waiting == nil || pod.Status.Phase == corev1.PodFailed, nil
+		})
+		if err != nil {
+			return err
+		}
+		if pod == nil || container == nil || container.State.Waiting != nil {
+			return nil
+		}
+
+		stream, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container: container.Name,
+			Follow:    true,
+		}).Stream(ctx)
+		if err != nil {
+			return err
+		}
+		err = printer.printFrom(stream, container.Name, nil)
+		stream.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// followRoutine prints the logs of the Build running as a routine, out of the operator logs.
+func (o *buildLogsCmdOptions) followRoutine(ctx context.Context, c client.Client, build *v1.Build, out io.Writer) error {
+	pod := o.getOperatorPod(ctx, c, build)
+	if pod == nil {
+		return errors.New("cannot find the operator Pod running the Build, use the --operator-namespace flag to set its namespace")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	finished := build.Status.IsFinished()
+	if !finished {
+		// Stop following the operator logs once the Build is finished
+		go func() {
+			_ = wait.PollUntilContextCancel(ctx, buildLogsPollInterval, false, func(ctx context.Context) (bool, error) {
+				return buildFinished(ctx, c, build)
+			})
+			time.Sleep(buildLogsGracePeriod)
+			cancel()
+		}()
+	}
+
+	stream, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Follow:    !finished,
+		SinceTime: build.Status.StartedAt,
+	}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	err = newBuildLogPrinter(out).printFrom(stream, "", build)
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// printFailure prints a summary of the failed Build, along with the Maven errors reported by the failed task.
+func (o *buildLogsCmdOptions) printFailure(ctx context.Context, c client.Client, build *v1.Build, out io.Writer) error {
+	if build.Status.Phase != v1.BuildPhaseFailed && build.Status.Phase != v1.BuildPhaseError {
+		fmt.Fprintf(out, "Build %s is %s, there is no failure to report\n", build.Name, buildPhase(build))
+
+		return nil
+	}
+
+	var records []buildLogRecord
+	failedTask := ""
+	if build.BuilderConfiguration().Strategy == v1.BuildStrategyPod {
+		pod, err := getBuilderPod(ctx, c, build)
+		if err != nil {
+			return err
+		}
+		if pod != nil {
+			for _, container := range builderPodContainerStatuses(pod) {
+				if container.State.Terminated != nil && container.State.Terminated.ExitCode != 0 {
+					failedTask = container.Name
+					records, err = readBuildLogs(ctx, c, pod, container.Name, nil)
+					if err != nil {
+						return err
+					}
+
+					break
+				}
+			}
+		}
+	} else if pod := o.getOperatorPod(ctx, c, build); pod != nil {
+		var err error
+		records, err = readBuildLogs(ctx, c, pod, "", build)
+		if err != nil {
+			return err
+		}
+	}
+
+	failedPhase := ""
+	for _, r := range records {
+		if r.Level == "error" && failedTask == "" {
+			failedTask = r.Task
+		}
+		if r.Task == failedTask && r.Phase != "" {
+			failedPhase = r.Phase
+		}
+	}
+
+	fmt.Fprintf(out, "Build:\t\t%s\n", build.Name)
+	fmt.Fprintf(out, "Namespace:\t%s\n", build.Namespace)
+	fmt.Fprintf(out, "Phase:\t\t%s\n", buildPhase(build))
+	if failedTask != "" {
+		fmt.Fprintf(out, "Failed Task:\t%s\n", failedTask)
+	}
+	if failedPhase != "" {
+		fmt.Fprintf(out, "Failed Phase:\t%s\n", failedPhase)
+	}
+	if build.Status.Error != "" {
+		fmt.Fprintf(out, "Failure Class:\t%s\n", maven.FailureClass(build.Status.Error))
+		fmt.Fprintf(out, "Error:\t\t%s\n", build.Status.Error)
+	}
+
+	if block := mavenErrorBlock(records, failedTask); len(block) > 0 {
+		fmt.Fprintln(out, "Maven Errors:")
+		for _, line := range block {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	} else if records == nil {
+		fmt.Fprintln(out, "The Build logs are no longer available")
+	}
+
+	return nil
+}
+
+// getOperatorPod returns the operator Pod, looking into the operator namespace if set,
+// then into the Build namespace, and finally into any namespace.
+func (o *buildLogsCmdOptions) getOperatorPod(ctx context.Context, c client.Client, build *v1.Build) *corev1.Pod {
+	if o.OperatorNamespace != "" {
+		return platform.GetOperatorPod(ctx, c, o.OperatorNamespace)
+	}
+	if pod := platform.GetOperatorPod(ctx, c, build.Namespace); pod != nil {
+		return pod
+	}
+
+	return platform.GetOperatorPod(ctx, c, "")
+}
+
+// buildFinished returns whether the Build is finished, or deleted.
+func buildFinished(ctx context.Context, c client.Client, build *v1.Build) (bool, error) {
+	b := v1.Build{}
+	if err := c.Get(ctx, ctrl.ObjectKeyFromObject(build), &b); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return b.Status.IsFinished(), nil
+}
+
+func buildPhase(build *v1.Build) string {
+	if build.Status.Phase == v1.BuildPhaseNone {
+		return "not started"
+	}
+
+	return string(build.Status.Phase)
+}
+
+// builderPodName returns the name of the Pod the operator creates to run a Build with the pod strategy.
+func builderPodName(build *v1.Build) string {
+	return "camel-k-" + build.Name + "-builder"
+}
+
+// getBuilderPod returns the builder Pod of the Build, or nil when it does not exist.
+func getBuilderPod(ctx context.Context, c client.Client, build *v1.Build) (*corev1.Pod, error) {
+	namespace := build.BuilderPodNamespace()
+	if namespace == "" {
+		namespace = build.Namespace
+	}
+
+	pod, err := c.CoreV1().Pods(namespace).Get(ctx, builderPodName(build), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return pod, err
+}
+
+// builderPodContainerStatuses returns the status of the builder Pod containers, in the order of the build tasks.
+func builderPodContainerStatuses(pod *corev1.Pod) []corev1.ContainerStatus {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			status := corev1.ContainerStatus{
+				Name: container.Name,
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{},
+				},
+			}
+			for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+				if s.Name == container.Name {
+					status = s
+				}
+			}
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses
+}
+
+// readBuildLogs returns the log records of the given Pod container, filtered for the given Build when set.
+func readBuildLogs(ctx context.Context, c client.Client, pod *corev1.Pod, container string, build *v1.Build) ([]buildLogRecord, error) {
+	stream, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+	}).Stream(ctx)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	defer stream.Close()
+
+	records := make([]buildLogRecord, 0)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if r, ok := parseBuildLogLine(scanner.Text(), container, build); ok {
+			records = append(records, r)
+		}
+	}
+
+	return records, scanner.Err()
+}
+
+// buildLogRecord is a trace of a build task.
+type buildLogRecord struct {
+	Level  string
+	Logger string
+	Msg    string
+	Task   string
+	Phase  string
+	Step   string
+}
+
+// parseBuildLogLine parses a line of the builder logs. The JSON traces attach the build task, phase and step they relate to.
+// When the Build is set, only its traces are returned, as the operator logs are shared with the other Builds and controllers,
+// otherwise the line is attributed to the given task, and kept as is when it is not a JSON trace.
+func parseBuildLogLine(line string, task string, build *v1.Build) (buildLogRecord, bool) {
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		if build != nil || strings.TrimSpace(line) == "" {
+			return buildLogRecord{}, false
+		}
+
+		return buildLogRecord{Msg: line, Task: task}, true
+	}
+
+	value := func(key string) string {
+		if s, ok := fields[key].(string); ok {
+			return s
+		}
+
+		return ""
+	}
+	if build != nil && (value(builder.LogNamespaceKey) != build.Namespace || value(builder.LogBuildKey) != build.Name) {
+		return buildLogRecord{}, false
+	}
+
+	r := buildLogRecord{
+		Level:  value("level"),
+		Logger: value("logger"),
+		Msg:    value("msg"),
+		Task:   value(builder.LogTaskKey),
+		Phase:  value(builder.LogPhaseKey),
+		Step:   value(builder.LogStepKey),
+	}
+	if r.Task == "" {
+		r.Task = task
+	}
+
+	return r, true
+}
+
+// buildLogPrinter prints the build log records, grouped by task and phase.
+type buildLogPrinter struct {
+	out   io.Writer
+	task  string
+	phase string
+}
+
+func newBuildLogPrinter(out io.Writer) *buildLogPrinter {
+	return &buildLogPrinter{out: out}
+}
+
+func (p *buildLogPrinter) printFrom(in io.Reader, task string, build *v1.Build) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if r, ok := parseBuildLogLine(scanner.Text(), task, build); ok {
+			p.print(r)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (p *buildLogPrinter) print(r buildLogRecord) {
+	if r.Task != p.task || (r.Phase != "" && r.Phase != p.phase) {
+		p.task = r.Task
+		p.phase = r.Phase
+		header := "[" + r.Task + "]"
+		if r.Phase != "" {
+			header += " [" + r.Phase + "]"
+		}
+		fmt.Fprintln(p.out, header)
+	}
+
+	if r.Level == "" {
+		fmt.Fprintf(p.out, "  %s\n", r.Msg)
+
+		return
+	}
+	fmt.Fprintf(p.out, "  %-5s %s\n", strings.ToUpper(r.Level), r.Msg)
+}
+
+// mavenErrorBlock returns the errors reported by Maven for the given task, without the trailing help Maven prints.
+func mavenErrorBlock(records []buildLogRecord, task string) []string {
+	block := make([]string, 0)
+	for _, r := range records {
+		if task != "" && r.Task != task {
+			continue
+		}
+
+		msg, ok := "", false
+		switch {
+		case r.Level == "error" && strings.HasSuffix(r.Logger, "maven.build"):
+			msg, ok = r.Msg, true
+		case r.Level == "" && strings.HasPrefix(r.Msg, "[ERROR]"):
+			msg, ok = strings.TrimSpace(strings.TrimPrefix(r.Msg, "[ERROR]")), true
+		}
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(msg, "To see the full stack trace of the errors") {
+			break
+		}
+		block = append(block, msg)
+	}
+
+	// Trim the blank lines Maven prints to separate the errors
+	for len(block) > 0 && strings.TrimSpace(block[len(block)-1]) == "" {
+		block = block[:len(block)-1]
+	}
+
+	return block
+}
@@ -0,0 +1,212 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdBuildLogs = "logs"
+
+func initializeBuildLogsCmdOptions(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	buildCmd, _ := newCmdBuild(options)
+	rootCmd.AddCommand(buildCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func newFailedBuild(name string, strategy v1.BuildStrategy) *v1.Build {
+	build := &v1.Build{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.BuildKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{Builder: &v1.BuilderTask{BaseTask: v1.BaseTask{Name: "builder", Configuration: v1.BuildConfiguration{Strategy: strategy}}}},
+				{Package: &v1.BuilderTask{BaseTask: v1.BaseTask{Name: "package"}}},
+				{Jib: &v1.JibTask{BaseTask: v1.BaseTask{Name: "jib"}}},
+			},
+		},
+	}
+	build.Status.Phase = v1.BuildPhaseFailed
+	build.Status.Error = "Failed to execute goal on project camel-k-integration: Could not resolve dependencies for project"
+
+	return build
+}
+
+func TestBuildLogsNoArgument(t *testing.T) {
+	rootCmd := initializeBuildLogsCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdBuild, cmdBuildLogs)
+	require.Error(t, err)
+	assert.Equal(t, "build logs expects an integration or integration kit name argument", err.Error())
+}
+
+func TestBuildLogsNotFound(t *testing.T) {
+	rootCmd := initializeBuildLogsCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdBuild, cmdBuildLogs, "my-it", "--failed-only")
+	require.Error(t, err)
+	assert.Equal(t, "no Integration or IntegrationKit named my-it in namespace default", err.Error())
+}
+
+func TestBuildLogsFailedOnlyNotFailed(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	build := newFailedBuild("my-kit", v1.BuildStrategyRoutine)
+	build.Status.Phase = v1.BuildPhaseSucceeded
+	build.Status.Error = ""
+
+	rootCmd := initializeBuildLogsCmdOptions(t, kit, build)
+	out, err := ExecuteCommand(rootCmd, cmdBuild, cmdBuildLogs, "my-kit", "--failed-only")
+	require.NoError(t, err)
+	assert.Equal(t, "Build my-kit is Succeeded, there is no failure to report\n", out)
+}
+
+func TestBuildLogsFailedOnlyPod(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "default", Name: "my-kit"}
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	build := newFailedBuild("my-kit", v1.BuildStrategyPod)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "camel-k-my-kit-builder",
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "builder"}, {Name: "package"}},
+			Containers:     []corev1.Container{{Name: "jib"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "builder", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "package", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}},
+			},
+		},
+	}
+
+	rootCmd := initializeBuildLogsCmdOptions(t, &it, kit, build, pod)
+	out, err := ExecuteCommand(rootCmd, cmdBuild, cmdBuildLogs, "my-it", "--failed-only")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Build:\t\tmy-kit\n")
+	assert.Contains(t, out, "Phase:\t\tFailed\n")
+	assert.Contains(t, out, "Failed Task:\tpackage\n")
+	assert.Contains(t, out, "Failure Class:\tDependency\n")
+	assert.Contains(t, out, "Error:\t\tFailed to execute goal")
+}
+
+func TestBuildLogsFailedOnlyRoutineNoOperator(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	build := newFailedBuild("my-kit", v1.BuildStrategyRoutine)
+
+	rootCmd := initializeBuildLogsCmdOptions(t, kit, build)
+	out, err := ExecuteCommand(rootCmd, cmdBuild, cmdBuildLogs, "my-kit", "--failed-only")
+	require.NoError(t, err)
+	assert.NotContains(t, out, "Failed Task:")
+	assert.Contains(t, out, "The Build logs are no longer available\n")
+}
+
+func TestParseBuildLogLine(t *testing.T) {
+	build := newFailedBuild("my-kit", v1.BuildStrategyRoutine)
+	line := `{"level":"error","ts":"2026-10-17T04:14:50Z","logger":"camel-k.builder.maven.build","msg":"boom",` +
+		`"ns":"default","build":"my-kit","task":"builder","step":"builder/ExecuteMavenPackageCommand","phase":"project-build"}`
+
+	r, ok := parseBuildLogLine(line, "", build)
+	require.True(t, ok)
+	assert.Equal(t, buildLogRecord{
+		Level:  "error",
+		Logger: "camel-k.builder.maven.build",
+		Msg:    "boom",
+		Task:   "builder",
+		Phase:  "project-build",
+		Step:   "builder/ExecuteMavenPackageCommand",
+	}, r)
+
+	_, ok = parseBuildLogLine(strings.Replace(line, `"build":"my-kit"`, `"build":"other-kit"`, 1), "", build)
+	assert.False(t, ok)
+	_, ok = parseBuildLogLine("not a trace", "", build)
+	assert.False(t, ok)
+
+	r, ok = parseBuildLogLine("not a trace", "buildpacks", nil)
+	require.True(t, ok)
+	assert.Equal(t, buildLogRecord{Msg: "not a trace", Task: "buildpacks"}, r)
+}
+
+func TestBuildLogPrinter(t *testing.T) {
+	in := strings.Join([]string{
+		`{"level":"info","logger":"camel-k.builder","msg":"running builder task builder","task":"builder"}`,
+		`{"level":"info","logger":"camel-k.builder","msg":"executing step","task":"builder","phase":"init"}`,
+		`{"level":"info","logger":"camel-k.builder","msg":"executing step","task":"builder","phase":"project-build"}`,
+		`{"level":"error","logger":"camel-k.builder.maven.build","msg":"boom","task":"builder","phase":"project-build"}`,
+		`{"level":"info","logger":"camel-k.builder","msg":"running builder task package","task":"package"}`,
+	}, "\n")
+
+	var out bytes.Buffer
+	require.NoError(t, newBuildLogPrinter(&out).printFrom(strings.NewReader(in), "", nil))
+	assert.Equal(t, `[builder]
+  INFO  running builder task builder
+[builder] [init]
+  INFO  executing step
+[builder] [project-build]
+  INFO  executing step
+  ERROR boom
+[package]
+  INFO  running builder task package
+`, out.String())
+}
+
+func TestMavenErrorBlock(t *testing.T) {
+	records := []buildLogRecord{
+		{Level: "info", Logger: "camel-k.builder.maven.build", Msg: "BUILD FAILURE", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder.maven.build", Msg: "Failed to execute goal", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder.maven.build", Msg: "", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder.maven.build", Msg: "To see the full stack trace of the errors, re-run Maven with the -e switch.", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder.maven.build", Msg: "Re-run Maven using the -X switch to enable full debug logging.", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder", Msg: "step failed", Task: "builder"},
+		{Level: "error", Logger: "camel-k.builder.maven.build", Msg: "Another task", Task: "package"},
+	}
+	assert.Equal(t, []string{"Failed to execute goal"}, mavenErrorBlock(records, "builder"))
+
+	raw := []buildLogRecord{
+		{Msg: "[INFO] BUILD FAILURE", Task: "custom"},
+		{Msg: "[ERROR] Failed to execute goal", Task: "custom"},
+		{Msg: "[ERROR] Compilation failure", Task: "custom"},
+	}
+	assert.Equal(t, []string{"Failed to execute goal", "Compilation failure"}, mavenErrorBlock(raw, "custom"))
+}
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cmdBuild = "build"
+
+func initializeBuildCmdOptions(t *testing.T) (*buildCmdOptions, *cobra.Command) {
+	t.Helper()
+
+	options, rootCmd := kamelTestPreAddCommandInit()
+	buildCmd, buildOptions := newCmdBuild(options)
+	rootCmd.AddCommand(buildCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return buildOptions, rootCmd
+}
+
+func TestBuildNoLocalOutput(t *testing.T) {
+	_, rootCmd := initializeBuildCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdBuild, "route.yaml")
+	require.Error(t, err)
+	assert.Equal(t, "the --local-output flag is required, as the Integrations are built in the cluster by kamel run", err.Error())
+}
+
+func TestBuildNoSources(t *testing.T) {
+	_, rootCmd := initializeBuildCmdOptions(t)
+	_, err := ExecuteCommand(rootCmd, cmdBuild, "--local-output", t.TempDir())
+	require.Error(t, err)
+	assert.Equal(t, "build expects at least 1 source file", err.Error())
+}
+
+func TestBuildLocalOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	route := filepath.Join(tmpDir, "route.yaml")
+	require.NoError(t, os.WriteFile(route, []byte(`
+- from:
+    uri: "timer:tick"
+    steps:
+      - to: "log:info"
+`), 0o600))
+	profile := filepath.Join(tmpDir, "profile.xml")
+	require.NoError(t, os.WriteFile(profile, []byte(`<profile><id>my-profile</id></profile>`), 0o600))
+	output := filepath.Join(tmpDir, "output")
+
+	buildOptions, rootCmd := initializeBuildCmdOptions(t)
+	out, err := ExecuteCommand(rootCmd, cmdBuild, route,
+		"--local-output", output,
+		"-d", "camel:mail",
+		"--maven-repository", "https://repo.example.com/maven2@id=example",
+		"--maven-profile", profile,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, output, buildOptions.LocalOutput)
+	assert.Contains(t, out, "Maven project generated in "+output)
+
+	pom, err := os.ReadFile(filepath.Join(output, "pom.xml"))
+	require.NoError(t, err)
+	for _, artifact := range []string{"camel-quarkus-timer", "camel-quarkus-log", "camel-quarkus-mail", "camel-quarkus-yaml-dsl"} {
+		assert.Contains(t, string(pom), "<artifactId>"+artifact+"</artifactId>")
+	}
+	assert.Contains(t, string(pom), "<url>https://repo.example.com/maven2</url>")
+	assert.Contains(t, string(pom), "<id>my-profile</id>")
+	assert.FileExists(t, filepath.Join(output, "settings.xml"))
+	assert.FileExists(t, filepath.Join(output, ".mvn", "maven.config"))
+}
@@ -19,8 +19,10 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -80,15 +82,32 @@ func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 	}
 
 	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
-	fmt.Fprintln(w, "NAME\tPHASE\tKIT")
+	fmt.Fprintln(w, "NAME\tPHASE\tKIT\tRECOMMENDATION")
 	for _, integration := range integrationList.Items {
 		kit := ""
 		if integration.Status.IntegrationKit != nil {
 			ns := integration.GetIntegrationKitNamespace("")
 			kit = fmt.Sprintf("%s/%s", ns, integration.Status.IntegrationKit.Name)
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", integration.Name, string(integration.Status.Phase), kit,
+			formatResourcesRecommendation(integration.Status.ResourcesRecommendation))
 	}
 
 	return w.Flush()
 }
+
+// formatResourcesRecommendation returns the target resources recommended by the VerticalPodAutoscaler, if any.
+func formatResourcesRecommendation(recommendation *v1.IntegrationResourcesRecommendation) string {
+	if recommendation == nil {
+		return ""
+	}
+	resources := make([]string, 0, 2)
+	if cpu, ok := recommendation.Target[corev1.ResourceCPU]; ok {
+		resources = append(resources, "cpu="+cpu.String())
+	}
+	if memory, ok := recommendation.Target[corev1.ResourceMemory]; ok {
+		resources = append(resources, "memory="+memory.String())
+	}
+
+	return strings.Join(resources, ",")
+}
@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdGet = "get"
+
+func initializeGetCmdOptions(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	getCmd, _ := newCmdGet(options)
+	rootCmd.AddCommand(getCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func TestGetResourcesRecommendation(t *testing.T) {
+	recommended := v1.NewIntegration("default", "recommended")
+	recommended.Status.Phase = v1.IntegrationPhaseRunning
+	recommended.Status.ResourcesRecommendation = &v1.IntegrationResourcesRecommendation{
+		Target: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("512Mi"),
+		},
+	}
+	notRecommended := v1.NewIntegration("default", "not-recommended")
+	notRecommended.Status.Phase = v1.IntegrationPhaseRunning
+	cmd := initializeGetCmdOptions(t, &recommended, &notRecommended)
+
+	output, err := ExecuteCommand(cmd, cmdGet)
+	require.NoError(t, err)
+	assert.Contains(t, output, `NAME		PHASE	KIT	RECOMMENDATION
+not-recommended	Running		
+recommended	Running		cpu=250m,memory=512Mi
+`)
+}
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -50,6 +51,7 @@ func newKitGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitGetComman
 	cmd.Flags().Bool(v1.IntegrationKitTypeUser, true, "Includes user Kits")
 	cmd.Flags().Bool(v1.IntegrationKitTypeExternal, true, "Includes external Kits")
 	cmd.Flags().Bool(v1.IntegrationKitTypePlatform, true, "Includes platform Kits")
+	cmd.Flags().Bool("vulnerabilities", false, "Lists the vulnerabilities found in the Kits dependencies")
 
 	return &cmd, &options
 }
@@ -57,9 +59,10 @@ func newKitGetCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitGetComman
 type kitGetCommandOptions struct {
 	*RootCmdOptions
 
-	User     bool `mapstructure:"user"`
-	External bool `mapstructure:"external"`
-	Platform bool `mapstructure:"platform"`
+	User            bool `mapstructure:"user"`
+	External        bool `mapstructure:"external"`
+	Platform        bool `mapstructure:"platform"`
+	Vulnerabilities bool `mapstructure:"vulnerabilities"`
 }
 
 func (command *kitGetCommandOptions) validate(cmd *cobra.Command, args []string) error {
@@ -76,8 +79,9 @@ func (command *kitGetCommandOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
-	fmt.Fprintln(w, "NAME\tPHASE\tTYPE\tIMAGE")
+	kits := make([]v1.IntegrationKit, 0, len(kitList.Items))
+	// the vulnerabilities are only shown when any kit has been scanned
+	scanned := false
 	for _, ctx := range kitList.Items {
 		t := ctx.Labels[v1.IntegrationKitTypeLabel]
 		u := command.User && t == v1.IntegrationKitTypeUser
@@ -85,9 +89,77 @@ func (command *kitGetCommandOptions) run(cmd *cobra.Command) error {
 		p := command.Platform && t == v1.IntegrationKitTypePlatform
 
 		if u || e || p {
+			kits = append(kits, ctx)
+			scanned = scanned || ctx.Status.GetCondition(v1.IntegrationKitConditionVulnerabilityScan) != nil
+		}
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	if scanned {
+		fmt.Fprintln(w, "NAME\tPHASE\tTYPE\tIMAGE\tVULNERABILITIES")
+	} else {
+		fmt.Fprintln(w, "NAME\tPHASE\tTYPE\tIMAGE")
+	}
+	for _, ctx := range kits {
+		t := ctx.Labels[v1.IntegrationKitTypeLabel]
+		if scanned {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ctx.Name, string(ctx.Status.Phase), t, ctx.Status.Image, vulnerabilitiesSummary(ctx))
+		} else {
 			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ctx.Name, string(ctx.Status.Phase), t, ctx.Status.Image)
 		}
 	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if !command.Vulnerabilities {
+		return nil
+	}
+	for _, kit := range kits {
+		if len(kit.Status.Vulnerabilities) == 0 {
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "\nKit %s vulnerabilities:\n", kit.Name)
+		w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "ID\tSEVERITY\tDEPENDENCY\tSUMMARY")
+		for _, v := range kit.Status.Vulnerabilities {
+			severity := string(v.Severity)
+			if severity == "" {
+				severity = "Unknown"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", v.ID, severity, v.Dependency, v.Summary)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// vulnerabilitiesSummary returns the number of vulnerabilities found in the kit dependencies, by severity,
+// or an empty string when the dependencies have not been scanned.
+func vulnerabilitiesSummary(kit v1.IntegrationKit) string {
+	if kit.Status.GetCondition(v1.IntegrationKitConditionVulnerabilityScan) == nil {
+		return ""
+	}
+	if len(kit.Status.Vulnerabilities) == 0 {
+		return "0"
+	}
+
+	counts := make(map[v1.VulnerabilitySeverity]int)
+	for _, v := range kit.Status.Vulnerabilities {
+		counts[v.Severity]++
+	}
+	details := make([]string, 0, len(counts))
+	if n := counts[""]; n > 0 {
+		details = append(details, fmt.Sprintf("Unknown: %d", n))
+	}
+	for i := len(v1.VulnerabilitySeverities) - 1; i >= 0; i-- {
+		if n := counts[v1.VulnerabilitySeverities[i]]; n > 0 {
+			details = append(details, fmt.Sprintf("%s: %d", v1.VulnerabilitySeverities[i], n))
+		}
+	}
 
-	return w.Flush()
+	return fmt.Sprintf("%d (%s)", len(kit.Status.Vulnerabilities), strings.Join(details, ", "))
 }
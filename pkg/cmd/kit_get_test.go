@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+const cmdKitGet = "get"
+
+func initializeKitGetCmdOptions(t *testing.T, initObjs ...runtime.Object) *cobra.Command {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	kitGetCmd, _ := newKitGetCmd(options)
+	rootCmd.AddCommand(kitGetCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd
+}
+
+func newScannedKit(name string, vulnerabilities ...v1.Vulnerability) *v1.IntegrationKit {
+	kit := v1.NewIntegrationKit("default", name)
+	kit.Labels = map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform}
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	kit.Status.Vulnerabilities = vulnerabilities
+	kit.Status.SetCondition(v1.IntegrationKitConditionVulnerabilityScan, corev1.ConditionTrue, v1.BuildConditionVulnerabilityScanPassedReason, "")
+
+	return kit
+}
+
+func TestKitGetNotScanned(t *testing.T) {
+	kit := v1.NewIntegrationKit("default", "kit-not-scanned")
+	kit.Labels = map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform}
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	cmd := initializeKitGetCmdOptions(t, kit)
+
+	output, err := ExecuteCommand(cmd, cmdKitGet)
+	require.NoError(t, err)
+	assert.Equal(t, `NAME		PHASE	TYPE		IMAGE
+kit-not-scanned	Ready	platform	
+`, output)
+}
+
+func TestKitGetVulnerabilities(t *testing.T) {
+	notScanned := v1.NewIntegrationKit("default", "kit-not-scanned")
+	notScanned.Labels = map[string]string{v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform}
+	cmd := initializeKitGetCmdOptions(t,
+		notScanned,
+		newScannedKit("kit-safe"),
+		newScannedKit("kit-vulnerable",
+			v1.Vulnerability{ID: "GHSA-0001", Dependency: "org.example:lib:1.0", Severity: v1.VulnerabilitySeverityCritical, Summary: "RCE"},
+			v1.Vulnerability{ID: "GHSA-0002", Dependency: "org.example:lib:1.0", Severity: v1.VulnerabilitySeverityLow},
+			v1.Vulnerability{ID: "GHSA-0003", Dependency: "org.example:other:2.0", Severity: v1.VulnerabilitySeverityLow},
+		),
+	)
+
+	output, err := ExecuteCommand(cmd, cmdKitGet, "--vulnerabilities")
+	require.NoError(t, err)
+	assert.Equal(t, `NAME		PHASE	TYPE		IMAGE	VULNERABILITIES
+kit-not-scanned		platform		
+kit-safe	Ready	platform		0
+kit-vulnerable	Ready	platform		3 (Critical: 1, Low: 2)
+
+Kit kit-vulnerable vulnerabilities:
+ID		SEVERITY	DEPENDENCY		SUMMARY
+GHSA-0001	Critical	org.example:lib:1.0	RCE
+GHSA-0002	Low		org.example:lib:1.0	
+GHSA-0003	Low		org.example:other:2.0	
+`, output)
+}
@@ -58,6 +58,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/controller"
+	"github.com/apache/camel-k/v2/pkg/controller/pipe"
 	"github.com/apache/camel-k/v2/pkg/controller/synthetic"
 	"github.com/apache/camel-k/v2/pkg/install"
 	"github.com/apache/camel-k/v2/pkg/platform"
@@ -180,9 +181,10 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	}
 
 	selectors := map[ctrl.Object]cache.ByObject{
-		&corev1.Pod{}:        selector,
-		&appsv1.Deployment{}: selector,
-		&batchv1.Job{}:       selector,
+		&corev1.Pod{}:         selector,
+		&appsv1.Deployment{}:  selector,
+		&appsv1.StatefulSet{}: selector,
+		&batchv1.Job{}:        selector,
 	}
 
 	if ok, err := kubernetes.IsAPIResourceInstalled(bootstrapClient, servingv1.SchemeGroupVersion.String(), reflect.TypeFor[servingv1.Service]().Name()); ok && err == nil {
@@ -220,6 +222,12 @@ func Run(healthPort, monitoringPort int32, leaderElection bool, leaderElectionID
 	exitOnError(err, "")
 	exitOnError(controller.AddToManager(ctx, mgr, ctrlClient), "")
 
+	pipeWebhookEnvVal, pipeWebhook := os.LookupEnv("CAMEL_K_PIPE_VALIDATION_WEBHOOK")
+	if pipeWebhook && pipeWebhookEnvVal == "true" {
+		log.Info("Registering the Pipe validating admission webhook")
+		exitOnError(pipe.AddWebhook(mgr, ctrlClient), "cannot register the Pipe validating admission webhook")
+	}
+
 	log.Info("Installing operator resources")
 	installCtx, installCancel := context.WithTimeout(ctx, 1*time.Minute)
 	defer installCancel()
@@ -0,0 +1,139 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+func newCmdRollback(rootCmdOptions *RootCmdOptions) (*cobra.Command, *rollbackCmdOptions) {
+	options := rollbackCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "rollback <integration>",
+		Short: "Roll back an Integration to a previous revision.",
+		Long: `Restore the specification of an Integration from the revision history recorded by the operator each time the Integration is deployed. ` +
+			`The Integration is rolled back to the revision preceding the current one, unless a revision is set with the --to-revision flag.`,
+		PreRunE: decode(&options, options.Flags),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+
+			return options.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().Int("to-revision", 0, "The revision to roll back to, instead of the one preceding the current revision")
+
+	return &cmd, &options
+}
+
+type rollbackCmdOptions struct {
+	*RootCmdOptions
+
+	ToRevision int `mapstructure:"to-revision"`
+}
+
+func (o *rollbackCmdOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("rollback requires an Integration name argument")
+	}
+	if o.ToRevision < 0 {
+		return fmt.Errorf("invalid revision: %d", o.ToRevision)
+	}
+
+	return nil
+}
+
+func (o *rollbackCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+	it, err := getIntegration(o.Context, c, args[0], o.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not find integration %s in namespace %s: %w", args[0], o.Namespace, err)
+	}
+	if kind, name := findCreator(it); kind == v1.PipeKind {
+		return fmt.Errorf("integration %s is managed by Pipe %s: update the Pipe instead", it.Name, name)
+	}
+
+	target, err := o.findRevision(c, it)
+	if err != nil {
+		return err
+	}
+	if target.Digest == it.Status.Digest {
+		fmt.Fprintf(cmd.OutOrStdout(), "Integration %s is already at revision %d\n", it.Name, target.Number)
+
+		return nil
+	}
+
+	it.Spec = target.Integration.Spec
+	if err := c.Update(o.Context, it); err != nil {
+		return fmt.Errorf("could not roll back integration %s in namespace %s: %w", it.Name, o.Namespace, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Integration %s rolled back to revision %d\n", it.Name, target.Number)
+
+	return nil
+}
+
+// findRevision returns the revision set with the --to-revision flag, or the one preceding the current revision.
+func (o *rollbackCmdOptions) findRevision(c client.Client, it *v1.Integration) (*revision.Revision, error) {
+	if o.ToRevision > 0 {
+		target, err := revision.Get(o.Context, c, it.Namespace, it.Name, o.ToRevision)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			return nil, fmt.Errorf("revision %d not found in the history of integration %s", o.ToRevision, it.Name)
+		}
+
+		return target, nil
+	}
+
+	revisions, err := revision.List(o.Context, c, it.Namespace, it.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision history found for integration %s", it.Name)
+	}
+	// The current revision may not be recorded yet, i.e., when the Integration is not deployed
+	current := len(revisions)
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if revisions[i].Digest == it.Status.Digest {
+			current = i
+
+			break
+		}
+	}
+	if current == 0 {
+		return nil, fmt.Errorf("no revision found before revision %d of integration %s", revisions[0].Number, it.Name)
+	}
+
+	return &revisions[current-1], nil
+}
@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+const cmdRollback = "rollback"
+
+func initializeRollbackCmdOptions(t *testing.T, initObjs ...runtime.Object) (*cobra.Command, *rollbackCmdOptions, client.Client) {
+	t.Helper()
+	fakeClient, err := internal.NewFakeClient(initObjs...)
+	require.NoError(t, err)
+	options, rootCmd := kamelTestPreAddCommandInitWithClient(fakeClient)
+	options.Namespace = "default"
+	rollbackCmdOptions := addTestRollbackCmd(*options, rootCmd)
+	kamelTestPostAddCommandInit(t, rootCmd, options)
+
+	return rootCmd, rollbackCmdOptions, fakeClient
+}
+
+func addTestRollbackCmd(options RootCmdOptions, rootCmd *cobra.Command) *rollbackCmdOptions {
+	rollbackCmd, rollbackOptions := newCmdRollback(&options)
+	rollbackCmd.Args = ArbitraryArgs
+	rootCmd.AddCommand(rollbackCmd)
+	return rollbackOptions
+}
+
+func TestRollbackNonExistingFlag(t *testing.T) {
+	cmd, _, _ := initializeRollbackCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdRollback, "--nonExistingFlag")
+	require.Error(t, err)
+	assert.Equal(t, "unknown flag: --nonExistingFlag", err.Error())
+}
+
+func TestRollbackNoArgs(t *testing.T) {
+	cmd, _, _ := initializeRollbackCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdRollback)
+	require.Error(t, err)
+	assert.Equal(t, "rollback requires an Integration name argument", err.Error())
+}
+
+func TestRollbackToRevisionFlag(t *testing.T) {
+	cmd, rollbackOptions, _ := initializeRollbackCmdOptions(t)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "3", "--namespace", "missing")
+	require.Error(t, err)
+	assert.Equal(t, 3, rollbackOptions.ToRevision)
+}
+
+func TestRollbackNoHistory(t *testing.T) {
+	it := rollbackTestIntegration("digest-v1", "v1")
+	cmd, _, _ := initializeRollbackCmdOptions(t, it)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "no revision history found for integration my-it", err.Error())
+}
+
+func TestRollbackToPreviousRevision(t *testing.T) {
+	cmd, _, c := initializeRollbackCmdOptions(t)
+	createRollbackTestIntegration(t, c, "v1", "v2", "v3")
+
+	output, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.NoError(t, err)
+	assert.Equal(t, "Integration my-it rolled back to revision 2\n", output)
+
+	restored, err := getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "from('timer:tick').log('v2')", restored.Spec.Sources[0].Content)
+}
+
+func TestRollbackToRevision(t *testing.T) {
+	cmd, _, c := initializeRollbackCmdOptions(t)
+	createRollbackTestIntegration(t, c, "v1", "v2", "v3")
+
+	output, err := ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Integration my-it rolled back to revision 1\n", output)
+
+	restored, err := getIntegration(context.TODO(), c, "my-it", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "from('timer:tick').log('v1')", restored.Spec.Sources[0].Content)
+
+	_, err = ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "5")
+	require.Error(t, err)
+	assert.Equal(t, "revision 5 not found in the history of integration my-it", err.Error())
+
+	output, err = ExecuteCommand(cmd, cmdRollback, "my-it", "--to-revision", "3")
+	require.NoError(t, err)
+	assert.Equal(t, "Integration my-it is already at revision 3\n", output)
+}
+
+func TestRollbackNoPreviousRevision(t *testing.T) {
+	cmd, _, c := initializeRollbackCmdOptions(t)
+	createRollbackTestIntegration(t, c, "v1")
+
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "no revision found before revision 1 of integration my-it", err.Error())
+}
+
+func TestRollbackPipeIntegration(t *testing.T) {
+	it := rollbackTestIntegration("digest-v1", "v1")
+	it.Labels = map[string]string{
+		kubernetes.CamelCreatorLabelKind: v1.PipeKind,
+		kubernetes.CamelCreatorLabelName: "my-pipe",
+	}
+	cmd, _, _ := initializeRollbackCmdOptions(t, it)
+	_, err := ExecuteCommand(cmd, cmdRollback, "my-it")
+	require.Error(t, err)
+	assert.Equal(t, "integration my-it is managed by Pipe my-pipe: update the Pipe instead", err.Error())
+}
+
+// createRollbackTestIntegration creates an Integration with a revision history for each of the given versions,
+// the current revision being the latest.
+func createRollbackTestIntegration(t *testing.T, c client.Client, versions ...string) {
+	t.Helper()
+
+	var it *v1.Integration
+	for _, version := range versions {
+		it = rollbackTestIntegration("digest-"+version, version)
+		_, err := revision.Record(context.TODO(), c, it)
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.Create(context.TODO(), it))
+}
+
+func rollbackTestIntegration(digest string, version string) *v1.Integration {
+	it := v1.NewIntegration("default", "my-it")
+	it.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "routes.groovy",
+				Content: "from('timer:tick').log('" + version + "')",
+			},
+		},
+	}
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Digest = digest
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "default", Name: "my-kit"}
+
+	return &it
+}
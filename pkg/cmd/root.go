@@ -142,6 +142,8 @@ func addKamelSubcommands(cmd *cobra.Command, options *RootCmdOptions) {
 	cmd.AddCommand(newCmdKit(options))
 	cmd.AddCommand(cmdOnly(newCmdReset(options)))
 	cmd.AddCommand(cmdOnly(newCmdRebuild(options)))
+	cmd.AddCommand(cmdOnly(newCmdRollback(options)))
+	cmd.AddCommand(cmdOnly(newCmdBuild(options)))
 	cmd.AddCommand(cmdOnly(newCmdOperator(options)))
 	cmd.AddCommand(cmdOnly(newCmdBuilder(options)))
 	cmd.AddCommand(cmdOnly(newCmdDebug(options)))
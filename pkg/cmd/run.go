@@ -51,6 +51,7 @@ import (
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	k8slog "github.com/apache/camel-k/v2/pkg/util/kubernetes/log"
 	"github.com/apache/camel-k/v2/pkg/util/property"
@@ -86,12 +87,15 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 		"(syntax: [my-key=my-value|file:/path/to/my-conf.properties])")
 	cmd.Flags().StringArray("build-property", nil, "Add a build time property or properties file from a path "+
 		"(syntax: [my-key=my-value|file:/path/to/my-conf.properties])")
-	cmd.Flags().StringArray("config", nil, "Add a runtime configuration from a Configmap or a Secret "+
-		"(syntax: [configmap|secret]:name[/key], where name represents the configmap/secret name and key optionally "+
-		"represents the configmap/secret key to be filtered)")
-	cmd.Flags().StringArray("resource", nil, "Add a runtime resource from a Configmap or a Secret "+
-		"(syntax: [configmap|secret]:name[/key][@path], where name represents the configmap/secret name, "+
-		"key optionally represents the configmap/secret key to be filtered and path represents the destination path)")
+	cmd.Flags().StringArray("config", nil, "Add a runtime configuration from a Configmap, a Secret or an external secret "+
+		"(syntax: [configmap|secret]:[namespace:]name[/key], where name represents the configmap/secret name, namespace optionally "+
+		"represents the namespace it is copied from and key optionally represents the configmap/secret key to be filtered, "+
+		"or [vault|csi]:path[#key] for an external secret)")
+	cmd.Flags().StringArray("resource", nil, "Add a runtime resource from a Configmap, a Secret or an external secret "+
+		"(syntax: [configmap|secret]:[namespace:]name[/key][@path], where name represents the configmap/secret name, "+
+		"namespace optionally represents the namespace it is copied from, "+
+		"key optionally represents the configmap/secret key to be filtered and path represents the destination path, "+
+		"or [vault|csi]:path[#key][@path] for an external secret)")
 	cmd.Flags().StringArray("maven-repository", nil, "Add a maven repository")
 	cmd.Flags().Bool("logs", false, "Print integration logs")
 	cmd.Flags().Bool("sync", false, "[Deprecated] Synchronize the local source file with the cluster, republishing at each change")
@@ -812,6 +816,12 @@ func (o *runCmdOptions) parseAndConvertToTrait(cmd *cobra.Command,
 	traitParam string,
 ) error {
 	for _, param := range params {
+		if externalsecret.IsReference(param) {
+			// External secrets are resolved by the operator
+			o.Traits = append(o.Traits, convertToTrait(param, traitParam))
+
+			continue
+		}
 		config, err := parse(param)
 		if err != nil {
 			return err
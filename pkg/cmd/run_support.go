@@ -41,20 +41,25 @@ func addDependency(cmd *cobra.Command, it *v1.Integration, dependency string, ca
 }
 
 func parseConfig(ctx context.Context, cmd *cobra.Command, c client.Client, config *resource.Config, integration *v1.Integration) error {
+	namespace := integration.Namespace
+	if config.Namespace() != "" {
+		// The resource is copied into the Integration namespace by the operator
+		namespace = config.Namespace()
+	}
 	switch config.StorageType() {
 	case resource.StorageTypeConfigmap:
 		//nolint:staticcheck
-		cm := kubernetes.LookupConfigmap(ctx, c, integration.Namespace, config.Name())
+		cm := kubernetes.LookupConfigmap(ctx, c, namespace, config.Name())
 		if cm == nil {
-			fmt.Fprintln(cmd.ErrOrStderr(), "Warn:", config.Name(), "Configmap not found in", integration.Namespace, "namespace, make sure to provide it before the Integration can run")
+			fmt.Fprintln(cmd.ErrOrStderr(), "Warn:", config.Name(), "Configmap not found in", namespace, "namespace, make sure to provide it before the Integration can run")
 		} else if config.ContentType() != resource.ContentTypeData && cm.BinaryData != nil {
 			return errors.New("you cannot provide a binary config, use a text file instead")
 		}
 	case resource.StorageTypeSecret:
 		//nolint:staticcheck
-		secret := kubernetes.LookupSecret(ctx, c, integration.Namespace, config.Name())
+		secret := kubernetes.LookupSecret(ctx, c, namespace, config.Name())
 		if secret == nil {
-			fmt.Fprintln(cmd.ErrOrStderr(), "Warn:", config.Name(), "Secret not found in", integration.Namespace, "namespace, make sure to provide it before the Integration can run")
+			fmt.Fprintln(cmd.ErrOrStderr(), "Warn:", config.Name(), "Secret not found in", namespace, "namespace, make sure to provide it before the Integration can run")
 		}
 	default:
 		// Should never reach this
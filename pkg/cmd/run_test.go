@@ -629,6 +629,50 @@ status: {}
 `, fileName, fileName), output)
 }
 
+func TestRunExternalSecrets(t *testing.T) {
+	var tmpFile *os.File
+	var err error
+	tempDir := t.TempDir()
+	if tmpFile, err = os.CreateTemp(tempDir, "camel-k-"); err != nil {
+		t.Error(err)
+	}
+
+	assert.Nil(t, tmpFile.Close())
+	require.NoError(t, os.WriteFile(tmpFile.Name(), []byte(TestSrcContent), 0o400))
+	fileName := filepath.Base(tmpFile.Name())
+
+	_, runCmd, _ := initializeRunCmdOptionsWithOutput(t)
+	output, err := ExecuteCommand(runCmd, cmdRun, tmpFile.Name(), "-o", "yaml",
+		"--config", "vault:secret/db#password",
+		"--resource", "csi:my-provider-class@/etc/certs",
+		"-p", "my.password=vault:secret/db#password")
+
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`apiVersion: camel.apache.org/v1
+kind: Integration
+metadata:
+  annotations:
+    camel.apache.org/operator.id: camel-k
+  name: %s
+spec:
+  sources:
+  - content: "\nimport org.apache.camel.builder.RouteBuilder;\n\npublic class Sample
+      extends RouteBuilder {\n  @Override\n  public void configure() throws Exception
+      {\n\t  from(\"timer:tick\")\n        .log(\"Hello Camel K!\");\n  }\n}\n"
+    name: %s
+  traits:
+    camel:
+      properties:
+      - my.password = vault:secret/db#password
+    mount:
+      configs:
+      - vault:secret/db#password
+      resources:
+      - csi:my-provider-class@/etc/certs
+status: {}
+`, fileName, fileName), output)
+}
+
 func TestMissingTrait(t *testing.T) {
 	var tmpFile *os.File
 	var err error
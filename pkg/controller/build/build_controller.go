@@ -19,6 +19,7 @@ package build
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/apache/camel-k/v2/pkg/util/log"
@@ -152,16 +153,30 @@ func (r *reconcileBuild) Reconcile(ctx context.Context, request reconcile.Reques
 	var actions []Action
 
 	pl := platform.SingletonPlatform
+	quotaOwner := "the operator"
 	ip, err := platform.GetForResource(ctx, r.client, &instance)
 	if err == nil {
 		// NOTE: whatever is the error we don't really care. If a deprecated platform exists, then
 		// we use it. Otherwise we use the conf coming from env var
 		pl = platform.FromIntegrationPlatform(ip)
+		quotaOwner = fmt.Sprintf("IntegrationPlatform %s/%s", ip.Namespace, ip.Name)
 	}
 
 	buildMonitor := Monitor{
 		maxRunningBuilds:   pl.MaxRunningBuilds,
 		buildOrderStrategy: pl.BuildConfiguration.OrderStrategy,
+		quota:              pl.BuildQuota,
+		quotaOwner:         quotaOwner,
+	}
+
+	// The IntegrationProfile build quota, if any, takes precedence over the platform one
+	profile, err := platform.ApplyIntegrationProfile(ctx, r.client, &instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if profile != nil && profile.Spec.Build.Quota != nil {
+		buildMonitor.quota = profile.Spec.Build.Quota
+		buildMonitor.quotaOwner = fmt.Sprintf("IntegrationProfile %s/%s", profile.Namespace, profile.Name)
 	}
 
 	switch instance.BuilderConfiguration().Strategy {
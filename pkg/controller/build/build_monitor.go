@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -29,16 +30,24 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 )
 
 const enqueuedMsg = "%s - the build (%s) gets enqueued"
 
+// buildPriorityAgingPeriod is the waiting time after which the effective priority of a queued build
+// gets increased by one, so that low priority builds are eventually run.
+const buildPriorityAgingPeriod = time.Minute
+
 var runningBuilds sync.Map
 
 type Monitor struct {
 	maxRunningBuilds   int32
 	buildOrderStrategy v1.BuildOrderStrategy
+	// the quota limiting the running builds of each namespace, and the resource defining it
+	quota      *v1.BuildQuotaSpec
+	quotaOwner string
 }
 
 func (bm *Monitor) canSchedule(ctx context.Context, c ctrl.Reader, build *v1.Build) (bool, *v1.BuildCondition, error) {
@@ -68,6 +77,46 @@ func (bm *Monitor) canSchedule(ctx context.Context, c ctrl.Reader, build *v1.Bui
 		return false, scheduledWaitingBuildcondition(build.Name, reason), nil
 	}
 
+	if limit := bm.quota.MaxRunningBuildsIn(build.Namespace); limit > 0 {
+		var namespaceRunningBuilds int32
+		runningBuilds.Range(func(_, v any) bool {
+			if v == build.Namespace {
+				namespaceRunningBuilds++
+			}
+
+			return true
+		})
+
+		if namespaceRunningBuilds >= limit {
+			reason := fmt.Sprintf(
+				"Maximum number of running builds (%d) in namespace %s exceeded, as set by the build quota of %s",
+				limit, build.Namespace, bm.quotaOwner,
+			)
+			Log.WithValues("request-namespace", requestNamespace, "request-name", requestName, "namespace-running-builds-limit", limit).
+				ForBuild(build).Infof(enqueuedMsg, reason, build.Name)
+			// namespace quota exceeded
+			return false, scheduledBuildcondition(corev1.ConditionFalse, v1.BuildConditionQuotaExceededReason, fmt.Sprintf(
+				enqueuedMsg,
+				reason,
+				build.Name,
+			)), nil
+		}
+	}
+
+	if bm.buildOrderStrategy == v1.BuildOrderStrategyPriority {
+		allowed, reason, err := bm.canSchedulePriority(ctx, c, build)
+		if err != nil {
+			return false, nil, err
+		}
+		if !allowed {
+			Log.WithValues("request-namespace", requestNamespace, "request-name", requestName, "order-strategy", bm.buildOrderStrategy).
+				ForBuild(build).Infof(enqueuedMsg, reason, build.Name)
+			return false, scheduledWaitingBuildcondition(build.Name, reason), nil
+		}
+
+		return true, scheduledReadyBuildcondition(build.Name), nil
+	}
+
 	layout := build.Labels[v1.IntegrationKitLayoutLabel]
 
 	// Native builds can be run in parallel, as incremental images is not applicable.
@@ -131,8 +180,100 @@ func (bm *Monitor) canSchedule(ctx context.Context, c ctrl.Reader, build *v1.Bui
 	return allowed, condition, nil
 }
 
+// canSchedulePriority grants precedence to the queued builds with the highest effective priority across all the
+// namespaces handled by the operator. Each namespace is entitled to a fair share of the maximum number of running
+// builds as long as builds from other namespaces are waiting, so that a single namespace cannot monopolize the builders.
+func (bm *Monitor) canSchedulePriority(ctx context.Context, c ctrl.Reader, build *v1.Build) (bool, string, error) {
+	builds := &v1.BuildList{}
+	// We use the non-caching client as informers cache is not invalidated nor updated
+	// atomically by write operations
+	if err := c.List(ctx, builds, ctrl.InNamespace(platform.GetOperatorWatchNamespace())); err != nil {
+		return false, "", err
+	}
+
+	active := make(map[string]int32)
+	namespaces := map[string]bool{build.Namespace: true}
+	waiting := make([]*v1.Build, 0)
+	for i := range builds.Items {
+		b := &builds.Items[i]
+		if !platform.IsOperatorHandler(b) || (b.Namespace == build.Namespace && b.Name == build.Name) {
+			continue
+		}
+
+		switch b.Status.Phase {
+		case v1.BuildPhasePending, v1.BuildPhaseRunning:
+			active[b.Namespace]++
+			namespaces[b.Namespace] = true
+		case v1.BuildPhaseNone, v1.BuildPhaseInitialization, v1.BuildPhaseScheduling:
+			waiting = append(waiting, b)
+			namespaces[b.Namespace] = true
+		}
+	}
+
+	fairShare := bm.maxRunningBuilds / int32(len(namespaces))
+	if fairShare < 1 {
+		fairShare = 1
+	}
+	// atQuota tells whether the builds of the namespace cannot be scheduled anyway, as it already runs as many builds
+	// as allowed by its quota
+	atQuota := func(namespace string) bool {
+		limit := bm.quota.MaxRunningBuildsIn(namespace)
+
+		return limit > 0 && active[namespace] >= limit
+	}
+
+	if active[build.Namespace] >= fairShare {
+		for _, b := range waiting {
+			if b.Namespace != build.Namespace && !atQuota(b.Namespace) {
+				return false, fmt.Sprintf(
+					"Namespace (%s) already runs its fair share (%d) of builds while build (%s/%s) is waiting",
+					build.Namespace, fairShare, b.Namespace, b.Name,
+				), nil
+			}
+		}
+	}
+
+	now := time.Now()
+	priority := effectiveBuildPriority(build, now)
+	for _, b := range waiting {
+		// builds from namespaces exceeding their fair share or their quota cannot be scheduled anyway
+		if b.Namespace != build.Namespace && (active[b.Namespace] >= fairShare || atQuota(b.Namespace)) {
+			continue
+		}
+
+		otherPriority := effectiveBuildPriority(b, now)
+		if otherPriority > priority {
+			return false, fmt.Sprintf("Waiting for build (%s) because it has a higher priority (%d)", b.Name, otherPriority), nil
+		}
+		if otherPriority == priority && isBuildCreatedBefore(b, build) {
+			return false, fmt.Sprintf("Waiting for build (%s) because it has the same priority and has been created before", b.Name), nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// effectiveBuildPriority returns the build priority increased by one for each aging period the build has been waiting.
+func effectiveBuildPriority(build *v1.Build, now time.Time) int64 {
+	priority := int64(build.Spec.Priority)
+	if !build.CreationTimestamp.IsZero() && now.After(build.CreationTimestamp.Time) {
+		priority += int64(now.Sub(build.CreationTimestamp.Time) / buildPriorityAgingPeriod)
+	}
+
+	return priority
+}
+
+func isBuildCreatedBefore(build *v1.Build, other *v1.Build) bool {
+	if build.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return types.NamespacedName{Namespace: build.Namespace, Name: build.Name}.String() <
+			types.NamespacedName{Namespace: other.Namespace, Name: other.Name}.String()
+	}
+
+	return build.CreationTimestamp.Before(&other.CreationTimestamp)
+}
+
 func monitorRunningBuild(build *v1.Build) {
-	runningBuilds.Store(types.NamespacedName{Namespace: build.Namespace, Name: build.Name}.String(), true)
+	runningBuilds.Store(types.NamespacedName{Namespace: build.Namespace, Name: build.Name}.String(), build.Namespace)
 }
 
 func monitorFinishedBuild(build *v1.Build) {
@@ -633,6 +633,262 @@ func TestMonitorDependencyMatchingBuilds(t *testing.T) {
 	}
 }
 
+func TestMonitorPriorityBuilds(t *testing.T) {
+	testcases := []struct {
+		name      string
+		running   []*v1.Build
+		builds    []*v1.Build
+		build     *v1.Build
+		allowed   bool
+		condition *v1.BuildCondition
+	}{
+		{
+			name:      "allowNewBuild",
+			running:   []*v1.Build{},
+			builds:    []*v1.Build{},
+			build:     newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, 0),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name: "limitMaxRunningBuilds",
+			running: []*v1.Build{
+				newBuild("some-ns", "my-build-1"),
+				newBuild("other-ns", "my-build-2"),
+				newBuild("another-ns", "my-build-3"),
+			},
+			build:   newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 10, 0),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionWaitingReason,
+				"Maximum number of running builds (3) exceeded - the build (my-build) gets enqueued"),
+		},
+		{
+			name: "queueBuildWhenHigherPriorityBuildIsWaiting",
+			builds: []*v1.Build{
+				newPriorityBuild("other-ns", "my-build-prod", v1.BuildPhaseScheduling, 10, 0),
+			},
+			build:   newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, time.Second),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionWaitingReason,
+				"Waiting for build (my-build-prod) because it has a higher priority (10) - the build (my-build) gets enqueued"),
+		},
+		{
+			name: "allowHigherPriorityBuild",
+			builds: []*v1.Build{
+				newPriorityBuild("other-ns", "my-build-dev", v1.BuildPhaseScheduling, 0, time.Second),
+			},
+			build:     newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 10, 0),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name: "queueBuildWhenOlderBuildWithSamePriorityIsWaiting",
+			builds: []*v1.Build{
+				newPriorityBuild("ns", "my-build-old", v1.BuildPhaseInitialization, 5, time.Second),
+			},
+			build:   newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 5, 0),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionWaitingReason,
+				"Waiting for build (my-build-old) because it has the same priority and has been created before - the build (my-build) gets enqueued"),
+		},
+		{
+			name: "allowLowPriorityBuildAfterAging",
+			builds: []*v1.Build{
+				newPriorityBuild("other-ns", "my-build-prod", v1.BuildPhaseScheduling, 10, 0),
+			},
+			build:     newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, 20*buildPriorityAgingPeriod),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name: "limitNamespaceToFairShare",
+			running: []*v1.Build{
+				newPriorityBuild("noisy-ns", "my-build-1", v1.BuildPhaseRunning, 10, 0),
+				newPriorityBuild("noisy-ns", "my-build-2", v1.BuildPhaseRunning, 10, 0),
+			},
+			builds: []*v1.Build{
+				newPriorityBuild("ns", "my-build-dev", v1.BuildPhaseScheduling, 0, 0),
+			},
+			build:   newPriorityBuild("noisy-ns", "my-build", v1.BuildPhaseScheduling, 10, 0),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionWaitingReason,
+				"Namespace (noisy-ns) already runs its fair share (1) of builds while build (ns/my-build-dev) is waiting - the build (my-build) gets enqueued"),
+		},
+		{
+			name: "allowBuildWhenHigherPriorityNamespaceExceedsFairShare",
+			running: []*v1.Build{
+				newPriorityBuild("noisy-ns", "my-build-1", v1.BuildPhaseRunning, 10, 0),
+				newPriorityBuild("noisy-ns", "my-build-2", v1.BuildPhaseRunning, 10, 0),
+			},
+			builds: []*v1.Build{
+				newPriorityBuild("noisy-ns", "my-build-3", v1.BuildPhaseScheduling, 10, time.Second),
+			},
+			build:     newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, 0),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name: "ignoreFinishedBuilds",
+			builds: []*v1.Build{
+				newPriorityBuild("ns", "my-build-x", v1.BuildPhaseSucceeded, 10, time.Second),
+				newPriorityBuild("ns", "my-build-failed", v1.BuildPhaseFailed, 10, time.Second),
+			},
+			build:     newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, 0),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var initObjs []runtime.Object
+			for _, build := range append(tc.running, tc.builds...) {
+				initObjs = append(initObjs, build)
+			}
+			initObjs = append(initObjs, tc.build)
+
+			c, err := internal.NewFakeClient(initObjs...)
+
+			require.NoError(t, err)
+
+			bm := Monitor{
+				maxRunningBuilds:   3,
+				buildOrderStrategy: v1.BuildOrderStrategyPriority,
+			}
+
+			// reset running builds in memory cache
+			cleanRunningBuildsMonitor()
+			for _, build := range tc.running {
+				monitorRunningBuild(build)
+			}
+
+			allowed, condition, err := bm.canSchedule(context.TODO(), c, tc.build)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+			assert.Equal(t, tc.condition.Type, condition.Type)
+			assert.Equal(t, tc.condition.Status, condition.Status)
+			assert.Equal(t, tc.condition.Reason, condition.Reason)
+			assert.Equal(t, tc.condition.Message, condition.Message)
+		})
+	}
+}
+
+func TestMonitorPriorityBuildsWithQuota(t *testing.T) {
+	running := newPriorityBuild("prod-ns", "my-build-1", v1.BuildPhaseRunning, 10, 0)
+	waiting := newPriorityBuild("prod-ns", "my-build-2", v1.BuildPhaseScheduling, 10, time.Second)
+	build := newPriorityBuild("ns", "my-build", v1.BuildPhaseScheduling, 0, 0)
+	c, err := internal.NewFakeClient(running, waiting, build)
+	require.NoError(t, err)
+
+	bm := Monitor{
+		maxRunningBuilds:   4,
+		buildOrderStrategy: v1.BuildOrderStrategyPriority,
+		quota:              &v1.BuildQuotaSpec{MaxRunningBuildsPerNamespace: 1},
+		quotaOwner:         "IntegrationPlatform ns/camel-k",
+	}
+	cleanRunningBuildsMonitor()
+	monitorRunningBuild(running)
+
+	// the higher priority build waits for its namespace quota, so it does not block the other namespaces
+	allowed, condition, err := bm.canSchedule(context.TODO(), c, build)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+
+	bm.quota = nil
+	allowed, condition, err = bm.canSchedule(context.TODO(), c, build)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, "Waiting for build (my-build-2) because it has a higher priority (10) - the build (my-build) gets enqueued", condition.Message)
+}
+
+func TestMonitorBuildQuota(t *testing.T) {
+	quota := &v1.BuildQuotaSpec{
+		MaxRunningBuildsPerNamespace: 1,
+		Namespaces: map[string]int32{
+			"prod-ns": 2,
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		running   []*v1.Build
+		build     *v1.Build
+		allowed   bool
+		condition *v1.BuildCondition
+	}{
+		{
+			name:      "allowBuildWithinQuota",
+			running:   []*v1.Build{newBuild("other-ns", "my-build-1")},
+			build:     newBuild("ns", "my-build"),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name:    "limitNamespaceRunningBuilds",
+			running: []*v1.Build{newBuild("ns", "my-build-1")},
+			build:   newBuild("ns", "my-build"),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionQuotaExceededReason,
+				"Maximum number of running builds (1) in namespace ns exceeded, as set by the build quota of IntegrationPlatform ns/camel-k - the build (my-build) gets enqueued"),
+		},
+		{
+			name:      "allowBuildWithinNamespaceQuota",
+			running:   []*v1.Build{newBuild("prod-ns", "my-build-1")},
+			build:     newBuild("prod-ns", "my-build"),
+			allowed:   true,
+			condition: newCondition(corev1.ConditionTrue, v1.BuildConditionReadyReason, "the build (my-build) is scheduled"),
+		},
+		{
+			name: "limitNamespaceRunningBuildsWithNamespaceQuota",
+			running: []*v1.Build{
+				newBuild("prod-ns", "my-build-1"),
+				newNativeBuild("prod-ns", "my-build-2"),
+			},
+			build:   newNativeBuild("prod-ns", "my-build"),
+			allowed: false,
+			condition: newCondition(corev1.ConditionFalse, v1.BuildConditionQuotaExceededReason,
+				"Maximum number of running builds (2) in namespace prod-ns exceeded, as set by the build quota of IntegrationPlatform ns/camel-k - the build (my-build) gets enqueued"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var initObjs []runtime.Object
+			for _, build := range tc.running {
+				initObjs = append(initObjs, build)
+			}
+
+			c, err := internal.NewFakeClient(initObjs...)
+
+			require.NoError(t, err)
+
+			bm := Monitor{
+				maxRunningBuilds:   3,
+				buildOrderStrategy: v1.BuildOrderStrategyFIFO,
+				quota:              quota,
+				quotaOwner:         "IntegrationPlatform ns/camel-k",
+			}
+
+			// reset running builds in memory cache
+			cleanRunningBuildsMonitor()
+			for _, build := range tc.running {
+				monitorRunningBuild(build)
+			}
+
+			allowed, condition, err := bm.canSchedule(context.TODO(), c, tc.build)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowed)
+			assert.Equal(t, tc.condition.Type, condition.Type)
+			assert.Equal(t, tc.condition.Status, condition.Status)
+			assert.Equal(t, tc.condition.Reason, condition.Reason)
+			assert.Equal(t, tc.condition.Message, condition.Message)
+		})
+	}
+}
+
 func cleanRunningBuildsMonitor() {
 	runningBuilds.Range(func(key interface{}, v interface{}) bool {
 		runningBuilds.Delete(key)
@@ -665,6 +921,14 @@ func newNativeBuildInPhase(namespace string, name string, phase v1.BuildPhase, d
 	return newBuildWithLayoutInPhase(namespace, name, v1.IntegrationKitLayoutNativeSources, phase, dependencies...)
 }
 
+func newPriorityBuild(namespace string, name string, phase v1.BuildPhase, priority int32, age time.Duration) *v1.Build {
+	build := newBuildInPhase(namespace, name, phase)
+	build.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+	build.Spec.Priority = priority
+
+	return build
+}
+
 func newBuildWithLayoutInPhase(namespace string, name string, layout string, phase v1.BuildPhase, dependencies ...string) *v1.Build {
 	return &v1.Build{
 		TypeMeta: metav1.TypeMeta{
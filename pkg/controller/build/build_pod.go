@@ -19,15 +19,21 @@ package build
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/client"
 	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -37,6 +43,13 @@ import (
 const (
 	builderDir    = "/builder"
 	builderVolume = "camel-k-builder"
+
+	buildpacksRegistryVolume = "camel-k-buildpacks-registry"
+	buildpacksDockerConfig   = "/tmp/buildpacks/.docker"
+
+	mavenCacheVolume = "camel-k-maven-repository"
+
+	vulnerabilityDatabaseVolume = "camel-k-vulnerability-database"
 )
 
 func newBuildPod(ctx context.Context, client client.Client, build *v1.Build) *corev1.Pod {
@@ -97,6 +110,8 @@ func newBuildPod(ctx context.Context, client client.Client, build *v1.Build) *co
 			addBuildTaskToPod(ctx, client, build, task.S2i.Name, pod)
 		case task.Jib != nil:
 			addBuildTaskToPod(ctx, client, build, task.Jib.Name, pod)
+		case task.Buildpacks != nil:
+			addBuildpacksTaskToPod(build, task.Buildpacks, pod)
 		}
 	}
 
@@ -220,6 +235,46 @@ func addBuildTaskToPod(ctx context.Context, client client.Client, build *v1.Buil
 		Env:        envVars,
 	}
 
+	if cache := mavenCache(build); cache != nil {
+		if !hasVolume(pod, mavenCacheVolume) {
+			pod.Spec.Volumes = append(pod.Spec.Volumes,
+				// Maven local repository shared across builder Pods
+				corev1.Volume{
+					Name: mavenCacheVolume,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: cache.PersistentVolumeClaim,
+						},
+					},
+				},
+			)
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      mavenCacheVolume,
+			MountPath: builder.MavenCacheDir,
+		})
+	}
+
+	if scan := vulnerabilityScan(build, taskName); scan != nil && scan.PersistentVolumeClaim != "" {
+		pod.Spec.Volumes = append(pod.Spec.Volumes,
+			// Offline vulnerability database the dependencies are checked against
+			corev1.Volume{
+				Name: vulnerabilityDatabaseVolume,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: scan.PersistentVolumeClaim,
+						ReadOnly:  true,
+					},
+				},
+			},
+		)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      vulnerabilityDatabaseVolume,
+			MountPath: builder.VulnerabilityDatabaseDir,
+			ReadOnly:  true,
+		})
+	}
+
 	// get security context from security context constraint configuration in namespace
 	if taskName == "s2i" {
 		securityContextConstrained, _ := openshift.GetOpenshiftSecurityContextRestricted(ctx, client, build.BuilderPodNamespace())
@@ -232,6 +287,120 @@ func addBuildTaskToPod(ctx context.Context, client client.Client, build *v1.Buil
 	addContainerToPod(build, container, pod)
 }
 
+// mavenCache returns the shared Maven local repository configuration of the Build, if any.
+func mavenCache(build *v1.Build) *v1.MavenCacheSpec {
+	if task, ok := v1.FindBuilderTask(build.Spec.Tasks); ok {
+		return task.Maven.Cache
+	}
+
+	return nil
+}
+
+// vulnerabilityScan returns the vulnerability scan configuration of the given task, if any.
+func vulnerabilityScan(build *v1.Build, taskName string) *v1.VulnerabilityScanSpec {
+	for _, task := range build.Spec.Tasks {
+		for _, t := range []*v1.BuilderTask{task.Builder, task.Package} {
+			if t != nil && t.Name == taskName {
+				return t.VulnerabilityScan
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureMavenCache creates the PersistentVolumeClaim holding the shared Maven local repository, if it does not exist yet.
+func ensureMavenCache(ctx context.Context, c client.Client, build *v1.Build) error {
+	cache := mavenCache(build)
+	if cache == nil {
+		return nil
+	}
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, build.BuilderPodNamespace(), cache.PersistentVolumeClaim)
+	if err != nil || pvc != nil {
+		return err
+	}
+
+	size, err := resource.ParseQuantity(cache.Size)
+	if err != nil {
+		return fmt.Errorf("could not parse Maven cache size %s: %w", cache.Size, err)
+	}
+	var sc *storagev1.StorageClass
+	if cache.StorageClassName != "" {
+		sc, err = kubernetes.LookupStorageClass(ctx, c, build.BuilderPodNamespace(), cache.StorageClassName)
+		if err != nil {
+			return fmt.Errorf("error looking up for StorageClass %s, %w", cache.StorageClassName, err)
+		}
+		if sc == nil {
+			return fmt.Errorf("could not find any %s StorageClass", cache.StorageClassName)
+		}
+	} else {
+		sc, err = kubernetes.LookupDefaultStorageClass(ctx, c)
+		if err != nil {
+			return fmt.Errorf("error looking up for default StorageClass, %w", err)
+		}
+		if sc == nil {
+			return errors.New("could not find any default StorageClass")
+		}
+	}
+
+	pvc = kubernetes.NewPersistentVolumeClaim(build.BuilderPodNamespace(), cache.PersistentVolumeClaim, sc.Name, size, cache.AccessMode)
+	pvc.Labels = map[string]string{
+		"camel.apache.org/component": "maven-repository",
+	}
+	if err := c.Create(ctx, pvc); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// checkMavenCache reports whether the PersistentVolumeClaim holding the shared Maven local repository is bound,
+// so that a builder Pod waiting for a volume the StorageClass cannot provision does not go unnoticed.
+func checkMavenCache(ctx context.Context, c client.Client, build *v1.Build) error {
+	cache := mavenCache(build)
+	if cache == nil {
+		return nil
+	}
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, build.BuilderPodNamespace(), cache.PersistentVolumeClaim)
+	if err != nil {
+		return err
+	}
+	if pvc == nil {
+		build.Status.SetCondition(v1.BuildConditionMavenCacheAvailable, corev1.ConditionFalse, v1.BuildConditionMavenCacheNotAvailableReason,
+			fmt.Sprintf("PersistentVolumeClaim %s not found", cache.PersistentVolumeClaim))
+
+		return nil
+	}
+	if pvc.Status.Phase == corev1.ClaimBound {
+		build.Status.SetCondition(v1.BuildConditionMavenCacheAvailable, corev1.ConditionTrue, v1.BuildConditionMavenCacheAvailableReason,
+			fmt.Sprintf("PersistentVolumeClaim %s bound", pvc.Name))
+
+		return nil
+	}
+
+	message := fmt.Sprintf("PersistentVolumeClaim %s is not bound: make sure its StorageClass supports the %v access modes, "+
+		"or provide a PersistentVolumeClaim of your own", pvc.Name, pvc.Spec.AccessModes)
+	events, err := c.CoreV1().Events(pvc.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.name=" + pvc.Name,
+	})
+	if err != nil {
+		return err
+	}
+	var failure *corev1.Event
+	for i, event := range events.Items {
+		if event.InvolvedObject.Kind == "PersistentVolumeClaim" && event.InvolvedObject.Name == pvc.Name && event.Reason == "ProvisioningFailed" &&
+			(failure == nil || event.LastTimestamp.After(failure.LastTimestamp.Time)) {
+			failure = &events.Items[i]
+		}
+	}
+	if failure != nil {
+		message = fmt.Sprintf("%s: %s", message, failure.Message)
+	}
+	build.Status.SetCondition(v1.BuildConditionMavenCacheAvailable, corev1.ConditionFalse, v1.BuildConditionMavenCacheNotAvailableReason, message)
+
+	return nil
+}
+
 func addCustomTaskToPod(build *v1.Build, task *v1.UserTask, pod *corev1.Pod) {
 	container := corev1.Container{
 		Name:            task.Name,
@@ -253,6 +422,50 @@ func addCustomTaskToPod(build *v1.Build, task *v1.UserTask, pod *corev1.Pod) {
 	addContainerToPod(build, container, pod)
 }
 
+func addBuildpacksTaskToPod(build *v1.Build, task *v1.BuildpacksTask, pod *corev1.Pod) {
+	var ugfid int64 = 1001
+	workspaceDir := filepath.Join(builderDir, build.Name)
+	container := corev1.Container{
+		Name:            task.Name,
+		Image:           task.BuilderImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         builder.BuildpacksCreatorCommand(task, workspaceDir),
+		WorkingDir:      workspaceDir,
+		Env:             proxyFromEnvironment(),
+	}
+	// The lifecycle must run with the same user owning the shared workspace
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "CNB_USER_ID", Value: strconv.FormatInt(ugfid, 10)},
+		corev1.EnvVar{Name: "CNB_GROUP_ID", Value: strconv.FormatInt(ugfid, 10)},
+	)
+
+	if task.Registry.Secret != "" {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: buildpacksRegistryVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: task.Registry.Secret,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  corev1.DockerConfigJsonKey,
+							Path: "config.json",
+						},
+					},
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      buildpacksRegistryVolume,
+			MountPath: buildpacksDockerConfig,
+			ReadOnly:  true,
+		})
+		container.Env = append(container.Env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: buildpacksDockerConfig})
+	}
+
+	configureResources(task.Name, build, &container)
+	addContainerToPod(build, container, pod)
+}
+
 func addContainerToPod(build *v1.Build, container corev1.Container, pod *corev1.Pod) {
 	if hasVolume(pod, builderVolume) {
 		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
@@ -22,9 +22,14 @@ import (
 	"testing"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -66,3 +71,238 @@ func TestNewBuildPodConfiguration(t *testing.T) {
 	assert.Equal(t, map[string]string{"node": "selector"}, pod.Spec.NodeSelector)
 	assert.Equal(t, map[string]string{"annotation": "value"}, pod.Annotations)
 }
+
+func TestNewBuildPodBuildpacks(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "theBuildName",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+							Configuration: v1.BuildConfiguration{
+								BuilderPodNamespace: "theNamespace",
+							},
+						},
+					},
+				},
+				{
+					Buildpacks: &v1.BuildpacksTask{
+						BaseTask: v1.BaseTask{
+							Name: "buildpacks",
+						},
+						PublishTask: v1.PublishTask{
+							Image: "registry/my-image",
+							Registry: v1.RegistrySpec{
+								Address: "registry",
+								Secret:  "my-registry-secret",
+							},
+						},
+						BuilderImage: "my-builder-image",
+					},
+				},
+			},
+		},
+	}
+
+	pod := newBuildPod(ctx, c, &build)
+
+	assert.Len(t, pod.Spec.InitContainers, 1)
+	assert.Equal(t, "builder", pod.Spec.InitContainers[0].Name)
+	assert.Len(t, pod.Spec.Containers, 1)
+	buildpacks := pod.Spec.Containers[0]
+	assert.Equal(t, "buildpacks", buildpacks.Name)
+	assert.Equal(t, "my-builder-image", buildpacks.Image)
+	assert.Equal(t, "/builder/theBuildName", buildpacks.WorkingDir)
+	assert.Contains(t, buildpacks.Command[2], "/cnb/lifecycle/creator -app=/builder/theBuildName/maven")
+	assert.Contains(t, buildpacks.Env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: buildpacksDockerConfig})
+	assert.Contains(t, buildpacks.Env, corev1.EnvVar{Name: "CNB_USER_ID", Value: "1001"})
+	assert.Len(t, buildpacks.VolumeMounts, 2)
+	assert.Len(t, pod.Spec.Volumes, 2)
+	assert.Equal(t, "my-registry-secret", pod.Spec.Volumes[1].Secret.SecretName)
+	assert.Equal(t, "registry/my-image", publishTaskImage(build.Spec.Tasks))
+	assert.False(t, operatorSupportedPublishingStrategy(build.Spec.Tasks))
+}
+
+func mavenCacheBuild() v1.Build {
+	return v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "theBuildName",
+		},
+		Spec: v1.BuildSpec{
+			Tasks: []v1.Task{
+				{
+					Builder: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "builder",
+							Configuration: v1.BuildConfiguration{
+								BuilderPodNamespace: "theNamespace",
+							},
+						},
+						Maven: v1.MavenBuildSpec{
+							MavenSpec: v1.MavenSpec{
+								Cache: &v1.MavenCacheSpec{
+									Enabled:               true,
+									PersistentVolumeClaim: "my-maven-repository",
+									AccessMode:            corev1.ReadWriteMany,
+									Size:                  "1Gi",
+								},
+							},
+						},
+					},
+				},
+				{
+					Package: &v1.BuilderTask{
+						BaseTask: v1.BaseTask{
+							Name: "package",
+						},
+					},
+				},
+				{
+					Jib: &v1.JibTask{
+						BaseTask: v1.BaseTask{
+							Name: "jib",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewBuildPodMavenCache(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := mavenCacheBuild()
+	pod := newBuildPod(ctx, c, &build)
+
+	assert.Len(t, pod.Spec.Volumes, 2)
+	assert.Equal(t, "my-maven-repository", pod.Spec.Volumes[1].PersistentVolumeClaim.ClaimName)
+	containers := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	assert.Len(t, containers, 3)
+	for _, container := range containers {
+		assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+			Name:      mavenCacheVolume,
+			MountPath: builder.MavenCacheDir,
+		})
+	}
+}
+
+func TestNewBuildPodVulnerabilityDatabase(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := mavenCacheBuild()
+	build.Spec.Tasks[1].Package.VulnerabilityScan = &v1.VulnerabilityScanSpec{
+		PersistentVolumeClaim: "osv-database",
+	}
+	pod := newBuildPod(ctx, c, &build)
+
+	assert.Len(t, pod.Spec.Volumes, 3)
+	assert.Equal(t, "osv-database", pod.Spec.Volumes[2].PersistentVolumeClaim.ClaimName)
+	assert.True(t, pod.Spec.Volumes[2].PersistentVolumeClaim.ReadOnly)
+	mount := corev1.VolumeMount{
+		Name:      vulnerabilityDatabaseVolume,
+		MountPath: builder.VulnerabilityDatabaseDir,
+		ReadOnly:  true,
+	}
+	mounted := 0
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if container.Name == "package" {
+			assert.Contains(t, container.VolumeMounts, mount)
+			mounted++
+		} else {
+			assert.NotContains(t, container.VolumeMounts, mount)
+		}
+	}
+	assert.Equal(t, 1, mounted)
+}
+
+func TestEnsureMavenCache(t *testing.T) {
+	ctx := context.TODO()
+	sc := storagev1.StorageClass{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StorageClass",
+			APIVersion: storagev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default-sc",
+			Annotations: map[string]string{
+				"storageclass.kubernetes.io/is-default-class": "true",
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(&sc)
+	require.NoError(t, err)
+
+	build := mavenCacheBuild()
+	require.NoError(t, ensureMavenCache(ctx, c, &build))
+	pvc, err := kubernetes.LookupPersistentVolumeClaim(ctx, c, "theNamespace", "my-maven-repository")
+	require.NoError(t, err)
+	assert.NotNil(t, pvc)
+	assert.Equal(t, "default-sc", *pvc.Spec.StorageClassName)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	assert.Equal(t, "1Gi", pvc.Spec.Resources.Requests.Storage().String())
+
+	// the existing claim is reused
+	require.NoError(t, ensureMavenCache(ctx, c, &build))
+}
+
+func TestEnsureMavenCacheMissingStorageClass(t *testing.T) {
+	ctx := context.TODO()
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	build := mavenCacheBuild()
+	build.Spec.Tasks[0].Builder.Maven.Cache.StorageClassName = "fast"
+	require.Error(t, ensureMavenCache(ctx, c, &build))
+
+	build.Spec.Tasks[0].Builder.Maven.Cache = nil
+	require.NoError(t, ensureMavenCache(ctx, c, &build))
+}
+
+func TestCheckMavenCache(t *testing.T) {
+	ctx := context.TODO()
+	pvc := kubernetes.NewPersistentVolumeClaim("theNamespace", "my-maven-repository", "nfs", resource.MustParse("1Gi"), corev1.ReadWriteMany)
+	event := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "theNamespace", Name: "my-maven-repository.1"},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "PersistentVolumeClaim",
+			Namespace: "theNamespace",
+			Name:      "my-maven-repository",
+		},
+		Reason:  "ProvisioningFailed",
+		Message: "access mode ReadWriteMany is not supported",
+	}
+	c, err := internal.NewFakeClient(pvc, &event)
+	require.NoError(t, err)
+
+	build := mavenCacheBuild()
+	require.NoError(t, checkMavenCache(ctx, c, &build))
+	condition := build.Status.GetCondition(v1.BuildConditionMavenCacheAvailable)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.BuildConditionMavenCacheNotAvailableReason, condition.Reason)
+	assert.Equal(t, "PersistentVolumeClaim my-maven-repository is not bound: make sure its StorageClass supports the [ReadWriteMany] access modes, "+
+		"or provide a PersistentVolumeClaim of your own: access mode ReadWriteMany is not supported", condition.Message)
+
+	pvc.Status.Phase = corev1.ClaimBound
+	c, err = internal.NewFakeClient(pvc)
+	require.NoError(t, err)
+	require.NoError(t, checkMavenCache(ctx, c, &build))
+	condition = build.Status.GetCondition(v1.BuildConditionMavenCacheAvailable)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+}
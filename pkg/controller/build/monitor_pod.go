@@ -73,6 +73,9 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 	if pod == nil {
 		switch build.Status.Phase {
 		case v1.BuildPhasePending:
+			if err = ensureMavenCache(ctx, action.client, build); err != nil {
+				return nil, fmt.Errorf("cannot provision Maven cache: %w", err)
+			}
 			pod = newBuildPod(ctx, action.client, build)
 			// If the Builder Pod is in the Build namespace, we can set the ownership to it. If not (global operator mode)
 			// we set the ownership to the Operator Pod instead
@@ -107,6 +110,8 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 		// Pod remains in pending phase when init containers execute
 		if action.isPodScheduled(pod) {
 			build.Status.Phase = v1.BuildPhaseRunning
+		} else if err = checkMavenCache(ctx, action.client, build); err != nil {
+			return nil, err
 		}
 		if time.Since(build.Status.StartedAt.Time) > build.Spec.Timeout.Duration {
 			// Patch the Pod with an annotation, to identify termination signal
@@ -372,6 +377,8 @@ func publishTaskName(tasks []v1.Task) string {
 		return t.Custom.Name
 	case t.Jib != nil:
 		return t.Jib.Name
+	case t.Buildpacks != nil:
+		return t.Buildpacks.Name
 	//nolint:staticcheck
 	case t.S2i != nil:
 		return t.S2i.Name
@@ -397,6 +404,9 @@ func publishTaskImage(tasks []v1.Task) string {
 	if t != nil && t.Custom != nil {
 		return t.Custom.PublishingImage
 	}
+	if t != nil && t.Buildpacks != nil {
+		return t.Buildpacks.Image
+	}
 
 	return ""
 }
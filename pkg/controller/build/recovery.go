@@ -23,9 +23,11 @@ import (
 
 	"github.com/jpillora/backoff"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/maven"
 )
 
 const (
@@ -33,6 +35,8 @@ const (
 	defaultRecoveryBackoffMaxDuration = 1 * time.Second
 	defaultRecoveryBackoffFactor      = 2
 	defaultRecoveryMaxAttempt         = 5
+	// the maximum backoff used when the Build defines its own retry policy
+	defaultRetryPolicyBackoffMaxDuration = 5 * time.Minute
 )
 
 func newErrorRecoveryAction() Action {
@@ -62,31 +66,55 @@ func (action *errorRecoveryAction) CanHandle(build *v1.Build) bool {
 }
 
 func (action *errorRecoveryAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	policy := build.Spec.RetryPolicy
 	if build.Status.Failure == nil {
+		attemptMax := defaultRecoveryMaxAttempt
+		if policy != nil && policy.MaxAttempts != nil {
+			attemptMax = int(*policy.MaxAttempts)
+		}
 		build.Status.Failure = &v1.Failure{
 			Reason: build.Status.Error,
 			Time:   metav1.Now(),
 			Recovery: v1.FailureRecovery{
-				AttemptMax: defaultRecoveryMaxAttempt,
+				AttemptMax: attemptMax,
 			},
 		}
 
 		return build, nil
 	}
 
+	if c := build.Status.GetCondition(v1.BuildConditionVulnerabilityScan); c != nil && c.Status == corev1.ConditionFalse {
+		action.L.Info("Build failure is not recoverable, as vulnerabilities have been found in the dependencies")
+		build.Status.Phase = v1.BuildPhaseError
+
+		return build, nil
+	}
+
 	if build.Status.Failure.Recovery.Attempt >= build.Status.Failure.Recovery.AttemptMax {
 		build.Status.Phase = v1.BuildPhaseError
 
 		return build, nil
 	}
 
+	// the failure is classified according to the error of the latest attempt
+	reason := build.Status.Error
+	if reason == "" {
+		reason = build.Status.Failure.Reason
+	}
+	if class := maven.FailureClass(reason); !policy.Retries(class) {
+		action.L.Infof("Build failure is not recoverable, as the retry policy does not retry %s failures", class)
+		build.Status.Phase = v1.BuildPhaseError
+
+		return build, nil
+	}
+
 	lastAttempt := build.Status.Failure.Recovery.AttemptTime.Time
 	if lastAttempt.IsZero() {
 		lastAttempt = build.Status.Failure.Time.Time
 	}
 
 	elapsed := time.Since(lastAttempt).Seconds()
-	elapsedMin := action.backOff.ForAttempt(float64(build.Status.Failure.Recovery.Attempt)).Seconds()
+	elapsedMin := action.backOffFor(policy).ForAttempt(float64(build.Status.Failure.Recovery.Attempt)).Seconds()
 
 	if elapsed < elapsedMin {
 		return nil, nil
@@ -103,3 +131,22 @@ func (action *errorRecoveryAction) Handle(ctx context.Context, build *v1.Build)
 
 	return build, nil
 }
+
+// backOffFor returns the backoff to apply according to the Build retry policy. The Builds without any retry policy
+// keep the legacy backoff.
+func (action *errorRecoveryAction) backOffFor(policy *v1.BuildRetryPolicy) *backoff.Backoff {
+	if policy == nil {
+		return &action.backOff
+	}
+
+	b := action.backOff
+	b.Max = defaultRetryPolicyBackoffMaxDuration
+	if policy.Backoff != nil {
+		b.Min = policy.Backoff.Duration
+	}
+	if policy.MaxBackoff != nil {
+		b.Max = policy.MaxBackoff.Duration
+	}
+
+	return &b
+}
@@ -0,0 +1,160 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const networkFailure = "Could not transfer artifact org.apache.camel:camel-timer:jar:4.14.0 from/to central: Connection reset"
+
+func newFailedBuild(policy *v1.BuildRetryPolicy, reason string, attempt int) *v1.Build {
+	build := v1.NewBuild("ns", "my-build")
+	build.Spec.RetryPolicy = policy
+	build.Status.Phase = v1.BuildPhaseFailed
+	build.Status.Error = reason
+	if attempt >= 0 {
+		build.Status.Failure = &v1.Failure{
+			Reason: reason,
+			Time:   metav1.NewTime(time.Now().Add(-time.Hour)),
+			Recovery: v1.FailureRecovery{
+				Attempt:    attempt,
+				AttemptMax: 3,
+			},
+		}
+	}
+
+	return build
+}
+
+func TestErrorRecoveryMaxAttempts(t *testing.T) {
+	a := newErrorRecoveryAction()
+	a.InjectLogger(log.Log)
+
+	build, err := a.Handle(context.TODO(), newFailedBuild(nil, networkFailure, -1))
+	require.NoError(t, err)
+	assert.Equal(t, defaultRecoveryMaxAttempt, build.Status.Failure.Recovery.AttemptMax)
+
+	build, err = a.Handle(context.TODO(), newFailedBuild(&v1.BuildRetryPolicy{MaxAttempts: ptr.To(int32(3))}, networkFailure, -1))
+	require.NoError(t, err)
+	assert.Equal(t, 3, build.Status.Failure.Recovery.AttemptMax)
+
+	build, err = a.Handle(context.TODO(), newFailedBuild(&v1.BuildRetryPolicy{MaxAttempts: ptr.To(int32(3))}, networkFailure, 3))
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, build.Status.Phase)
+
+	// no recovery at all
+	build, err = a.Handle(context.TODO(), newFailedBuild(&v1.BuildRetryPolicy{MaxAttempts: ptr.To(int32(0))}, networkFailure, -1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, build.Status.Failure.Recovery.AttemptMax)
+	build, err = a.Handle(context.TODO(), build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, build.Status.Phase)
+}
+
+func TestErrorRecoveryRetryOn(t *testing.T) {
+	a := newErrorRecoveryAction()
+	a.InjectLogger(log.Log)
+	policy := &v1.BuildRetryPolicy{
+		RetryOn: []v1.BuildFailureClass{v1.BuildFailureClassNetwork},
+	}
+
+	build, err := a.Handle(context.TODO(), newFailedBuild(policy, networkFailure, 0))
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseInitialization, build.Status.Phase)
+	assert.Equal(t, 1, build.Status.Failure.Recovery.Attempt)
+
+	build, err = a.Handle(context.TODO(), newFailedBuild(policy, "COMPILATION ERROR :", 0))
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, build.Status.Phase)
+	assert.Equal(t, 0, build.Status.Failure.Recovery.Attempt)
+}
+
+func TestErrorRecoveryRetryOnLatestFailure(t *testing.T) {
+	a := newErrorRecoveryAction()
+	a.InjectLogger(log.Log)
+	policy := &v1.BuildRetryPolicy{
+		RetryOn: []v1.BuildFailureClass{v1.BuildFailureClassNetwork},
+	}
+
+	// the first attempt failed because of the network, but the latest one because of the sources
+	build := newFailedBuild(policy, networkFailure, 1)
+	build.Status.Error = "COMPILATION ERROR :"
+	build, err := a.Handle(context.TODO(), build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, build.Status.Phase)
+	assert.Equal(t, 1, build.Status.Failure.Recovery.Attempt)
+}
+
+func TestErrorRecoveryVulnerabilitiesFound(t *testing.T) {
+	a := newErrorRecoveryAction()
+	a.InjectLogger(log.Log)
+
+	build := newFailedBuild(nil, "vulnerability scan failed: found 1 vulnerabilities in the dependencies", 0)
+	build.Status.SetCondition(v1.BuildConditionVulnerabilityScan, corev1.ConditionFalse, v1.BuildConditionVulnerabilitiesFoundReason, "found 1 vulnerabilities")
+	build, err := a.Handle(context.TODO(), build)
+	require.NoError(t, err)
+	assert.Equal(t, v1.BuildPhaseError, build.Status.Phase)
+	assert.Equal(t, 0, build.Status.Failure.Recovery.Attempt)
+}
+
+func TestErrorRecoveryBackoff(t *testing.T) {
+	a := newErrorRecoveryAction()
+	a.InjectLogger(log.Log)
+	policy := &v1.BuildRetryPolicy{
+		Backoff:    &metav1.Duration{Duration: 10 * time.Second},
+		MaxBackoff: &metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	b := a.(*errorRecoveryAction).backOffFor(policy)
+	assert.Equal(t, 10*time.Second, b.ForAttempt(0))
+	assert.Equal(t, 40*time.Second, b.ForAttempt(2))
+
+	// the Builds without retry policy keep the legacy constant backoff
+	b = a.(*errorRecoveryAction).backOffFor(nil)
+	assert.Equal(t, time.Second, b.ForAttempt(0))
+	assert.Equal(t, time.Second, b.ForAttempt(10))
+
+	// the retry policy backoff goes from 5s up to 5m by default
+	b = a.(*errorRecoveryAction).backOffFor(&v1.BuildRetryPolicy{MaxAttempts: ptr.To(int32(10))})
+	assert.Equal(t, 5*time.Second, b.ForAttempt(0))
+	assert.Equal(t, 20*time.Second, b.ForAttempt(2))
+	assert.Equal(t, 5*time.Minute, b.ForAttempt(10))
+	b = a.(*errorRecoveryAction).backOffFor(&v1.BuildRetryPolicy{Backoff: &metav1.Duration{Duration: time.Minute}})
+	assert.Equal(t, 5*time.Minute, b.ForAttempt(10))
+
+	// the last attempt happened less than the backoff duration ago
+	build := newFailedBuild(policy, networkFailure, 10)
+	build.Status.Failure.Recovery.AttemptMax = 20
+	build.Status.Failure.Recovery.AttemptTime = metav1.Now()
+	build, err := a.Handle(context.TODO(), build)
+	require.NoError(t, err)
+	assert.Nil(t, build)
+}
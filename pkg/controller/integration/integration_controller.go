@@ -56,6 +56,12 @@ import (
 	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
 )
 
+const (
+	canaryRequeueAfterDuration = 10 * time.Second
+	// externalSecretsRequeueAfterDuration is the interval the rotation of the external secrets is checked at.
+	externalSecretsRequeueAfterDuration = time.Minute
+)
+
 func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
 	err := mgr.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, "status.phase",
 		func(obj ctrl.Object) []string {
@@ -191,6 +197,17 @@ func integrationKitEnqueueRequestsFromMapFunc(ctx context.Context, c client.Clie
 	return requests
 }
 
+// isConfigReferencing returns true if the config of the Integration references the given resource,
+// either from the Integration namespace or from another namespace.
+func isConfigReferencing(conf *utilResource.Config, storageType utilResource.StorageType, integration *v1.Integration, res ctrl.Object) bool {
+	namespace := integration.Namespace
+	if conf.Namespace() != "" {
+		namespace = conf.Namespace()
+	}
+
+	return conf.StorageType() == storageType && conf.Name() == res.GetName() && namespace == res.GetNamespace()
+}
+
 func enqueueRequestsFromConfigFunc(ctx context.Context, c client.Client, res ctrl.Object) []reconcile.Request {
 	requests := make([]reconcile.Request, 0)
 
@@ -226,7 +243,7 @@ func enqueueRequestsFromConfigFunc(ctx context.Context, c client.Client, res ctr
 		}
 		for _, c := range integration.Status.Traits.Mount.Configs {
 			if conf, parseErr := utilResource.ParseConfig(c); parseErr == nil {
-				if conf.StorageType() == storageType && conf.Name() == res.GetName() {
+				if isConfigReferencing(conf, storageType, &integration, res) {
 					found = true
 
 					break
@@ -235,7 +252,7 @@ func enqueueRequestsFromConfigFunc(ctx context.Context, c client.Client, res ctr
 		}
 		for _, r := range integration.Status.Traits.Mount.Resources {
 			if conf, parseErr := utilResource.ParseConfig(r); parseErr == nil {
-				if conf.StorageType() == storageType && conf.Name() == res.GetName() {
+				if isConfigReferencing(conf, storageType, &integration, res) {
 					found = true
 
 					break
@@ -419,6 +436,8 @@ func watchIntegrationResources(c client.Client, b *builder.Builder) {
 			})).
 		// Watch for the owned Deployments
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(StatusChangedPredicate{})).
+		// Watch for the owned StatefulSets
+		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(StatusChangedPredicate{})).
 		// Watch for the owned Builds
 		Owns(&v1.Build{}, builder.WithPredicates(StatusChangedPredicate{}))
 }
@@ -554,6 +573,27 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		break
 	}
 
+	if target.IsCanaryInProgress() {
+		// Requeue to move the canary through the rollout steps
+		return reconcile.Result{
+			RequeueAfter: canaryRequeueAfterDuration,
+		}, nil
+	}
+
+	if remaining, ok := autoRollbackRemainingWindow(target); ok {
+		// Requeue to roll back the Integration when its failure window expires
+		return reconcile.Result{
+			RequeueAfter: remaining + time.Second,
+		}, nil
+	}
+
+	if target.Status.Phase == v1.IntegrationPhaseRunning && hasExternalSecretsHotReload(target) {
+		// Requeue to check whether the external secrets have been rotated
+		return reconcile.Result{
+			RequeueAfter: externalSecretsRequeueAfterDuration,
+		}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
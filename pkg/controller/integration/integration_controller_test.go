@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
 )
 
 func TestActions(t *testing.T) {
@@ -241,3 +242,20 @@ func TestDeploymentTimestampIsSet(t *testing.T) {
 		})
 	}
 }
+
+func TestIsConfigReferencing(t *testing.T) {
+	it := v1.NewIntegration("default", "my-it")
+	local := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "my-cm"}}
+	shared := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-cm"}}
+
+	conf, err := utilResource.ParseConfig("configmap:my-cm/key")
+	require.NoError(t, err)
+	assert.True(t, isConfigReferencing(conf, utilResource.StorageTypeConfigmap, &it, local))
+	assert.False(t, isConfigReferencing(conf, utilResource.StorageTypeConfigmap, &it, shared))
+	assert.False(t, isConfigReferencing(conf, utilResource.StorageTypeSecret, &it, local))
+
+	conf, err = utilResource.ParseConfig("configmap:shared:my-cm/key")
+	require.NoError(t, err)
+	assert.False(t, isConfigReferencing(conf, utilResource.StorageTypeConfigmap, &it, local))
+	assert.True(t, isConfigReferencing(conf, utilResource.StorageTypeConfigmap, &it, shared))
+}
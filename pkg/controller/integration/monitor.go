@@ -42,8 +42,11 @@ import (
 	"github.com/apache/camel-k/v2/pkg/trait"
 	"github.com/apache/camel-k/v2/pkg/util"
 	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/property"
 	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -103,8 +106,17 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	} else if changed != nil {
 		return changed, nil
 	}
+	checkExternalSecrets(integration)
 
-	if kit != nil {
+	// Check if the Integration has to be rolled back to its last healthy revision
+	if rolledBack, err := action.checkAutoRollback(ctx, integration); err != nil {
+		return nil, err
+	} else if rolledBack != nil {
+		return rolledBack, nil
+	}
+
+	// Do not switch a rolled back Integration to another IntegrationKit
+	if kit != nil && !integration.IsRolledBack() {
 		// Check if an IntegrationKit with higher priority is ready
 		priority, ok := kit.Labels[v1.IntegrationKitPriorityLabel]
 		if !ok {
@@ -141,6 +153,13 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	}
 	action.checkTraitAnnotationsDeprecatedNotice(integration)
 
+	// Keep track of the applied Integration revision in its history, once deployed, rather than on each monitoring
+	if integration.Status.Phase == v1.IntegrationPhaseDeploying {
+		if _, err := revision.Record(ctx, action.client, integration); err != nil {
+			action.L.Errorf(err, "Cannot record the revision of integration %s", integration.Name)
+		}
+	}
+
 	return action.monitorPods(ctx, environment, integration)
 }
 
@@ -218,6 +237,15 @@ func (action *monitorAction) monitorPods(ctx context.Context, environment *trait
 	if err != nil {
 		return nil, err
 	}
+	if integration.IsCanaryInProgress() {
+		// The canary pods are monitored separately, so that a failing canary does not affect the stable pods
+		var canaryPendingPods, canaryRunningPods []corev1.Pod
+		pendingPods.Items, canaryPendingPods = splitCanaryPods(pendingPods.Items)
+		runningPods.Items, canaryRunningPods = splitCanaryPods(runningPods.Items)
+		if err := action.monitorCanaryPods(ctx, environment, integration, canaryPendingPods, canaryRunningPods); err != nil {
+			return nil, err
+		}
+	}
 	nonTerminatingPods := 0
 	for _, pod := range runningPods.Items {
 		if pod.DeletionTimestamp != nil {
@@ -331,7 +359,17 @@ func getIntegrationSecretAndConfigmapResourceVersions(ctx context.Context, clien
 		mergedResources = append(mergedResources, integration.Status.Traits.Mount.Configs...)
 		mergedResources = append(mergedResources, integration.Status.Traits.Mount.Resources...)
 		for _, c := range mergedResources {
+			if externalsecret.IsReference(c) {
+				secrets = append(secrets, lookupExternalSecretDigest(ctx, client, integration, c, ""))
+
+				continue
+			}
 			if conf, parseErr := utilResource.ParseConfig(c); parseErr == nil {
+				namespace := integration.Namespace
+				if conf.Namespace() != "" {
+					// The resource is copied from another namespace, which holds the actual version
+					namespace = conf.Namespace()
+				}
 				if conf.StorageType() == utilResource.StorageTypeConfigmap {
 					cm := corev1.ConfigMap{
 						TypeMeta: metav1.TypeMeta{
@@ -339,7 +377,7 @@ func getIntegrationSecretAndConfigmapResourceVersions(ctx context.Context, clien
 							APIVersion: corev1.SchemeGroupVersion.String(),
 						},
 						ObjectMeta: metav1.ObjectMeta{
-							Namespace: integration.Namespace,
+							Namespace: namespace,
 							Name:      conf.Name(),
 						},
 					}
@@ -351,7 +389,7 @@ func getIntegrationSecretAndConfigmapResourceVersions(ctx context.Context, clien
 							APIVersion: corev1.SchemeGroupVersion.String(),
 						},
 						ObjectMeta: metav1.ObjectMeta{
-							Namespace: integration.Namespace,
+							Namespace: namespace,
 							Name:      conf.Name(),
 						},
 					}
@@ -359,11 +397,44 @@ func getIntegrationSecretAndConfigmapResourceVersions(ctx context.Context, clien
 				}
 			}
 		}
+		if integration.Status.Traits.Camel != nil {
+			for _, prop := range integration.Status.Traits.Camel.Properties {
+				if name, value := property.SplitPropertyFileEntry(prop); externalsecret.IsReference(value) {
+					secrets = append(secrets, lookupExternalSecretDigest(ctx, client, integration, value, name))
+				}
+			}
+		}
 	}
 
 	return secrets, configmaps
 }
 
+// hasExternalSecretsHotReload returns true if the Integration must be redeployed when the external secrets it references are rotated.
+func hasExternalSecretsHotReload(integration *v1.Integration) bool {
+	if integration.Status.Traits == nil || integration.Status.Traits.Mount == nil || !ptr.Deref(integration.Status.Traits.Mount.HotReload, false) {
+		return false
+	}
+	for _, c := range integration.Status.Traits.Mount.Configs {
+		if externalsecret.IsReference(c) {
+			return true
+		}
+	}
+	for _, r := range integration.Status.Traits.Mount.Resources {
+		if externalsecret.IsReference(r) {
+			return true
+		}
+	}
+	if integration.Status.Traits.Camel != nil {
+		for _, prop := range integration.Status.Traits.Camel.Properties {
+			if _, value := property.SplitPropertyFileEntry(prop); externalsecret.IsReference(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type controller interface {
 	checkReadyCondition(ctx context.Context) (bool, error)
 	updateReadyCondition(readyPods int32) bool
@@ -385,6 +456,16 @@ func (action *monitorAction) newController(env *trait.Environment, integration *
 			obj:         deploy,
 			integration: integration,
 		}
+	case integration.IsConditionTrue(v1.IntegrationConditionStatefulSetAvailable):
+		obj = getUpdatedController(env, &appsv1.StatefulSet{})
+		statefulSet, ok := obj.(*appsv1.StatefulSet)
+		if !ok {
+			return nil, fmt.Errorf("type assertion failed, not a StatefulSet: %v", obj)
+		}
+		controller = &statefulSetController{
+			obj:         statefulSet,
+			integration: integration,
+		}
 	case integration.IsConditionTrue(v1.IntegrationConditionKnativeServiceAvailable):
 		obj = getUpdatedController(env, &servingv1.Service{})
 		svc, ok := obj.(*servingv1.Service)
@@ -420,7 +501,8 @@ func (action *monitorAction) newController(env *trait.Environment, integration *
 // getUpdatedController retrieves the controller updated from the deployer trait execution.
 func getUpdatedController(env *trait.Environment, obj ctrl.Object) ctrl.Object {
 	return env.Resources.GetController(func(object ctrl.Object) bool {
-		return reflect.TypeOf(obj) == reflect.TypeOf(object)
+		return reflect.TypeOf(obj) == reflect.TypeOf(object) &&
+			object.GetLabels()[v1.IntegrationCanaryTrackLabel] != v1.IntegrationCanaryTrackCanary
 	})
 }
 
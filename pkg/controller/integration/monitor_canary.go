@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/trait"
+)
+
+// splitCanaryPods returns the stable and the canary pods.
+func splitCanaryPods(pods []corev1.Pod) ([]corev1.Pod, []corev1.Pod) {
+	stable := make([]corev1.Pod, 0, len(pods))
+	canary := make([]corev1.Pod, 0)
+	for _, pod := range pods {
+		if pod.Labels[v1.IntegrationCanaryTrackLabel] == v1.IntegrationCanaryTrackCanary {
+			canary = append(canary, pod)
+		} else {
+			stable = append(stable, pod)
+		}
+	}
+
+	return stable, canary
+}
+
+// monitorCanaryPods reports the readiness of the canary pods in the Integration canary status, and marks the canary as
+// failed when its pods fail, so that the canary trait can roll it back.
+func (action *monitorAction) monitorCanaryPods(
+	ctx context.Context, environment *trait.Environment, integration *v1.Integration,
+	pendingPods []corev1.Pod, runningPods []corev1.Pod,
+) error {
+	canary := integration.Status.Canary
+	if canary.Phase != v1.IntegrationCanaryPhaseProgressing {
+		return nil
+	}
+
+	// The canary pods are checked against a copy of the Integration, as the checks report their results
+	// in the Integration Ready condition
+	probed := integration.DeepCopy()
+	if arePodsFailingStatuses(probed, pendingPods, runningPods) {
+		action.setCanaryFailed(canary, probed)
+
+		return nil
+	}
+	readyPods, probeOk, err := action.probeReadiness(ctx, environment, probed, runningPods)
+	if err != nil {
+		return err
+	}
+	canary.ReadyReplicas = readyPods
+	// The canary pods may not be ready yet, it's only failed when the runtime health checks are down
+	if ready := probed.Status.GetCondition(v1.IntegrationConditionReady); !probeOk && ready != nil &&
+		ready.Reason == v1.IntegrationConditionErrorReason {
+		action.setCanaryFailed(canary, probed)
+	}
+
+	return nil
+}
+
+func (action *monitorAction) setCanaryFailed(canary *v1.IntegrationCanaryStatus, probed *v1.Integration) {
+	canary.Phase = v1.IntegrationCanaryPhaseFailed
+	if ready := probed.Status.GetCondition(v1.IntegrationConditionReady); ready != nil {
+		canary.Message = ready.Message
+	}
+	action.L.Infof("Canary of integration %s failed: %s", probed.Name, canary.Message)
+}
@@ -23,6 +23,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
@@ -62,6 +63,14 @@ func (c *deploymentController) updateReadyCondition(readyPods int32) bool {
 	if r := c.integration.Spec.Replicas; r != nil {
 		replicas = *r
 	}
+	// The replicas of the Deployment are managed by the autoscaler, when enabled
+	if hpa := c.integration.Spec.Traits.HPA; hpa != nil && ptr.Deref(hpa.Enabled, false) && c.obj.Spec.Replicas != nil {
+		replicas = *c.obj.Spec.Replicas
+	}
+	// Some of the replicas are replaced by canary pods during a canary rollout
+	if c.integration.IsCanaryInProgress() && c.obj.Spec.Replicas != nil {
+		replicas = *c.obj.Spec.Replicas
+	}
 	// The Deployment status reports updated and ready replicas separately,
 	// so that the number of ready replicas also accounts for older versions.
 	readyReplicas := readyPods
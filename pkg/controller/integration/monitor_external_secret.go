@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
+)
+
+// externalSecretsCacheExpiration is the time after which the digest of an external secret, which is not checked anymore, is evicted.
+const externalSecretsCacheExpiration = 10 * externalSecretsRequeueAfterDuration
+
+// externalSecretDigests keeps the digests of the external secrets referenced by the Integrations.
+var externalSecretDigests = newExternalSecretDigestCache()
+
+type externalSecretDigest struct {
+	digest  string
+	err     error
+	checked time.Time
+}
+
+// externalSecretDigestCache rate limits the lookups of the external secrets, which are done at most once per check
+// interval, and keeps the last known digest of a secret when its lookup fails, so that an unavailable store does
+// not redeploy the Integrations.
+type externalSecretDigestCache struct {
+	lock    sync.Mutex
+	entries map[string]externalSecretDigest
+}
+
+func newExternalSecretDigestCache() *externalSecretDigestCache {
+	return &externalSecretDigestCache{
+		entries: make(map[string]externalSecretDigest),
+	}
+}
+
+func (c *externalSecretDigestCache) get(key string) (externalSecretDigest, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+
+	return entry, ok
+}
+
+func (c *externalSecretDigestCache) set(key string, entry externalSecretDigest) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for k, e := range c.entries {
+		if time.Since(e.checked) > externalSecretsCacheExpiration {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = entry
+}
+
+// failures returns the errors of the latest lookups of the external secrets of the Integration.
+func (c *externalSecretDigestCache) failures(integration *v1.Integration) []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	prefix := externalSecretDigestKey(integration, "", "")
+	failures := make([]string, 0)
+	for k, e := range c.entries {
+		if e.err != nil && strings.HasPrefix(k, prefix) {
+			failures = append(failures, e.err.Error())
+		}
+	}
+	sort.Strings(failures)
+
+	return failures
+}
+
+func externalSecretDigestKey(integration *v1.Integration, property string, value string) string {
+	if property == "" && value == "" {
+		return fmt.Sprintf("%s/%s/", integration.Namespace, integration.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s=%s", integration.Namespace, integration.Name, property, value)
+}
+
+// lookupExternalSecretDigest returns the digest of the content of an external secret, which changes when the secret is rotated.
+// The property is the name of the Camel property referencing the secret, if any. When the lookup fails, the last known
+// digest is returned.
+func lookupExternalSecretDigest(ctx context.Context, c client.Client, integration *v1.Integration, value string, property string) string {
+	key := externalSecretDigestKey(integration, property, value)
+	last, found := externalSecretDigests.get(key)
+	if found && time.Since(last.checked) < externalSecretsRequeueAfterDuration {
+		return last.digest
+	}
+
+	ref, err := externalsecret.ParseReference(value)
+	if err != nil {
+		return ""
+	}
+	entry := externalSecretDigest{checked: time.Now()}
+	content, err := externalsecret.Resolve(externalsecret.Context{
+		Ctx:            ctx,
+		Client:         c,
+		Namespace:      integration.Namespace,
+		ServiceAccount: integration.Spec.ServiceAccountName,
+	}, *ref)
+	switch {
+	case err == nil:
+		entry.digest = content.Digest()
+	case found:
+		entry.digest, entry.err = last.digest, err
+	default:
+		// The operator has restarted since the latest lookup, the last known content is the one synced into the cluster
+		entry.digest, entry.err = syncedExternalSecretDigest(ctx, c, integration, ref, property), err
+	}
+	externalSecretDigests.set(key, entry)
+
+	return entry.digest
+}
+
+// syncedExternalSecretDigest returns the digest of the content of an external secret, as synced into a Secret by the mount trait.
+func syncedExternalSecretDigest(ctx context.Context, c client.Client, integration *v1.Integration, ref *externalsecret.Reference, property string) string {
+	name := ref.SecretName(integration.Name)
+	if property != "" {
+		name = externalsecret.PropertiesSecretName(integration.Name)
+	}
+	secret := corev1.Secret{}
+	if err := c.Get(ctx, ctrl.ObjectKey{Namespace: integration.Namespace, Name: name}, &secret); err != nil {
+		return ""
+	}
+	content := externalsecret.Content{Data: secret.Data}
+	if property != "" {
+		content.Data = map[string][]byte{ref.Key: secret.Data[property]}
+	}
+
+	return content.Digest()
+}
+
+// checkExternalSecrets warns when the latest lookups of the external secrets of the Integration have failed.
+func checkExternalSecrets(integration *v1.Integration) {
+	if failures := externalSecretDigests.failures(integration); len(failures) > 0 {
+		integration.Status.SetCondition(
+			v1.IntegrationConditionExternalSecretsAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionExternalSecretsNotAvailableReason,
+			"cannot check whether the external secrets have been rotated: "+strings.Join(failures, ", "),
+		)
+
+		return
+	}
+	integration.Status.RemoveCondition(v1.IntegrationConditionExternalSecretsAvailable)
+}
@@ -0,0 +1,169 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+)
+
+const defaultAutoRollbackFailureWindow = int32(120)
+
+// autoRollbackRemainingWindow returns the time left before the failing Integration revision is rolled back
+// to the last healthy one, and false if the Integration is not subject to be rolled back.
+func autoRollbackRemainingWindow(integration *v1.Integration) (time.Duration, bool) {
+	autoRollback := integration.Spec.Traits.AutoRollback
+	if autoRollback == nil || !ptr.Deref(autoRollback.Enabled, false) {
+		return 0, false
+	}
+	// The Integration has already been rolled back, or cannot be
+	if integration.Status.Phase != v1.IntegrationPhaseError || integration.Status.GetCondition(v1.IntegrationConditionRolledBack) != nil {
+		return 0, false
+	}
+	healthy := integration.Status.LastHealthyRevision
+	if healthy == nil || healthy.IntegrationKit == nil || healthy.Digest == integration.Status.Digest {
+		return 0, false
+	}
+	ready := integration.Status.GetCondition(v1.IntegrationConditionReady)
+	if ready == nil || ready.Status != corev1.ConditionFalse || ready.Reason != v1.IntegrationConditionErrorReason {
+		return 0, false
+	}
+
+	window := time.Duration(ptr.Deref(autoRollback.FailureWindowSeconds, defaultAutoRollbackFailureWindow)) * time.Second
+
+	return max(window-time.Since(ready.LastTransitionTime.Time), 0), true
+}
+
+// checkAutoRollback redeploys the last healthy revision, when the current revision has been failing for longer than
+// the failure window of the auto-rollback trait. The specification of the last healthy revision is restored from the
+// revision history of the Integration, along with its IntegrationKit. When it is not available, only the
+// IntegrationKit is rolled back, provided it differs from the failing one, otherwise the Integration cannot be rolled back.
+func (action *monitorAction) checkAutoRollback(ctx context.Context, integration *v1.Integration) (*v1.Integration, error) {
+	remaining, ok := autoRollbackRemainingWindow(integration)
+	if !ok || remaining > 0 {
+		return nil, nil
+	}
+
+	healthy := integration.Status.LastHealthyRevision
+	kit, err := kubernetes.GetIntegrationKit(ctx, action.client, healthy.IntegrationKit.Name, healthy.IntegrationKit.Namespace)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if kit == nil || kit.Status.Phase != v1.IntegrationKitPhaseReady {
+		return action.cannotRollback(integration, fmt.Sprintf("integration kit %s/%s of revision %s is not available",
+			healthy.IntegrationKit.Namespace, healthy.IntegrationKit.Name, healthy.Digest))
+	}
+
+	target, err := action.healthyRevision(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	var message string
+	switch {
+	case target != nil:
+		action.L.Infof("Integration %s is failing: rolling back to revision %d", integration.Name, target.Number)
+
+		// The Integration specification is restored, so that the configuration of the failing revision is rolled back too
+		restored := integration.DeepCopy()
+		restored.Spec = target.Integration.Spec
+		if err := action.client.Patch(ctx, restored, ctrl.MergeFrom(integration)); err != nil {
+			return nil, fmt.Errorf("unable to roll back integration %s to revision %d: %w", integration.Name, target.Number, err)
+		}
+		integration.Spec = restored.Spec
+		integration.ResourceVersion = restored.ResourceVersion
+		integration.Generation = restored.Generation
+		// The digest of the restored specification is recomputed, so that the restored Integration
+		// is not mistaken for a changed one, and reset, on the next monitoring
+		secrets, configmaps := getIntegrationSecretAndConfigmapResourceVersions(ctx, action.client, integration)
+		hash, err := digest.ComputeForIntegration(integration, configmaps, secrets)
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf("revision %s failed: rolled back to revision %d, with integration kit %s/%s",
+			integration.Status.Digest, target.Number, kit.Namespace, kit.Name)
+		integration.Status.Digest = hash
+	case integration.Status.IntegrationKit != nil && integration.Status.IntegrationKit.Name == kit.Name &&
+		integration.Status.IntegrationKit.Namespace == kit.Namespace:
+		// Deploying the same IntegrationKit would only deploy the failing revision again
+		return action.cannotRollback(integration, fmt.Sprintf("revision %s is not in the revision history, "+
+			"and it uses the same integration kit %s/%s as the failing revision", healthy.Digest, kit.Namespace, kit.Name))
+	default:
+		action.L.Infof("Integration %s is failing: rolling back to integration kit %s/%s", integration.Name, kit.Namespace, kit.Name)
+
+		message = fmt.Sprintf("revision %s failed: rolled back to integration kit %s/%s of revision %s",
+			integration.Status.Digest, kit.Namespace, kit.Name, healthy.Digest)
+	}
+
+	integration.SetIntegrationKit(kit)
+	integration.Status.SetCondition(
+		v1.IntegrationConditionRolledBack,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionAutoRollbackReason,
+		message,
+	)
+	integration.SetDeployingPhase()
+
+	return integration, nil
+}
+
+// healthyRevision returns the last healthy revision from the history of the Integration, if available.
+// The Integrations managed by a Pipe are not restored, as their specification is owned by the Pipe.
+func (action *monitorAction) healthyRevision(ctx context.Context, integration *v1.Integration) (*revision.Revision, error) {
+	for _, owner := range integration.OwnerReferences {
+		if owner.Kind == v1.PipeKind {
+			return nil, nil
+		}
+	}
+	revisions, err := revision.List(ctx, action.client, integration.Namespace, integration.Name)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if revisions[i].Digest == integration.Status.LastHealthyRevision.Digest && revisions[i].Integration != nil {
+			return &revisions[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// cannotRollback reports the failing Integration cannot be rolled back, so that it is not attempted again
+// until the Integration changes.
+func (action *monitorAction) cannotRollback(integration *v1.Integration, reason string) (*v1.Integration, error) {
+	action.L.Infof("Integration %s cannot be rolled back: %s", integration.Name, reason)
+	integration.Status.SetCondition(
+		v1.IntegrationConditionRolledBack,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionAutoRollbackImpossibleReason,
+		reason,
+	)
+
+	return integration, nil
+}
@@ -0,0 +1,232 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorAutoRollback(t *testing.T) {
+	it := failingIntegration(time.Now().Add(-5 * time.Minute))
+	hash, err := digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+	it.Status.Digest = hash
+	c, err := internal.NewFakeClient(it, rollbackTestKit("my-kit", "my-image:2"), rollbackTestKit("healthy-kit", "my-image:1"))
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	require.NotNil(t, handledIt)
+	assert.Equal(t, v1.IntegrationPhaseDeploying, handledIt.Status.Phase)
+	assert.Equal(t, "healthy-kit", handledIt.Status.IntegrationKit.Name)
+	assert.Equal(t, "my-image:1", handledIt.Status.Image)
+	assert.Equal(t, hash, handledIt.Status.Digest)
+	assert.True(t, handledIt.IsRolledBack())
+	assert.Equal(t, v1.IntegrationConditionAutoRollbackReason,
+		handledIt.Status.GetCondition(v1.IntegrationConditionRolledBack).Reason)
+	_, pending := autoRollbackRemainingWindow(handledIt)
+	assert.False(t, pending)
+}
+
+func TestMonitorAutoRollbackRestoresHealthyRevision(t *testing.T) {
+	it := failingIntegration(time.Now().Add(-5 * time.Minute))
+	healthy := it.DeepCopy()
+	healthy.Spec.Sources = []v1.SourceSpec{v1.NewSourceSpec("routes.yaml", "- from: {uri: timer:tick}", v1.LanguageYaml)}
+	healthy.Status.Digest = "healthy-digest"
+	it.Spec.Sources = healthy.Spec.Sources
+	it.Spec.Configuration = []v1.ConfigurationSpec{{Type: "env", Value: "FAILING=true"}}
+	hash, err := digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+	it.Status.Digest = hash
+	// The failing revision uses the same kit, as only its configuration changed
+	it.Status.IntegrationKit.Name = "healthy-kit"
+	defaultCatalog, err := camel.DefaultCatalog()
+	require.NoError(t, err)
+	it.Status.RuntimeVersion = defaultCatalog.Runtime.Version
+	catalog := &v1.CamelCatalog{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.CamelCatalogKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "camel-k-catalog",
+		},
+		Spec: defaultCatalog.CamelCatalogSpec,
+	}
+	c, err := internal.NewFakeClient(catalog, it, rollbackTestKit("healthy-kit", "my-image:1"))
+	require.NoError(t, err)
+	_, err = revision.Record(context.TODO(), c, healthy)
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	require.NotNil(t, handledIt)
+	assert.Equal(t, v1.IntegrationPhaseDeploying, handledIt.Status.Phase)
+	assert.True(t, handledIt.IsRolledBack())
+	assert.Contains(t, handledIt.Status.GetCondition(v1.IntegrationConditionRolledBack).Message, "rolled back to revision 1")
+	assert.Empty(t, handledIt.Spec.Configuration)
+
+	// The specification of the healthy revision is restored
+	restored := v1.NewIntegration("ns", "my-it")
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(&restored), &restored))
+	assert.Empty(t, restored.Spec.Configuration)
+	assert.Equal(t, healthy.Spec.Sources, restored.Spec.Sources)
+
+	// The restored Integration is not reset on the next monitoring, so that it remains rolled back
+	restoredHash, err := digest.ComputeForIntegration(&restored, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, restoredHash, handledIt.Status.Digest)
+	handledIt, err = a.Handle(context.TODO(), handledIt)
+	require.NoError(t, err)
+	require.NotNil(t, handledIt)
+	assert.Equal(t, restoredHash, handledIt.Status.Digest)
+	assert.True(t, handledIt.IsRolledBack())
+	assert.Contains(t, handledIt.Status.GetCondition(v1.IntegrationConditionRolledBack).Message, "rolled back to revision 1")
+}
+
+func TestMonitorAutoRollbackImpossible(t *testing.T) {
+	it := failingIntegration(time.Now().Add(-5 * time.Minute))
+	hash, err := digest.ComputeForIntegration(it, nil, nil)
+	require.NoError(t, err)
+	it.Status.Digest = hash
+	// The failing revision uses the same kit, and the healthy revision is not in the history
+	it.Status.IntegrationKit.Name = "healthy-kit"
+	c, err := internal.NewFakeClient(it, rollbackTestKit("healthy-kit", "my-image:1"))
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+	handledIt, err := a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	require.NotNil(t, handledIt)
+	assert.Equal(t, v1.IntegrationPhaseError, handledIt.Status.Phase)
+	assert.False(t, handledIt.IsRolledBack())
+	condition := handledIt.Status.GetCondition(v1.IntegrationConditionRolledBack)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionAutoRollbackImpossibleReason, condition.Reason)
+	// The rollback is not attempted again
+	_, pending := autoRollbackRemainingWindow(handledIt)
+	assert.False(t, pending)
+}
+
+func TestAutoRollbackRemainingWindow(t *testing.T) {
+	it := failingIntegration(time.Now().Add(-30 * time.Second))
+	remaining, pending := autoRollbackRemainingWindow(it)
+	assert.True(t, pending)
+	assert.Greater(t, remaining, 80*time.Second)
+	assert.LessOrEqual(t, remaining, 90*time.Second)
+
+	it.Spec.Traits.AutoRollback.FailureWindowSeconds = ptr.To(int32(10))
+	remaining, pending = autoRollbackRemainingWindow(it)
+	assert.True(t, pending)
+	assert.Equal(t, time.Duration(0), remaining)
+
+	it.Status.LastHealthyRevision.Digest = it.Status.Digest
+	_, pending = autoRollbackRemainingWindow(it)
+	assert.False(t, pending)
+
+	it = failingIntegration(time.Now())
+	it.Spec.Traits.AutoRollback.Enabled = nil
+	_, pending = autoRollbackRemainingWindow(it)
+	assert.False(t, pending)
+}
+
+func failingIntegration(failingSince time.Time) *v1.Integration {
+	return &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				AutoRollback: &trait.AutoRollbackTrait{
+					Trait: trait.Trait{
+						Enabled: ptr.To(true),
+					},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase:          v1.IntegrationPhaseError,
+			Digest:         "failing-digest",
+			Image:          "my-image:2",
+			IntegrationKit: &corev1.ObjectReference{Namespace: "ns", Name: "my-kit"},
+			Conditions: []v1.IntegrationCondition{
+				{
+					Type:               v1.IntegrationConditionReady,
+					Status:             corev1.ConditionFalse,
+					Reason:             v1.IntegrationConditionErrorReason,
+					LastTransitionTime: metav1.NewTime(failingSince),
+				},
+			},
+			LastHealthyRevision: &v1.IntegrationHealthyRevision{
+				Digest:         "healthy-digest",
+				IntegrationKit: &corev1.ObjectReference{Namespace: "ns", Name: "healthy-kit"},
+				Image:          "my-image:1",
+			},
+		},
+	}
+}
+
+func rollbackTestKit(name string, image string) *v1.IntegrationKit {
+	return &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+			Image: image,
+		},
+	}
+}
@@ -19,6 +19,7 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -32,8 +33,10 @@ import (
 
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
 	"github.com/apache/camel-k/v2/pkg/util/digest"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/log"
+	"github.com/apache/camel-k/v2/pkg/util/revision"
 
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/stretchr/testify/assert"
@@ -99,6 +102,191 @@ func TestGetIntegrationSecretAndConfigmapResourceVersions(t *testing.T) {
 	assert.NotEqual(t, "", secrets[0])
 }
 
+func TestGetIntegrationCrossNamespaceResourceVersions(t *testing.T) {
+	cm := newConfigMap("shared", "cm-test", "test.txt", "test.txt", "xyz", nil)
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "default",
+		},
+		Status: v1.IntegrationStatus{
+			Traits: &v1.Traits{
+				Mount: &trait.MountTrait{
+					Configs:   []string{"configmap:shared:cm-test"},
+					HotReload: ptr.To(true),
+				},
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(cm)
+	require.NoError(t, err)
+
+	_, configmaps := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	require.Len(t, configmaps, 1)
+	// The version is the one of the Configmap in the shared namespace
+	assert.NotEqual(t, "", configmaps[0])
+}
+
+// localSecretProvider is a stand-in external secret provider, resolving the secrets from memory.
+type localSecretProvider map[string]map[string][]byte
+
+func (p localSecretProvider) ID() string {
+	return "local"
+}
+
+func (p localSecretProvider) Resolve(ctx externalsecret.Context, ref externalsecret.Reference) (*externalsecret.Content, error) {
+	data := make(map[string][]byte)
+	for k, v := range p[ref.Path] {
+		data[k] = v
+	}
+
+	return &externalsecret.Content{Data: data}, nil
+}
+
+func TestGetIntegrationExternalSecretVersions(t *testing.T) {
+	store := localSecretProvider{
+		"db": {"password": []byte("changeit")},
+	}
+	externalsecret.RegisterProvider(store)
+	externalSecretDigests = newExternalSecretDigestCache()
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "default",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+			Traits: &v1.Traits{
+				Camel: &trait.CamelTrait{
+					Properties: []string{"my.password=local:db#password", "my.user=admin"},
+				},
+				Mount: &trait.MountTrait{
+					Configs: []string{"local:db"},
+				},
+			},
+		},
+	}
+	c, err := internal.NewFakeClient()
+	require.NoError(t, err)
+	// Default hot reload (false)
+	assert.False(t, hasExternalSecretsHotReload(it))
+	secrets, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Empty(t, secrets)
+	// Enabled hot reload (true)
+	it.Status.Traits.Mount.HotReload = ptr.To(true)
+	assert.True(t, hasExternalSecretsHotReload(it))
+	secrets, _ = getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	require.Len(t, secrets, 2)
+	assert.NotEmpty(t, secrets[0])
+	assert.NotEmpty(t, secrets[1])
+	// The secret is not looked up again before the check interval has elapsed
+	store["db"]["password"] = []byte("rotated")
+	cached, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Equal(t, secrets, cached)
+	// The versions change when the secret is rotated
+	expireExternalSecretDigests()
+	rotated, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	require.Len(t, rotated, 2)
+	assert.NotEqual(t, secrets[0], rotated[0])
+	assert.NotEqual(t, secrets[1], rotated[1])
+}
+
+// flakySecretProvider is a stand-in external secret provider, which fails when its store is unavailable.
+type flakySecretProvider struct {
+	data map[string][]byte
+	err  error
+}
+
+func (p *flakySecretProvider) ID() string {
+	return "flaky"
+}
+
+func (p *flakySecretProvider) Resolve(ctx externalsecret.Context, ref externalsecret.Reference) (*externalsecret.Content, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	data := make(map[string][]byte)
+	for k, v := range p.data {
+		data[k] = v
+	}
+
+	return &externalsecret.Content{Data: data}, nil
+}
+
+// expireExternalSecretDigests lets the next lookups of the external secrets hit the stores.
+func expireExternalSecretDigests() {
+	for k, e := range externalSecretDigests.entries {
+		e.checked = e.checked.Add(-externalSecretsRequeueAfterDuration)
+		externalSecretDigests.entries[k] = e
+	}
+}
+
+func TestGetIntegrationExternalSecretVersionsUnavailable(t *testing.T) {
+	store := &flakySecretProvider{data: map[string][]byte{"password": []byte("changeit")}}
+	externalsecret.RegisterProvider(store)
+	externalSecretDigests = newExternalSecretDigestCache()
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-it",
+			Namespace: "default",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+			Traits: &v1.Traits{
+				Camel: &trait.CamelTrait{
+					Properties: []string{"my.password=flaky:db#password"},
+				},
+				Mount: &trait.MountTrait{
+					Configs:   []string{"flaky:db#password"},
+					HotReload: ptr.To(true),
+				},
+			},
+		},
+	}
+	ref := externalsecret.Reference{Provider: "flaky", Path: "db", Key: "password"}
+	c, err := internal.NewFakeClient(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: ref.SecretName("my-it")},
+			Data:       map[string][]byte{"password": []byte("changeit")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: externalsecret.PropertiesSecretName("my-it")},
+			Data:       map[string][]byte{"my.password": []byte("changeit")},
+		},
+	)
+	require.NoError(t, err)
+
+	secrets, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	require.Len(t, secrets, 2)
+	checkExternalSecrets(it)
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionExternalSecretsAvailable))
+
+	// The last known versions are kept when the store is unavailable
+	store.err = errors.New("connection refused")
+	expireExternalSecretDigests()
+	unavailable, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Equal(t, secrets, unavailable)
+	checkExternalSecrets(it)
+	condition := it.Status.GetCondition(v1.IntegrationConditionExternalSecretsAvailable)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionExternalSecretsNotAvailableReason, condition.Reason)
+	assert.Contains(t, condition.Message, "could not resolve external secret flaky:db#password: connection refused")
+
+	// The last known versions are the ones of the synced secrets once the operator has restarted
+	externalSecretDigests = newExternalSecretDigestCache()
+	restarted, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Equal(t, secrets, restarted)
+
+	// The warning is removed once the store is available again
+	store.err = nil
+	expireExternalSecretDigests()
+	available, _ := getIntegrationSecretAndConfigmapResourceVersions(context.TODO(), c, it)
+	assert.Equal(t, secrets, available)
+	checkExternalSecrets(it)
+	assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionExternalSecretsAvailable))
+}
+
 func TestMonitorIntegration(t *testing.T) {
 	c, it, err := nominalEnvironment()
 	require.NoError(t, err)
@@ -117,6 +305,33 @@ func TestMonitorIntegration(t *testing.T) {
 	assert.Equal(t, v1.IntegrationConditionDeploymentReadyReason, handledIt.Status.GetCondition(v1.IntegrationConditionReady).Reason)
 }
 
+func TestMonitorIntegrationRecordsRevision(t *testing.T) {
+	c, it, err := nominalEnvironment()
+	require.NoError(t, err)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	// The revision is not recorded while the Integration is running
+	handledIt, err := a.Handle(context.TODO(), it.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	revisions, err := revision.List(context.TODO(), c, it.Namespace, it.Name)
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+
+	// The revision is recorded once the Integration is deployed
+	it.Status.Phase = v1.IntegrationPhaseDeploying
+	handledIt, err = a.Handle(context.TODO(), it)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationPhaseRunning, handledIt.Status.Phase)
+	revisions, err = revision.List(context.TODO(), c, it.Namespace, it.Name)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, it.Status.Digest, revisions[0].Digest)
+}
+
 func TestMonitorFailureIntegration(t *testing.T) {
 	c, it, err := nominalEnvironment()
 	require.NoError(t, err)
@@ -264,3 +479,173 @@ func newConfigMap(namespace, cmName, originalFilename string, generatedKey strin
 	}
 	return &cm
 }
+
+func TestDeploymentReadyConditionWithHPA(t *testing.T) {
+	it := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Replicas: ptr.To(int32(1)),
+			Traits: v1.Traits{
+				HPA: &trait.HPATrait{
+					Trait:       trait.Trait{Enabled: ptr.To(true)},
+					MaxReplicas: ptr.To(int32(5)),
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(3)),
+		},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas: 3,
+		},
+	}
+	c := &deploymentController{obj: deployment, integration: it}
+
+	// The number of replicas is the one set by the autoscaler on the Deployment
+	assert.False(t, c.updateReadyCondition(2))
+	assert.Equal(t, "2/3 ready replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+	assert.True(t, c.updateReadyCondition(3))
+	assert.Equal(t, "3/3 ready replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+}
+
+func TestStatefulSetReadyCondition(t *testing.T) {
+	it := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Replicas: ptr.To(int32(3)),
+		},
+	}
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "it",
+		},
+		Status: appsv1.StatefulSetStatus{
+			CurrentRevision: "it-1",
+			UpdateRevision:  "it-2",
+			UpdatedReplicas: 1,
+		},
+	}
+	c := &statefulSetController{obj: statefulSet, integration: it}
+
+	done, err := c.checkReadyCondition(context.TODO())
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	// The pods are updated one after the other
+	assert.False(t, c.updateReadyCondition(2))
+	assert.Equal(t, v1.IntegrationConditionStatefulSetProgressingReason, it.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+	assert.Equal(t, "1/3 updated replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+
+	statefulSet.Status.CurrentRevision = "it-2"
+	statefulSet.Status.UpdatedReplicas = 3
+	assert.False(t, c.updateReadyCondition(2))
+	assert.Equal(t, "2/3 ready replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+
+	assert.True(t, c.updateReadyCondition(3))
+	assert.Equal(t, corev1.ConditionTrue, it.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	assert.Equal(t, v1.IntegrationConditionStatefulSetReadyReason, it.Status.GetCondition(v1.IntegrationConditionReady).Reason)
+	assert.Equal(t, "3/3 ready replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+	assert.Equal(t, "StatefulSet/it", c.getControllerName())
+}
+
+func TestDeploymentReadyConditionWithCanary(t *testing.T) {
+	it := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Replicas: ptr.To(int32(4)),
+		},
+		Status: v1.IntegrationStatus{
+			Canary: &v1.IntegrationCanaryStatus{
+				Phase: v1.IntegrationCanaryPhaseProgressing,
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(3)),
+		},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas: 3,
+		},
+	}
+	c := &deploymentController{obj: deployment, integration: it}
+
+	// One of the replicas is replaced by a canary pod
+	assert.True(t, c.updateReadyCondition(3))
+	assert.Equal(t, "3/3 ready replicas", it.Status.GetCondition(v1.IntegrationConditionReady).Message)
+}
+
+func TestMonitorCanaryPods(t *testing.T) {
+	it := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-it",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+			Canary: &v1.IntegrationCanaryStatus{
+				Phase:    v1.IntegrationCanaryPhaseProgressing,
+				Replicas: 2,
+			},
+			Conditions: []v1.IntegrationCondition{
+				{
+					Type:   v1.IntegrationConditionReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+	stablePod := canaryTestPod("my-it-1", v1.IntegrationCanaryTrackStable, corev1.ConditionTrue)
+	readyPod := canaryTestPod("my-it-canary-1", v1.IntegrationCanaryTrackCanary, corev1.ConditionTrue)
+	crashingPod := canaryTestPod("my-it-canary-2", v1.IntegrationCanaryTrackCanary, corev1.ConditionFalse)
+	crashingPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "CrashLoopBackOff",
+					Message: "back-off restarting failed container",
+				},
+			},
+		},
+	}
+
+	stable, canary := splitCanaryPods([]corev1.Pod{stablePod, readyPod})
+	assert.Equal(t, []corev1.Pod{stablePod}, stable)
+	assert.Equal(t, []corev1.Pod{readyPod}, canary)
+
+	a := monitorAction{}
+	a.InjectLogger(log.Log)
+
+	require.NoError(t, a.monitorCanaryPods(context.TODO(), nil, it, nil, []corev1.Pod{readyPod}))
+	assert.Equal(t, v1.IntegrationCanaryPhaseProgressing, it.Status.Canary.Phase)
+	assert.Equal(t, int32(1), it.Status.Canary.ReadyReplicas)
+
+	// A failing canary does not affect the Integration
+	require.NoError(t, a.monitorCanaryPods(context.TODO(), nil, it, nil, []corev1.Pod{readyPod, crashingPod}))
+	assert.Equal(t, v1.IntegrationCanaryPhaseFailed, it.Status.Canary.Phase)
+	assert.Equal(t, "back-off restarting failed container", it.Status.Canary.Message)
+	assert.Equal(t, v1.IntegrationPhaseRunning, it.Status.Phase)
+	assert.Equal(t, corev1.ConditionTrue, it.Status.GetCondition(v1.IntegrationConditionReady).Status)
+	assert.True(t, it.IsCanaryInProgress())
+}
+
+func canaryTestPod(name string, track string, ready corev1.ConditionStatus) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+			Labels: map[string]string{
+				v1.IntegrationLabel:            "my-it",
+				v1.IntegrationCanaryTrackLabel: track,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodReady,
+					Status: ready,
+				},
+			},
+		},
+	}
+}
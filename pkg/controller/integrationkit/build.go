@@ -167,10 +167,15 @@ func (action *buildAction) createBuild(ctx context.Context, kit *v1.IntegrationK
 			Annotations: annotations,
 		},
 		Spec: v1.BuildSpec{
-			Tasks: env.Pipeline,
+			Tasks:       env.Pipeline,
+			RetryPolicy: env.BuildRetryPolicy,
 		},
 	}
 
+	if kit.Spec.Traits.Builder != nil && kit.Spec.Traits.Builder.Priority != nil {
+		build.Spec.Priority = *kit.Spec.Traits.Builder.Priority
+	}
+
 	timeout := env.Platform.BuildTimeout
 	if layout := labels[v1.IntegrationKitLayoutLabel]; timeout == platform.DefaultBuildTimeout && layout == v1.IntegrationKitLayoutNativeSources {
 		if timeout < minNativeBuildTimeout {
@@ -249,6 +254,8 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 				Checksum: a.Checksum,
 			})
 		}
+		kit.Status.Signature = build.Status.Signature
+		setVulnerabilityScanCondition(kit, build)
 
 		return kit, err
 	case v1.BuildPhaseError, v1.BuildPhaseInterrupted:
@@ -265,9 +272,18 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		// Let's copy the build failure to the integration kit status
 		kit.Status.Failure = build.Status.Failure
 		kit.Status.Phase = v1.IntegrationKitPhaseError
+		setVulnerabilityScanCondition(kit, build)
 
 		return kit, nil
 	}
 
 	return nil, nil
 }
+
+// setVulnerabilityScanCondition reports the outcome of the dependencies vulnerability scan, if any, to the integration kit.
+func setVulnerabilityScanCondition(kit *v1.IntegrationKit, build *v1.Build) {
+	kit.Status.Vulnerabilities = build.Status.Vulnerabilities
+	if c := build.Status.GetCondition(v1.BuildConditionVulnerabilityScan); c != nil {
+		kit.Status.SetCondition(v1.IntegrationKitConditionVulnerabilityScan, c.Status, c.Reason, c.Message)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/bindings"
@@ -28,18 +29,21 @@ import (
 
 const defaultCamelErrorHandler = "defaultErrorHandler"
 
-// maybeErrorHandler will return a Binding mapping a DeadLetterChannel, a Log or a None Error Handler.
-// If the bindings has no URI, then, you can assume it's a none Error Handler.
-func maybeErrorHandler(errHandlConf *v1.ErrorHandlerSpec, bindingContext bindings.BindingContext) (*bindings.Binding, error) {
+// javaClassNameRegexp matches a fully qualified Java class name.
+var javaClassNameRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+
+// maybeErrorHandler will return a Binding mapping a DeadLetterChannel, a Log or a None Error Handler, along with the parsed
+// Error Handler specification. If the bindings has no URI, then, you can assume it's a none Error Handler.
+func maybeErrorHandler(errHandlConf *v1.ErrorHandlerSpec, bindingContext bindings.BindingContext) (*bindings.Binding, v1.ErrorHandler, error) {
 	if errHandlConf == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	var errorHandlerBinding *bindings.Binding
 
 	errorHandlerSpec, err := parseErrorHandler(&errHandlConf.RawMessage)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse error handler: %w", err)
+		return nil, nil, fmt.Errorf("could not parse error handler: %w", err)
 	}
 	// We need to get the translated URI from any referenced resource (ie, kamelets)
 	if errorHandlerSpec.Type() == v1.ErrorHandlerTypeSink {
@@ -49,7 +53,7 @@ func maybeErrorHandler(errHandlConf *v1.ErrorHandlerSpec, bindingContext binding
 			*errorHandlerSpec.Endpoint(),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("could not determine error handler URI: %w", err)
+			return nil, nil, fmt.Errorf("could not determine error handler URI: %w", err)
 		}
 	} else {
 		// Create a new binding otherwise in order to store error handler application properties
@@ -63,10 +67,10 @@ func maybeErrorHandler(errHandlConf *v1.ErrorHandlerSpec, bindingContext binding
 
 	err = setErrorHandlerConfiguration(errorHandlerBinding, errorHandlerSpec)
 	if err != nil {
-		return nil, fmt.Errorf("could not set integration error handler: %w", err)
+		return nil, nil, fmt.Errorf("could not set integration error handler: %w", err)
 	}
 
-	return errorHandlerBinding, nil
+	return errorHandlerBinding, errorHandlerSpec, nil
 }
 
 func parseErrorHandler(rawMessage *v1.RawMessage) (v1.ErrorHandler, error) {
@@ -98,6 +102,9 @@ func parseErrorHandler(rawMessage *v1.RawMessage) (v1.ErrorHandler, error) {
 		if err = dst.Validate(); err != nil {
 			return nil, err
 		}
+		if err = validateRedeliveryPolicy(dst); err != nil {
+			return nil, err
+		}
 
 		return dst, nil
 	}
@@ -105,6 +112,50 @@ func parseErrorHandler(rawMessage *v1.RawMessage) (v1.ErrorHandler, error) {
 	return nil, errors.New("you must provide any supported error handler")
 }
 
+// errorHandlerLog returns the configuration shared by the log and sink error handlers, if any.
+func errorHandlerLog(errorHandler v1.ErrorHandler) *v1.ErrorHandlerLog {
+	switch eh := errorHandler.(type) {
+	case *v1.ErrorHandlerLog:
+		return eh
+	case *v1.ErrorHandlerSink:
+		return &eh.ErrorHandlerLog
+	default:
+		return nil
+	}
+}
+
+func validateRedeliveryPolicy(errorHandler v1.ErrorHandler) error {
+	log := errorHandlerLog(errorHandler)
+	if log == nil || log.RedeliveryPolicy == nil {
+		return nil
+	}
+	policy := log.RedeliveryPolicy
+
+	if policy.MaximumRedeliveries != nil && *policy.MaximumRedeliveries < -1 {
+		return fmt.Errorf("invalid redelivery policy: maximumRedeliveries must be greater than or equal to -1, was %d", *policy.MaximumRedeliveries)
+	}
+	if policy.RedeliveryDelay != nil && *policy.RedeliveryDelay < 0 {
+		return fmt.Errorf("invalid redelivery policy: redeliveryDelay must not be negative, was %d", *policy.RedeliveryDelay)
+	}
+	if policy.MaximumRedeliveryDelay != nil && *policy.MaximumRedeliveryDelay < 0 {
+		return fmt.Errorf("invalid redelivery policy: maximumRedeliveryDelay must not be negative, was %d", *policy.MaximumRedeliveryDelay)
+	}
+	if policy.RedeliveryDelay != nil && policy.MaximumRedeliveryDelay != nil && *policy.MaximumRedeliveryDelay < *policy.RedeliveryDelay {
+		return fmt.Errorf("invalid redelivery policy: maximumRedeliveryDelay (%d) must be greater than or equal to redeliveryDelay (%d)",
+			*policy.MaximumRedeliveryDelay, *policy.RedeliveryDelay)
+	}
+	if policy.BackOffMultiplier != nil && *policy.BackOffMultiplier < 1 {
+		return fmt.Errorf("invalid redelivery policy: backOffMultiplier must be greater than or equal to 1, was %v", *policy.BackOffMultiplier)
+	}
+	for _, exception := range policy.RetryOn {
+		if !javaClassNameRegexp.MatchString(exception) {
+			return fmt.Errorf("invalid redelivery policy: retryOn %q is not a fully qualified class name", exception)
+		}
+	}
+
+	return nil
+}
+
 func setErrorHandlerConfiguration(errorHandlerBinding *bindings.Binding, errorHandler v1.ErrorHandler) error {
 	properties, err := errorHandler.Configuration()
 	if err != nil {
@@ -121,27 +172,71 @@ func setErrorHandlerConfiguration(errorHandlerBinding *bindings.Binding, errorHa
 	return nil
 }
 
-// translateCamelErrorHandler will translate a binding as an error handler YAML as expected by Camel.
-func translateCamelErrorHandler(b *bindings.Binding) map[string]any {
-	yamlCode := map[string]any{}
+// translateCamelErrorHandler will translate a binding as an error handler YAML as expected by Camel. The redelivery policy
+// is set on the error handler, unless it is restricted to some exceptions, in which case an onException clause is added.
+func translateCamelErrorHandler(b *bindings.Binding, errorHandler v1.ErrorHandler) []map[string]any {
+	handlerConfig := map[string]any{}
+	var handler map[string]any
 	switch b.URI {
 	case "":
-		yamlCode["errorHandler"] = map[string]any{
-			"noErrorHandler": map[string]any{},
+		handler = map[string]any{
+			"noErrorHandler": handlerConfig,
 		}
 	case defaultCamelErrorHandler:
-		yamlCode["errorHandler"] = map[string]any{
-			"defaultErrorHandler": map[string]any{
-				"logName": "err",
-			},
+		handlerConfig["logName"] = "err"
+		handler = map[string]any{
+			"defaultErrorHandler": handlerConfig,
 		}
 	default:
-		yamlCode["errorHandler"] = map[string]any{
-			"deadLetterChannel": map[string]any{
-				"deadLetterUri": b.URI,
-			},
+		handlerConfig["deadLetterUri"] = b.URI
+		handler = map[string]any{
+			"deadLetterChannel": handlerConfig,
+		}
+	}
+	yamlCode := []map[string]any{
+		{"errorHandler": handler},
+	}
+
+	log := errorHandlerLog(errorHandler)
+	if log == nil {
+		return yamlCode
+	}
+	if log.UseOriginalMessage != nil {
+		handlerConfig["useOriginalMessage"] = *log.UseOriginalMessage
+	}
+	if policy := log.RedeliveryPolicy; policy != nil {
+		if len(policy.RetryOn) == 0 {
+			handlerConfig["redeliveryPolicy"] = translateCamelRedeliveryPolicy(policy)
+		} else {
+			onException := map[string]any{
+				"exception":        policy.RetryOn,
+				"redeliveryPolicy": translateCamelRedeliveryPolicy(policy),
+			}
+			if log.UseOriginalMessage != nil {
+				onException["useOriginalMessage"] = *log.UseOriginalMessage
+			}
+			yamlCode = append(yamlCode, map[string]any{"onException": onException})
 		}
 	}
 
 	return yamlCode
 }
+
+func translateCamelRedeliveryPolicy(policy *v1.ErrorHandlerRedeliveryPolicy) map[string]any {
+	redeliveryPolicy := map[string]any{}
+	if policy.MaximumRedeliveries != nil {
+		redeliveryPolicy["maximumRedeliveries"] = *policy.MaximumRedeliveries
+	}
+	if policy.RedeliveryDelay != nil {
+		redeliveryPolicy["redeliveryDelay"] = *policy.RedeliveryDelay
+	}
+	if policy.BackOffMultiplier != nil {
+		redeliveryPolicy["backOffMultiplier"] = *policy.BackOffMultiplier
+		redeliveryPolicy["useExponentialBackOff"] = *policy.BackOffMultiplier > 1
+	}
+	if policy.MaximumRedeliveryDelay != nil {
+		redeliveryPolicy["maximumRedeliveryDelay"] = *policy.MaximumRedeliveryDelay
+	}
+
+	return redeliveryPolicy
+}
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 )
 
 func TestParseErrorHandlerNoneDoesSucceed(t *testing.T) {
@@ -102,3 +103,63 @@ func TestParseErrorHandlerSinkFail(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, "missing endpoint in Error Handler Sink", err.Error())
 }
+
+func TestParseErrorHandlerSinkWithRedeliveryPolicyDoesSucceed(t *testing.T) {
+	cnt := v1.RawMessage([]byte(`{
+		"sink": {
+			"endpoint": {"uri": "someUri"},
+			"redeliveryPolicy": {
+				"maximumRedeliveries": 3,
+				"redeliveryDelay": 2000,
+				"backOffMultiplier": 2,
+				"maximumRedeliveryDelay": 30000,
+				"retryOn": ["java.io.IOException"]
+			},
+			"useOriginalMessage": true
+		}
+	}`))
+	sinkErrorHandler, err := parseErrorHandler(&cnt)
+	require.NoError(t, err)
+	log := errorHandlerLog(sinkErrorHandler)
+	require.NotNil(t, log)
+	assert.Equal(t, ptr.To(int32(3)), log.RedeliveryPolicy.MaximumRedeliveries)
+	assert.Equal(t, ptr.To(int64(2000)), log.RedeliveryPolicy.RedeliveryDelay)
+	assert.Equal(t, ptr.To(2.0), log.RedeliveryPolicy.BackOffMultiplier)
+	assert.Equal(t, ptr.To(int64(30000)), log.RedeliveryPolicy.MaximumRedeliveryDelay)
+	assert.Equal(t, []string{"java.io.IOException"}, log.RedeliveryPolicy.RetryOn)
+	assert.Equal(t, ptr.To(true), log.UseOriginalMessage)
+}
+
+func TestParseErrorHandlerRedeliveryPolicyFail(t *testing.T) {
+	tests := []struct {
+		policy string
+		err    string
+	}{
+		{
+			policy: `{"maximumRedeliveries": -2}`,
+			err:    "invalid redelivery policy: maximumRedeliveries must be greater than or equal to -1, was -2",
+		},
+		{
+			policy: `{"redeliveryDelay": -1}`,
+			err:    "invalid redelivery policy: redeliveryDelay must not be negative, was -1",
+		},
+		{
+			policy: `{"redeliveryDelay": 5000, "maximumRedeliveryDelay": 1000}`,
+			err:    "invalid redelivery policy: maximumRedeliveryDelay (1000) must be greater than or equal to redeliveryDelay (5000)",
+		},
+		{
+			policy: `{"backOffMultiplier": 0.5}`,
+			err:    "invalid redelivery policy: backOffMultiplier must be greater than or equal to 1, was 0.5",
+		},
+		{
+			policy: `{"retryOn": ["java.io.IOException", "not a class"]}`,
+			err:    `invalid redelivery policy: retryOn "not a class" is not a fully qualified class name`,
+		},
+	}
+	for _, test := range tests {
+		cnt := v1.RawMessage(`{"log": {"redeliveryPolicy": ` + test.policy + `}}`)
+		_, err := parseErrorHandler(&cnt)
+		require.Error(t, err)
+		assert.Equal(t, test.err, err.Error())
+	}
+}
@@ -106,11 +106,18 @@ func propagateIcon(ctx context.Context, c client.Client, l log.Logger, pipe *v1.
 }
 
 func findIcon(ctx context.Context, c client.Client, pipe *v1.Pipe) (string, error) {
+	// the icon of the source Kamelet, or else of the sink Kamelet, or else of the first Kamelet of the sinks
+	endpoints := []v1.Endpoint{pipe.Spec.Source, pipe.Spec.Sink}
+	for _, sink := range pipe.Spec.Sinks {
+		endpoints = append(endpoints, sink.Endpoint)
+	}
 	var kameletRef *corev1.ObjectReference
-	if pipe.Spec.Source.Ref != nil && pipe.Spec.Source.Ref.Kind == "Kamelet" && strings.HasPrefix(pipe.Spec.Source.Ref.APIVersion, "camel.apache.org/") {
-		kameletRef = pipe.Spec.Source.Ref
-	} else if pipe.Spec.Sink.Ref != nil && pipe.Spec.Sink.Ref.Kind == "Kamelet" && strings.HasPrefix(pipe.Spec.Sink.Ref.APIVersion, "camel.apache.org/") {
-		kameletRef = pipe.Spec.Sink.Ref
+	for _, e := range endpoints {
+		if e.Ref != nil && e.Ref.Kind == "Kamelet" && strings.HasPrefix(e.Ref.APIVersion, "camel.apache.org/") {
+			kameletRef = e.Ref
+
+			break
+		}
 	}
 
 	if kameletRef == nil {
@@ -262,14 +262,14 @@ func TestNewPipeUnsupportedRef(t *testing.T) {
 	handledPipe, err := a.Handle(context.TODO(), pipe)
 	require.Error(t, err)
 	assert.Equal(t, "could not find any suitable binding provider for my-api-version/my-kind my-kind-name in namespace ns. "+
-		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", err.Error())
+		"Bindings available: [\"kafka-ref\" \"kamelet\" \"knative-uri\" \"strimzi\" \"http-ref\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", err.Error())
 	assert.Equal(t, v1.PipePhaseError, handledPipe.Status.Phase)
 	cond := handledPipe.Status.GetCondition(v1.PipeConditionReady)
 	assert.NotNil(t, cond)
 	assert.Equal(t, corev1.ConditionFalse, cond.Status)
 	assert.Equal(t, "IntegrationError", cond.Reason)
 	assert.Equal(t, "could not find any suitable binding provider for my-api-version/my-kind my-kind-name in namespace ns. "+
-		"Bindings available: [\"kamelet\" \"knative-uri\" \"strimzi\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", cond.Message)
+		"Bindings available: [\"kafka-ref\" \"kamelet\" \"knative-uri\" \"strimzi\" \"http-ref\" \"service-ref\" \"camel-uri\" \"knative-ref\"]", cond.Message)
 }
 
 func TestNewPipeKnativeURIBinding(t *testing.T) {
@@ -481,3 +481,41 @@ func asEndpointProperties(props map[string]string) *v1.EndpointProperties {
 		RawMessage: serialized,
 	}
 }
+
+func TestFindIconSinks(t *testing.T) {
+	sink := v1.NewKamelet("ns", "my-sink")
+	sink.Annotations = map[string]string{
+		v1.AnnotationIcon: "my-sink-icon-base64",
+	}
+	pipe := &v1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-pipe",
+		},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				URI: ptr.To("timer:tick"),
+			},
+			Sinks: []v1.PipeSink{
+				{Endpoint: v1.Endpoint{URI: ptr.To("log:info")}},
+				{
+					Endpoint: v1.Endpoint{
+						Ref: &corev1.ObjectReference{
+							APIVersion: v1.SchemeGroupVersion.String(),
+							Kind:       v1.KameletKind,
+							Namespace:  "ns",
+							Name:       "my-sink",
+						},
+					},
+					Filter: "${header.important}",
+				},
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(&sink)
+	require.NoError(t, err)
+
+	icon, err := findIcon(context.TODO(), c, pipe)
+	require.NoError(t, err)
+	assert.Equal(t, "my-sink-icon-base64", icon)
+}
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/trait"
 
 	"github.com/apache/camel-k/v2/pkg/client"
@@ -123,12 +124,21 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 	if err != nil {
 		return nil, err
 	}
-	to, err := bindings.Translate(bindingContext, endpointTypeSinkContext, pipe.Spec.Sink)
+	if err := bindings.ValidateRouting(pipe); err != nil {
+		return nil, err
+	}
+	var to *bindings.Binding
+	var sinks []sinkBinding
+	if len(pipe.Spec.Sinks) > 0 {
+		sinks, err = translateSinks(bindingContext, pipe.Spec.Sinks)
+	} else {
+		to, err = bindings.Translate(bindingContext, endpointTypeSinkContext, pipe.Spec.Sink)
+	}
 	if err != nil {
 		return nil, err
 	}
 	// error handler is optional
-	errorHandler, err := maybeErrorHandler(pipe.Spec.ErrorHandler, bindingContext)
+	errorHandler, errorHandlerSpec, err := maybeErrorHandler(pipe.Spec.ErrorHandler, bindingContext)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +156,7 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		steps = append(steps, stepBinding)
 	}
 
-	if to.Step == nil && to.URI == "" {
+	if to != nil && to.Step == nil && to.URI == "" {
 		return nil, errors.New("illegal step definition for sink step: either Step or URI should be provided")
 	}
 	if from.URI == "" {
@@ -166,8 +176,16 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		return nil, err
 	}
 
-	if err := configureBinding(&it, to); err != nil {
-		return nil, err
+	if to != nil {
+		if err := configureBinding(&it, to); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, sink := range sinks {
+		if err := configureBinding(&it, sink.Binding); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := configureBinding(&it, errorHandler); err != nil {
@@ -196,12 +214,11 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 		dslSteps = append(dslSteps, step.AsYamlDSL())
 	}
 
-	if to.Step != nil {
-		dslSteps = append(dslSteps, to.AsYamlDSL())
+	if to != nil {
+		dslSteps = append(dslSteps, sinkSteps(to)...)
+	} else {
+		dslSteps = append(dslSteps, translateCamelRouting(pipe.Spec.Routing, sinks))
 	}
-	dslSteps = append(dslSteps, map[string]any{
-		"to": to.URI,
-	})
 
 	fromWrapper := map[string]any{
 		"uri":   from.URI,
@@ -216,12 +233,13 @@ func CreateIntegrationFor(ctx context.Context, c client.Client, pipe *v1.Pipe) (
 	}
 
 	if errorHandler != nil {
-		eh := translateCamelErrorHandler(errorHandler)
-		encodedErrorHandler, err := json.Marshal(eh)
-		if err != nil {
-			return nil, err
+		for _, eh := range translateCamelErrorHandler(errorHandler, errorHandlerSpec) {
+			encodedErrorHandler, err := json.Marshal(eh)
+			if err != nil {
+				return nil, err
+			}
+			it.Spec.Flows = append(it.Spec.Flows, v1.Flow{RawMessage: encodedErrorHandler})
 		}
-		it.Spec.Flows = append(it.Spec.Flows, v1.Flow{RawMessage: encodedErrorHandler})
 	}
 
 	encodedRoute, err := json.Marshal(flowRoute)
@@ -256,6 +274,13 @@ func configureBinding(integration *v1.Integration, bindings ...*bindings.Binding
 
 			integration.Spec.AddConfigurationProperty(entry)
 		}
+		if len(b.Configs) > 0 || len(b.Resources) > 0 {
+			if integration.Spec.Traits.Mount == nil {
+				integration.Spec.Traits.Mount = &traitv1.MountTrait{}
+			}
+			util.StringSliceUniqueConcat(&integration.Spec.Traits.Mount.Configs, b.Configs)
+			util.StringSliceUniqueConcat(&integration.Spec.Traits.Mount.Resources, b.Resources)
+		}
 	}
 
 	return nil
@@ -24,9 +24,11 @@ import (
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -151,6 +153,74 @@ func TestCreateIntegrationForPipeWithLogErrorHandler(t *testing.T) {
 	)
 }
 
+func TestCreateIntegrationForPipeWithErrorHandlerRedeliveryPolicy(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-error-handler-pipe")
+	pipe.Spec.ErrorHandler = &v1.ErrorHandlerSpec{
+		RawMessage: []byte(`{"sink": {"endpoint": {"uri": "someUri"}, "useOriginalMessage": true, ` +
+			`"redeliveryPolicy": {"maximumRedeliveries": 3, "redeliveryDelay": 2000, "backOffMultiplier": 2}}}`),
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`- errorHandler:
+    deadLetterChannel:
+      deadLetterUri: someUri
+      redeliveryPolicy:
+        backOffMultiplier: 2
+        maximumRedeliveries: 3
+        redeliveryDelay: 2000
+        useExponentialBackOff: true
+      useOriginalMessage: true
+- route:
+    from:
+      steps:
+      - to: kamelet:my-sink/sink
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl),
+	)
+}
+
+func TestCreateIntegrationForPipeWithErrorHandlerRetryOn(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-error-handler-pipe")
+	pipe.Spec.ErrorHandler = &v1.ErrorHandlerSpec{
+		RawMessage: []byte(`{"log": {"redeliveryPolicy": {"maximumRedeliveries": 5, ` +
+			`"retryOn": ["java.io.IOException", "java.net.ConnectException"]}}}`),
+	}
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`- errorHandler:
+    defaultErrorHandler:
+      logName: err
+- onException:
+    exception:
+    - java.io.IOException
+    - java.net.ConnectException
+    redeliveryPolicy:
+      maximumRedeliveries: 5
+- route:
+    from:
+      steps:
+      - to: kamelet:my-sink/sink
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl),
+	)
+}
+
 func TestCreateIntegrationForPipeWithNoneErrorHandler(t *testing.T) {
 	client, err := internal.NewFakeClient()
 	require.NoError(t, err)
@@ -219,6 +289,53 @@ func TestCreateIntegrationForPipeDataTypeOverridden(t *testing.T) {
 	assert.Equal(t, expectedNominalRouteWithDataType(newDataTypeKameletAction), string(dsl))
 }
 
+func TestCreateIntegrationForPipeWithHTTPRefSink(t *testing.T) {
+	svc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-api",
+			Namespace: "default",
+			Annotations: map[string]string{
+				bindings.HTTPAuthSecretAnnotation: "my-api-auth",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 8080}},
+		},
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-api-auth",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"token": []byte("my-token")},
+	}
+	client, err := internal.NewFakeClient(&svc, &secret)
+	require.NoError(t, err)
+
+	pipe := nominalPipe("my-pipe")
+	pipe.Spec.Traits = &v1.Traits{
+		Mount: &trait.MountTrait{
+			Configs: []string{"configmap:my-conf"},
+		},
+	}
+	pipe.Spec.Sink = v1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Kind:       "Service",
+			Name:       "my-api",
+			APIVersion: "v1",
+		},
+	}
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"configmap:my-conf",
+		"secret:my-api-auth/token@/etc/camel/conf.d/_secrets/_bindings/default/my-api-auth/camel.k.binding.http.default.my-api-auth.token",
+	}, it.Spec.Traits.Mount.Configs)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Contains(t, string(dsl), "to: http://my-api.default.svc.cluster.local:8080?authBearerToken=RAW({{camel.k.binding.http.default.my-api-auth.token}})")
+}
+
 func nominalPipe(name string) v1.Pipe {
 	pipe := v1.NewPipe("default", name)
 	pipe.Annotations = map[string]string{
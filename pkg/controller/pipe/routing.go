@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"fmt"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+)
+
+const defaultFilterLanguage = "simple"
+
+// sinkBinding is the Binding of one of the sinks of a Pipe routing the events to several destinations.
+type sinkBinding struct {
+	*bindings.Binding
+
+	filter   string
+	language string
+}
+
+// translateSinks translates the sinks of a Pipe routing the events to several destinations.
+func translateSinks(bindingContext bindings.BindingContext, sinks []v1.PipeSink) ([]sinkBinding, error) {
+	sinkBindings := make([]sinkBinding, 0, len(sinks))
+	for idx, sink := range sinks {
+		position := idx
+		b, err := bindings.Translate(bindingContext, bindings.EndpointContext{
+			Type:     v1.EndpointTypeSink,
+			Position: &position,
+		}, sink.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine URI for sink %d: %w", idx, err)
+		}
+		if b.Step == nil && b.URI == "" {
+			return nil, fmt.Errorf("illegal step definition for sink %d: either Step or URI should be provided", idx)
+		}
+		language := sink.FilterLanguage
+		if language == "" {
+			language = defaultFilterLanguage
+		}
+		sinkBindings = append(sinkBindings, sinkBinding{
+			Binding:  b,
+			filter:   sink.Filter,
+			language: language,
+		})
+	}
+
+	return sinkBindings, nil
+}
+
+// sinkSteps returns the YAML DSL steps sending the events to the sink.
+func sinkSteps(b *bindings.Binding) []map[string]any {
+	steps := make([]map[string]any, 0, 2)
+	if b.Step != nil {
+		steps = append(steps, b.AsYamlDSL())
+	}
+
+	return append(steps, map[string]any{
+		"to": b.URI,
+	})
+}
+
+// translateCamelRouting returns the YAML DSL step routing the events to the sinks: a multicast for the broadcast
+// routing, where each filtered sink is wrapped into a filter, or a choice for the content-based routing.
+func translateCamelRouting(routing v1.PipeRouting, sinks []sinkBinding) map[string]any {
+	if routing == v1.PipeRoutingContentBased {
+		whens := make([]map[string]any, 0, len(sinks))
+		choice := map[string]any{}
+		for _, sink := range sinks {
+			if sink.filter == "" {
+				choice["otherwise"] = map[string]any{
+					"steps": sinkSteps(sink.Binding),
+				}
+
+				continue
+			}
+			whens = append(whens, map[string]any{
+				sink.language: sink.filter,
+				"steps":       sinkSteps(sink.Binding),
+			})
+		}
+		choice["when"] = whens
+
+		return map[string]any{
+			"choice": choice,
+		}
+	}
+
+	branches := make([]map[string]any, 0, len(sinks))
+	for _, sink := range sinks {
+		steps := sinkSteps(sink.Binding)
+		switch {
+		case sink.filter != "":
+			branches = append(branches, map[string]any{
+				"filter": map[string]any{
+					sink.language: sink.filter,
+					"steps":       steps,
+				},
+			})
+		case len(steps) == 1:
+			branches = append(branches, steps[0])
+		default:
+			branches = append(branches, map[string]any{
+				"pipeline": map[string]any{
+					"steps": steps,
+				},
+			})
+		}
+	}
+
+	return map[string]any{
+		"multicast": map[string]any{
+			"steps": branches,
+		},
+	}
+}
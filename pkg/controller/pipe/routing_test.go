@@ -0,0 +1,177 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func routingPipe(routing v1.PipeRouting, sinks ...v1.PipeSink) v1.Pipe {
+	pipe := nominalPipe("my-routing-pipe")
+	pipe.Spec.Sink = v1.Endpoint{}
+	pipe.Spec.Routing = routing
+	pipe.Spec.Sinks = sinks
+
+	return pipe
+}
+
+func TestCreateIntegrationForPipeBroadcast(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := routingPipe("",
+		v1.PipeSink{
+			Endpoint: v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       "Kamelet",
+					Name:       "my-sink",
+					APIVersion: "camel.apache.org/v1",
+				},
+			},
+		},
+		v1.PipeSink{
+			Endpoint: v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       "Kamelet",
+					Name:       "my-other-sink",
+					APIVersion: "camel.apache.org/v1",
+				},
+				DataTypes: map[v1.TypeSlot]v1.DataTypeReference{
+					v1.TypeSlotIn: {Format: "string"},
+				},
+			},
+		},
+		v1.PipeSink{
+			Endpoint:       v1.Endpoint{URI: ptr.To("log:priority")},
+			Filter:         "$.priority == 'high'",
+			FilterLanguage: "jsonpath",
+		},
+	)
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`- route:
+    from:
+      steps:
+      - multicast:
+          steps:
+          - to: kamelet:my-sink/sink-0
+          - pipeline:
+              steps:
+              - kamelet:
+                  name: data-type-action/sink-1-in
+              - to: kamelet:my-other-sink/sink-1
+          - filter:
+              jsonpath: $.priority == 'high'
+              steps:
+              - to: log:priority
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl),
+	)
+}
+
+func TestCreateIntegrationForPipeContentBased(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := routingPipe(v1.PipeRoutingContentBased,
+		v1.PipeSink{
+			Endpoint: v1.Endpoint{URI: ptr.To("log:others")},
+		},
+		v1.PipeSink{
+			Endpoint: v1.Endpoint{URI: ptr.To("log:eu")},
+			Filter:   "${header.region} == 'eu'",
+		},
+		v1.PipeSink{
+			Endpoint: v1.Endpoint{URI: ptr.To("log:us")},
+			Filter:   "${header.region} == 'us'",
+		},
+	)
+
+	it, err := CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.NoError(t, err)
+	dsl, err := v1.ToYamlDSL(it.Spec.Flows)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`- route:
+    from:
+      steps:
+      - choice:
+          otherwise:
+            steps:
+            - to: log:others
+          when:
+          - simple: ${header.region} == 'eu'
+            steps:
+            - to: log:eu
+          - simple: ${header.region} == 'us'
+            steps:
+            - to: log:us
+      uri: kamelet:my-source/source
+    id: binding
+`, string(dsl),
+	)
+}
+
+func TestCreateIntegrationForPipeRoutingErrors(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	logSink := v1.PipeSink{Endpoint: v1.Endpoint{URI: ptr.To("log:info")}}
+
+	pipe := nominalPipe("my-routing-pipe")
+	pipe.Spec.Sinks = []v1.PipeSink{logSink}
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+	assert.Equal(t, "spec.sink: Forbidden: a Pipe cannot declare both a sink and sinks", err.Error())
+
+	pipe = nominalPipe("my-routing-pipe")
+	pipe.Spec.Routing = v1.PipeRoutingBroadcast
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+	assert.Equal(t, `spec.routing: Invalid value: "broadcast": requires sinks`, err.Error())
+
+	pipe = routingPipe(v1.PipeRoutingContentBased, logSink, logSink)
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+	assert.Equal(t, "spec.sinks: Invalid value: 2: content-based routing accepts at most one sink without filter", err.Error())
+
+	pipe = routingPipe(v1.PipeRoutingBroadcast, logSink, v1.PipeSink{Endpoint: logSink.Endpoint, FilterLanguage: "jq"})
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+	assert.Equal(t, `spec.sinks[1].filterLanguage: Invalid value: "jq": a filter language requires a filter`, err.Error())
+
+	pipe = routingPipe(v1.PipeRoutingBroadcast, logSink, v1.PipeSink{})
+	_, err = CreateIntegrationFor(context.TODO(), client, &pipe)
+	require.Error(t, err)
+	assert.Equal(t, "could not determine URI for sink 1: no ref or URI specified in endpoint", err.Error())
+}
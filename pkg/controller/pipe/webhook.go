@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client"
+	"github.com/apache/camel-k/v2/pkg/platform"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+)
+
+// AddWebhook registers the Pipe validating admission webhook to the Manager. The webhook checks the Kamelet
+// endpoints against the Kamelet definitions, so that errors are reported when the Pipe is applied.
+func AddWebhook(mgr manager.Manager, c client.Client) error {
+	return builder.WebhookManagedBy(mgr, &v1.Pipe{}).
+		WithValidator(&validator{lookup: bindings.NewKameletLookup(c, platform.GetOperatorNamespace())}).
+		Complete()
+}
+
+type validator struct {
+	lookup bindings.KameletLookup
+}
+
+var _ admission.Validator[*v1.Pipe] = &validator{}
+
+// ValidateCreate --.
+func (v *validator) ValidateCreate(ctx context.Context, pipe *v1.Pipe) (admission.Warnings, error) {
+	return v.validate(ctx, pipe)
+}
+
+// ValidateUpdate --.
+func (v *validator) ValidateUpdate(ctx context.Context, _, pipe *v1.Pipe) (admission.Warnings, error) {
+	return v.validate(ctx, pipe)
+}
+
+// ValidateDelete --.
+func (v *validator) ValidateDelete(_ context.Context, _ *v1.Pipe) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, pipe *v1.Pipe) (admission.Warnings, error) {
+	warnings, errs := bindings.ValidatePipe(ctx, pipe, v.lookup)
+	if len(errs) > 0 {
+		return warnings, k8serrors.NewInvalid(v1.SchemeGroupVersion.WithKind(v1.PipeKind).GroupKind(), pipe.Name, errs)
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+	"github.com/apache/camel-k/v2/pkg/util/bindings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePipeWebhook(t *testing.T) {
+	kamelet := &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-source", Namespace: "ns"},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Definition: &v1.JSONSchemaProps{
+					Required: []string{"message"},
+					Properties: map[string]v1.JSONSchemaProp{
+						"message": {Type: "string"},
+						"period":  {Type: "integer"},
+					},
+				},
+			},
+		},
+	}
+	c, err := internal.NewFakeClient(kamelet)
+	require.NoError(t, err)
+
+	pipe := &v1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-pipe"},
+		Spec: v1.PipeSpec{
+			Source: v1.Endpoint{
+				Ref: &corev1.ObjectReference{
+					Kind:       v1.KameletKind,
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Name:       "my-source",
+				},
+				Properties: &v1.EndpointProperties{RawMessage: v1.RawMessage(`{"period": "often"}`)},
+			},
+			Sink: v1.Endpoint{URI: ptr.To("log:info")},
+		},
+	}
+
+	v := &validator{lookup: bindings.NewKameletLookup(c)}
+	warnings, err := v.ValidateCreate(context.TODO(), pipe)
+	require.Error(t, err)
+	assert.True(t, k8serrors.IsInvalid(err))
+	assert.Equal(t, `Pipe.camel.apache.org "my-pipe" is invalid: spec.source.properties.period: Invalid value: "often": `+
+		`must be of type integer as defined by Kamelet "my-source"`, err.Error())
+	assert.Equal(t, admission.Warnings{`spec.source.properties.message: property required by Kamelet "my-source" is not set, ` +
+		`it must be provided by the Integration configuration`}, warnings)
+
+	pipe.Spec.Source.Properties = &v1.EndpointProperties{RawMessage: v1.RawMessage(`{"message": "hello", "period": 1000}`)}
+	warnings, err = v.ValidateUpdate(context.TODO(), pipe, pipe)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
@@ -38,6 +38,8 @@ const (
 	ReasonIntegrationPhaseUpdated = "IntegrationPhaseUpdated"
 	// ReasonIntegrationConditionChanged --.
 	ReasonIntegrationConditionChanged = "IntegrationConditionChanged"
+	// ReasonIntegrationRolledBack --.
+	ReasonIntegrationRolledBack = "IntegrationRolledBack"
 
 	// ReasonIntegrationKitPhaseUpdated --.
 	ReasonIntegrationKitPhaseUpdated = "IntegrationKitPhaseUpdated"
@@ -95,6 +97,11 @@ func NotifyIntegrationUpdated(ctx context.Context, c client.Client, recorder eve
 	}
 	notifyIfPhaseUpdated(ctx, c, recorder, newResource, oldPhase, string(newResource.Status.Phase),
 		"Integration", newResource.Name, ReasonIntegrationPhaseUpdated, "")
+	if newResource.IsRolledBack() && (old == nil || !old.IsRolledBack()) {
+		cond := newResource.Status.GetCondition(v1.IntegrationConditionRolledBack)
+		recorder.Eventf(newResource, nil, corev1.EventTypeWarning, ReasonIntegrationRolledBack, "RolledBack",
+			"Integration %s rolled back: %s", newResource.Name, cond.Message)
+	}
 }
 
 // NotifyIntegrationKitUpdated automatically generates events when an integration kit changes.
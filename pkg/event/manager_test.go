@@ -18,11 +18,14 @@ limitations under the License.
 package event
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 )
 
 // fakeRecorder implements events.EventRecorder and captures calls.
@@ -121,3 +124,34 @@ func TestNotifyError(t *testing.T) {
 		})
 	}
 }
+
+func TestNotifyIntegrationRolledBack(t *testing.T) {
+	old := v1.NewIntegration("ns", "my-it")
+	old.Status.Phase = v1.IntegrationPhaseError
+	it := old.DeepCopy()
+	it.Status.Phase = v1.IntegrationPhaseDeploying
+	it.Status.SetCondition(v1.IntegrationConditionRolledBack, corev1.ConditionTrue,
+		v1.IntegrationConditionAutoRollbackReason, "rolled back to integration kit ns/kit-1")
+
+	rec := &fakeRecorder{}
+	NotifyIntegrationUpdated(context.Background(), nil, rec, &old, it)
+
+	if rec.eventtype != corev1.EventTypeWarning {
+		t.Errorf("expected event type %s, got %s", corev1.EventTypeWarning, rec.eventtype)
+	}
+	if rec.reason != ReasonIntegrationRolledBack {
+		t.Errorf("unexpected reason: %s", rec.reason)
+	}
+	expectedMsg := "Integration my-it rolled back: rolled back to integration kit ns/kit-1"
+	if rec.message != expectedMsg {
+		t.Errorf("expected message %q, got %q", expectedMsg, rec.message)
+	}
+
+	// No event when the Integration was already rolled back
+	rec = &fakeRecorder{}
+	NotifyIntegrationUpdated(context.Background(), nil, rec, it, it.DeepCopy())
+
+	if rec.called {
+		t.Errorf("unexpected event: %s", rec.message)
+	}
+}
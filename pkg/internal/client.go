@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/camel-k/v2/pkg/apis"
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	vpav1 "github.com/apache/camel-k/v2/pkg/apis/duck/vpa/v1"
 	"github.com/apache/camel-k/v2/pkg/client"
 	fakecamelclientset "github.com/apache/camel-k/v2/pkg/client/camel/clientset/versioned/fake"
 	camelv1 "github.com/apache/camel-k/v2/pkg/client/camel/clientset/versioned/typed/camel/v1"
@@ -48,6 +49,7 @@ import (
 	controller "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // NewFakeClient ---.
@@ -79,7 +81,8 @@ func NewFakeClient(initObjs ...runtime.Object) (client.Client, error) {
 		return strings.Contains(gvk.Group, "camel")
 	})...)
 	clientset := fakeclientset.NewSimpleClientset(filterObjects(scheme, initObjs, func(gvk schema.GroupVersionKind) bool {
-		return !strings.Contains(gvk.Group, "camel") && !strings.Contains(gvk.Group, "knative")
+		return !strings.Contains(gvk.Group, "camel") && !strings.Contains(gvk.Group, "knative") &&
+			!strings.Contains(gvk.Group, "openshift") && gvk.Group != gwv1.GroupName && gvk.Group != vpav1.VPAGroup
 	})...)
 	replicasCount := make(map[string]int32)
 	fakescaleclient := fakescale.FakeScaleClient{}
@@ -262,10 +265,11 @@ type FakeSAR struct {
 	authorizationv1.SubjectAccessReviewInterface
 }
 
-// Create fake create implementation (needed in cross namespace Kamelets test). Only allow `cross-ns-sa` user in `default` namespace.
+// Create fake create implementation (needed in cross namespace Kamelets, Configmaps and Secrets tests). Only allow `cross-ns-sa` user in `default` namespace.
 func (f *FakeSAR) Create(ctx context.Context, sar *authv1.SubjectAccessReview, opts metav1.CreateOptions) (*authv1.SubjectAccessReview, error) {
 	ra := sar.Spec.ResourceAttributes
-	allowed := sar.Spec.User == "system:serviceaccount:default:cross-ns-sa" && ra.Verb == "get" && ra.Resource == "kamelets"
+	allowed := sar.Spec.User == "system:serviceaccount:default:cross-ns-sa" && ra.Verb == "get" &&
+		(ra.Resource == "kamelets" || ra.Resource == "configmaps" || ra.Resource == "secrets")
 
 	sar.Status.Allowed = allowed
 	sar.Status.Reason = "mocked"
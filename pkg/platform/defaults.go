@@ -238,6 +238,11 @@ func applyPlatformSpec(source *v1.IntegrationPlatform, target *v1.IntegrationPla
 		target.Status.Build.Maven.LocalRepository = source.Status.Build.Maven.LocalRepository
 	}
 
+	if target.Status.Build.Maven.Cache == nil && source.Status.Build.Maven.Cache != nil {
+		log.Debugf("Integration Platform %s [%s]: setting Maven cache", target.Name, target.Namespace)
+		target.Status.Build.Maven.Cache = source.Status.Build.Maven.Cache.DeepCopy()
+	}
+
 	if len(source.Status.Build.Maven.CLIOptions) > 0 && len(target.Status.Build.Maven.CLIOptions) == 0 {
 		log.Debugf("Integration Platform %s [%s]: setting CLI options", target.Name, target.Namespace)
 		target.Status.Build.Maven.CLIOptions = make([]string, len(source.Status.Build.Maven.CLIOptions))
@@ -286,6 +291,11 @@ func applyPlatformSpec(source *v1.IntegrationPlatform, target *v1.IntegrationPla
 		target.Status.Build.MaxRunningBuilds = source.Status.Build.MaxRunningBuilds
 	}
 
+	if target.Status.Build.Quota == nil && source.Status.Build.Quota != nil {
+		log.Debugf("Integration Platform %s [%s]: setting build quota", target.Name, target.Namespace)
+		target.Status.Build.Quota = source.Status.Build.Quota.DeepCopy()
+	}
+
 	if len(target.Status.Kamelet.Repositories) == 0 {
 		log.Debugf("Integration Platform %s [%s]: setting kamelet repositories", target.Name, target.Namespace)
 		target.Status.Kamelet.Repositories = source.Status.Kamelet.Repositories
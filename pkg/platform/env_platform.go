@@ -49,6 +49,7 @@ type Platform struct {
 	Registry            v1.RegistrySpec
 	Maven               v1.MavenBuildSpec
 	MaxRunningBuilds    int32
+	BuildQuota          *v1.BuildQuotaSpec
 }
 
 // getEnvPlatform is in charge to parse the environment variables of the operator and return the Platform object.
@@ -79,6 +80,7 @@ func getEnvPlatform() Platform {
 			Repositories: repositories(),
 		},
 		MaxRunningBuilds: maxRunningBuilds(),
+		BuildQuota:       buildQuota(),
 	}
 }
 
@@ -145,6 +147,23 @@ func maxRunningBuilds() int32 {
 	return DefaultMaxRunningBuildsPodStrategy
 }
 
+func buildQuota() *v1.BuildQuotaSpec {
+	maxRunningBuildsString := GetEnvOrDefault("MAX_RUNNING_BUILDS_PER_NAMESPACE", "")
+	if maxRunningBuildsString == "" {
+		return nil
+	}
+	val, err := strconv.ParseInt(maxRunningBuildsString, 10, 32)
+	if err != nil {
+		log.Error(err, "could not parse MAX_RUNNING_BUILDS_PER_NAMESPACE environment variable, no build quota is set")
+
+		return nil
+	}
+
+	return &v1.BuildQuotaSpec{
+		MaxRunningBuildsPerNamespace: int32(val),
+	}
+}
+
 func orderStrategy() v1.BuildOrderStrategy {
 	buildOrderStrategy := GetEnvOrDefault("BUILD_ORDER_STRATEGY", "")
 	if buildOrderStrategy != "" {
@@ -325,5 +344,6 @@ func FromIntegrationPlatform(itp *v1.IntegrationPlatform) Platform {
 			MavenSpec: itp.Status.Build.Maven,
 		},
 		MaxRunningBuilds: itp.Status.Build.MaxRunningBuilds,
+		BuildQuota:       itp.Status.Build.Quota,
 	}
 }
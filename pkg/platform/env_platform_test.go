@@ -36,6 +36,7 @@ func TestGetEnvPlatform_Defaults(t *testing.T) {
 	assert.Equal(t, DefaultBuildTimeout, pl.BuildTimeout)
 	assert.Empty(t, pl.Registry.Address)
 	assert.Equal(t, strings.Split(DefaultMavenCLIOptions, ","), pl.Maven.CLIOptions)
+	assert.Nil(t, pl.BuildQuota)
 }
 
 func TestGetEnvPlatform_WithEnv(t *testing.T) {
@@ -51,6 +52,7 @@ func TestGetEnvPlatform_WithEnv(t *testing.T) {
 	t.Setenv("MAVEN_CA_SECRETS", "secret1@key1,secret2@key2")
 	t.Setenv("MAVEN_SETTINGS", "configmap:my-settings@settings")
 	t.Setenv("MAVEN_SETTINGS_SECURITY", "secret:my-settings-sec@sec")
+	t.Setenv("MAX_RUNNING_BUILDS_PER_NAMESPACE", "2")
 
 	p := getEnvPlatform() // reinitialize to get the value from env vars
 
@@ -63,6 +65,8 @@ func TestGetEnvPlatform_WithEnv(t *testing.T) {
 	assert.True(t, p.Registry.Insecure)
 	assert.Equal(t, []string{"linux/amd64", "linux/arm64"}, p.BuildConfiguration.ImagePlatforms)
 	assert.Equal(t, []string{"opt1", "opt2"}, p.Maven.CLIOptions)
+	assert.NotNil(t, p.BuildQuota)
+	assert.Equal(t, int32(2), p.BuildQuota.MaxRunningBuildsPerNamespace)
 
 	// Check CA secrets
 	assert.Len(t, p.Maven.CASecrets, 2)
@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	autoRollbackTraitID    = "auto-rollback"
+	autoRollbackTraitOrder = 2470
+)
+
+type autoRollbackTrait struct {
+	BaseTrait
+	traitv1.AutoRollbackTrait `property:",squash"`
+}
+
+func newAutoRollbackTrait() Trait {
+	return &autoRollbackTrait{
+		BaseTrait: NewBaseTrait(autoRollbackTraitID, autoRollbackTraitOrder),
+	}
+}
+
+func (t *autoRollbackTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if ptr.Deref(t.FailureWindowSeconds, 0) < 0 {
+		return false, nil, fmt.Errorf("auto-rollback trait failure window must be positive: %d", *t.FailureWindowSeconds)
+	}
+
+	return true, nil, nil
+}
+
+// Apply records the current revision of the Integration as the last healthy one, once it is ready.
+// The rollback itself is performed by the Integration monitor, when the revision fails.
+func (t *autoRollbackTrait) Apply(e *Environment) error {
+	it := e.Integration
+	if !e.IntegrationInPhase(v1.IntegrationPhaseRunning) || it.Status.IntegrationKit == nil ||
+		it.IsRolledBack() || it.IsCanaryInProgress() {
+		return nil
+	}
+	ready := it.Status.GetCondition(v1.IntegrationConditionReady)
+	if ready == nil || ready.Status != corev1.ConditionTrue {
+		return nil
+	}
+
+	if last := it.Status.LastHealthyRevision; last != nil && last.Digest == it.Status.Digest &&
+		last.IntegrationKit != nil && *last.IntegrationKit == *it.Status.IntegrationKit && last.Image == it.Status.Image {
+		return nil
+	}
+	it.Status.LastHealthyRevision = &v1.IntegrationHealthyRevision{
+		Digest:         it.Status.Digest,
+		IntegrationKit: it.Status.IntegrationKit.DeepCopy(),
+		Image:          it.Status.Image,
+		Timestamp:      metav1.Now().Rfc3339Copy(),
+	}
+
+	return nil
+}
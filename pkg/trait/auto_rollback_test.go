@@ -0,0 +1,106 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+func TestConfigureAutoRollbackTraitDisabledByDefault(t *testing.T) {
+	trait, environment := createNominalAutoRollbackTest()
+	trait.Enabled = nil
+
+	configured, condition, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.False(t, configured)
+}
+
+func TestConfigureAutoRollbackTraitInvalidFailureWindow(t *testing.T) {
+	trait, environment := createNominalAutoRollbackTest()
+	trait.FailureWindowSeconds = ptr.To(int32(-1))
+
+	_, _, err := trait.Configure(environment)
+	require.Error(t, err)
+	assert.Equal(t, "auto-rollback trait failure window must be positive: -1", err.Error())
+}
+
+func TestAutoRollbackTraitRecordsHealthyRevision(t *testing.T) {
+	trait, environment := createNominalAutoRollbackTest()
+
+	configured, _, err := trait.Configure(environment)
+	require.NoError(t, err)
+	assert.True(t, configured)
+	require.NoError(t, trait.Apply(environment))
+
+	healthy := environment.Integration.Status.LastHealthyRevision
+	require.NotNil(t, healthy)
+	assert.Equal(t, "digest-2", healthy.Digest)
+	assert.Equal(t, "kit-2", healthy.IntegrationKit.Name)
+	assert.Equal(t, "my-image:2", healthy.Image)
+	assert.False(t, healthy.Timestamp.IsZero())
+}
+
+func TestAutoRollbackTraitDoesNotRecordFailingRevision(t *testing.T) {
+	trait, environment := createNominalAutoRollbackTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseError
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionFalse,
+		v1.IntegrationConditionErrorReason, "crashing")
+	previous := &v1.IntegrationHealthyRevision{
+		Digest:         "digest-1",
+		IntegrationKit: &corev1.ObjectReference{Namespace: "ns", Name: "kit-1"},
+		Image:          "my-image:1",
+	}
+	environment.Integration.Status.LastHealthyRevision = previous
+
+	require.NoError(t, trait.Apply(environment))
+	assert.Equal(t, previous, environment.Integration.Status.LastHealthyRevision)
+}
+
+func TestAutoRollbackTraitDoesNotRecordRolledBackRevision(t *testing.T) {
+	trait, environment := createNominalAutoRollbackTest()
+	environment.Integration.Status.SetCondition(v1.IntegrationConditionRolledBack, corev1.ConditionTrue,
+		v1.IntegrationConditionAutoRollbackReason, "rolled back")
+
+	require.NoError(t, trait.Apply(environment))
+	assert.Nil(t, environment.Integration.Status.LastHealthyRevision)
+}
+
+func createNominalAutoRollbackTest() (*autoRollbackTrait, *Environment) {
+	trait, _ := newAutoRollbackTrait().(*autoRollbackTrait)
+	trait.Enabled = ptr.To(true)
+
+	it := v1.NewIntegration("ns", "my-it")
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Digest = "digest-2"
+	it.Status.Image = "my-image:2"
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "ns", Name: "kit-2"}
+	it.Status.SetCondition(v1.IntegrationConditionReady, corev1.ConditionTrue,
+		v1.IntegrationConditionDeploymentReadyReason, "1/1 ready replicas")
+
+	return trait, &Environment{
+		Integration: &it,
+	}
+}
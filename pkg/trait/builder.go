@@ -25,10 +25,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
@@ -68,6 +72,26 @@ func (t *builderTrait) Matches(trait Trait) bool {
 	if t.BaseImage != otherTrait.BaseImage || len(t.Properties) != len(otherTrait.Properties) || len(t.Tasks) != len(otherTrait.Tasks) {
 		return false
 	}
+	if t.PublishStrategy != otherTrait.PublishStrategy ||
+		t.BuildpacksBuilderImage != otherTrait.BuildpacksBuilderImage ||
+		t.BuildpacksRunImage != otherTrait.BuildpacksRunImage {
+		return false
+	}
+	if ptr.Deref(t.SBOM, false) != ptr.Deref(otherTrait.SBOM, false) ||
+		t.SBOMFormat != otherTrait.SBOMFormat ||
+		ptr.Deref(t.SBOMPush, false) != ptr.Deref(otherTrait.SBOMPush, false) {
+		return false
+	}
+	if ptr.Deref(t.Sign, false) != ptr.Deref(otherTrait.Sign, false) ||
+		t.SignSecret != otherTrait.SignSecret ||
+		ptr.Deref(t.Provenance, false) != ptr.Deref(otherTrait.Provenance, false) {
+		return false
+	}
+	if t.VulnerabilityDatabaseConfigMap != otherTrait.VulnerabilityDatabaseConfigMap ||
+		t.VulnerabilityDatabasePVC != otherTrait.VulnerabilityDatabasePVC ||
+		t.VulnerabilityFailureThreshold != otherTrait.VulnerabilityFailureThreshold {
+		return false
+	}
 	// More sofisticated check if len is the same. Sort and compare via slices equal func.
 	// Although the Matches func is used as a support for comparison, it makes sense
 	// to copy the properties and avoid possible inconsistencies caused by the sorting operation.
@@ -105,6 +129,10 @@ func (t *builderTrait) Configure(e *Environment) (bool, *TraitCondition, error)
 				return false, condition, err
 			}
 		}
+		condition = t.configureForBuildpacks(e, condition)
+		condition = t.configureForMavenCache(e, condition)
+		condition = t.configureForSBOM(e, condition)
+		condition = t.configureForVulnerabilityScan(condition)
 
 		return true, condition, nil
 	}
@@ -154,6 +182,230 @@ func (t *builderTrait) configureForQuarkus(trait Trait, e *Environment, conditio
 	return condition, nil
 }
 
+func (t *builderTrait) configureForBuildpacks(e *Environment, condition *TraitCondition) *TraitCondition {
+	if t.publishStrategy(e) != v1.IntegrationPlatformBuildPublishStrategyBuildpacks {
+		return condition
+	}
+	// Buildpacks lifecycle runs in its own container, hence, it requires the build to run in a separate Pod
+	if t.Strategy != string(v1.BuildStrategyPod) {
+		m := "This is a Buildpacks publishing: setting build configuration with build Pod strategy."
+		t.L.Info(m)
+		condition = newOrAppend(condition, m)
+		t.Strategy = string(v1.BuildStrategyPod)
+	}
+
+	return condition
+}
+
+func (t *builderTrait) configureForMavenCache(e *Environment, condition *TraitCondition) *TraitCondition {
+	if !ptr.Deref(t.MavenCache, false) || t.buildStrategy(e) == v1.BuildStrategyPod {
+		return condition
+	}
+	// The shared Maven local repository is a volume mounted into the builder Pod
+	m := "The Maven cache is only available with build Pod strategy: the build won't use it."
+	t.L.Info(m)
+
+	return newOrAppend(condition, m)
+}
+
+func (t *builderTrait) configureForSBOM(e *Environment, condition *TraitCondition) *TraitCondition {
+	if !ptr.Deref(t.SBOMPush, false) || t.publishStrategy(e) == v1.IntegrationPlatformBuildPublishStrategyJib {
+		return condition
+	}
+	m := "The Software Bill of Materials can only be pushed with Jib publish strategy: it won't be pushed."
+	t.L.Info(m)
+
+	return newOrAppend(condition, m)
+}
+
+func (t *builderTrait) configureForVulnerabilityScan(condition *TraitCondition) *TraitCondition {
+	if t.VulnerabilityDatabasePVC == "" {
+		return condition
+	}
+	// The vulnerability database is a volume mounted into the builder Pod
+	if t.Strategy != string(v1.BuildStrategyPod) {
+		m := "The vulnerability database is stored in a PersistentVolumeClaim: setting build configuration with build Pod strategy."
+		t.L.Info(m)
+		condition = newOrAppend(condition, m)
+		t.Strategy = string(v1.BuildStrategyPod)
+	}
+
+	return condition
+}
+
+// sbom returns the Software Bill of Materials configuration, if required.
+func (t *builderTrait) sbom() (*v1.SBOMSpec, error) {
+	if !ptr.Deref(t.SBOM, false) {
+		return nil, nil
+	}
+	sbom := &v1.SBOMSpec{
+		Format: v1.SBOMFormatCycloneDX,
+	}
+	if t.SBOMFormat != "" {
+		sbom.Format = v1.SBOMFormat(t.SBOMFormat)
+		if err := sbom.Format.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sbom, nil
+}
+
+// signing returns the image signing configuration, if required.
+func (t *builderTrait) signing(e *Environment) (*v1.ImageSigningSpec, error) {
+	if !ptr.Deref(t.Sign, false) {
+		if ptr.Deref(t.Provenance, false) {
+			return nil, errors.New("the provenance attestation requires the image to be signed")
+		}
+
+		return nil, nil
+	}
+	if t.SignSecret == "" {
+		return nil, errors.New("the Secret holding the signing key must be provided")
+	}
+	if strategy := t.publishStrategy(e); strategy != v1.IntegrationPlatformBuildPublishStrategyJib {
+		return nil, fmt.Errorf("the image cannot be signed with %s publish strategy", strategy)
+	}
+
+	return &v1.ImageSigningSpec{
+		Secret:     t.SignSecret,
+		Provenance: ptr.Deref(t.Provenance, false),
+	}, nil
+}
+
+// vulnerabilityScan returns the dependencies vulnerability scan configuration, if required.
+func (t *builderTrait) vulnerabilityScan() (*v1.VulnerabilityScanSpec, error) {
+	if t.VulnerabilityDatabaseConfigMap == "" && t.VulnerabilityDatabasePVC == "" {
+		if t.VulnerabilityFailureThreshold != "" {
+			return nil, errors.New("the vulnerability failure threshold requires a vulnerability database")
+		}
+
+		return nil, nil
+	}
+	scan := &v1.VulnerabilityScanSpec{
+		ConfigMap:             t.VulnerabilityDatabaseConfigMap,
+		PersistentVolumeClaim: t.VulnerabilityDatabasePVC,
+		FailureThreshold:      v1.VulnerabilitySeverityHigh,
+	}
+	if t.VulnerabilityFailureThreshold != "" {
+		scan.FailureThreshold = v1.VulnerabilitySeverity(t.VulnerabilityFailureThreshold)
+		if err := scan.FailureThreshold.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return scan, nil
+}
+
+// buildStrategy returns the build strategy required by the trait, or the platform default.
+func (t *builderTrait) buildStrategy(e *Environment) v1.BuildStrategy {
+	if t.Strategy != "" {
+		return v1.BuildStrategy(t.Strategy)
+	}
+
+	return e.Platform.BuildConfiguration.Strategy
+}
+
+// mavenCache returns the shared Maven local repository configuration, merging the trait options
+// into the platform one. It returns nil when the Maven cache is disabled or not usable by the build strategy.
+func (t *builderTrait) mavenCache(e *Environment, strategy v1.BuildStrategy) (*v1.MavenCacheSpec, error) {
+	cache := v1.MavenCacheSpec{}
+	if e.Platform.Maven.Cache != nil {
+		cache = *e.Platform.Maven.Cache
+	}
+	if t.MavenCache != nil {
+		cache.Enabled = *t.MavenCache
+	}
+	if !cache.Enabled || strategy != v1.BuildStrategyPod {
+		return nil, nil
+	}
+
+	if t.MavenCachePVC != "" {
+		cache.PersistentVolumeClaim = t.MavenCachePVC
+	}
+	if t.MavenCacheSize != "" {
+		cache.Size = t.MavenCacheSize
+	}
+	if t.MavenCacheMaxSize != "" {
+		cache.MaxSize = t.MavenCacheMaxSize
+	}
+	if t.MavenCacheEvictionPolicy != "" {
+		cache.EvictionPolicy = v1.MavenCacheEvictionPolicy(t.MavenCacheEvictionPolicy)
+	}
+
+	if cache.PersistentVolumeClaim == "" {
+		cache.PersistentVolumeClaim = builder.MavenCacheDefaultPersistentVolumeClaim
+	}
+	if cache.AccessMode == "" {
+		cache.AccessMode = corev1.ReadWriteMany
+	}
+	if cache.Size == "" {
+		cache.Size = builder.MavenCacheDefaultSize
+	}
+	if cache.EvictionPolicy == "" {
+		cache.EvictionPolicy = v1.MavenCacheEvictionPolicyNone
+	}
+
+	if err := cache.EvictionPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := resource.ParseQuantity(cache.Size); err != nil {
+		return nil, fmt.Errorf("could not parse Maven cache size %s: %w", cache.Size, err)
+	}
+	if _, err := builder.MavenCacheMaxSize(&cache); err != nil {
+		return nil, err
+	}
+
+	return &cache, nil
+}
+
+// retryPolicy returns the policy used to retry the failed builds, or nil if the trait does not define any.
+func (t *builderTrait) retryPolicy() (*v1.BuildRetryPolicy, error) {
+	if t.RetryMaxAttempts == nil && t.RetryBackoff == "" && t.RetryMaxBackoff == "" && len(t.RetryOn) == 0 {
+		return nil, nil
+	}
+
+	policy := v1.BuildRetryPolicy{}
+	if t.RetryMaxAttempts != nil {
+		if *t.RetryMaxAttempts < 0 {
+			return nil, fmt.Errorf("invalid build retry max attempts: %d", *t.RetryMaxAttempts)
+		}
+		policy.MaxAttempts = ptr.To(*t.RetryMaxAttempts)
+	}
+	if t.RetryBackoff != "" {
+		d, err := time.ParseDuration(t.RetryBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse build retry backoff %s: %w", t.RetryBackoff, err)
+		}
+		policy.Backoff = &metav1.Duration{Duration: d}
+	}
+	if t.RetryMaxBackoff != "" {
+		d, err := time.ParseDuration(t.RetryMaxBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse build retry max backoff %s: %w", t.RetryMaxBackoff, err)
+		}
+		policy.MaxBackoff = &metav1.Duration{Duration: d}
+	}
+	for _, class := range t.RetryOn {
+		c := v1.BuildFailureClass(class)
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+		policy.RetryOn = append(policy.RetryOn, c)
+	}
+
+	return &policy, nil
+}
+
+// publishStrategy returns the publish strategy required by the trait, or the platform default.
+func (t *builderTrait) publishStrategy(e *Environment) v1.IntegrationPlatformBuildPublishStrategy {
+	if t.PublishStrategy != "" {
+		return v1.IntegrationPlatformBuildPublishStrategy(t.PublishStrategy)
+	}
+
+	return e.Platform.PublishStrategy
+}
+
 func existsTaskRequest(tasks []string, taskName string) bool {
 	for _, task := range tasks {
 		ts := strings.Split(task, ":")
@@ -263,9 +515,86 @@ func (t *builderTrait) Apply(e *Environment) error {
 	packageTask.Steps = make([]string, 0)
 	pipelineTasks = append(pipelineTasks, v1.Task{Package: packageTask})
 
+	// Software Bill of Materials
+	sbom, err := t.sbom()
+	if err != nil {
+		if err := failIntegrationKit(
+			e,
+			"IntegrationKitSBOMValid",
+			corev1.ConditionFalse,
+			"IntegrationKitSBOMValid",
+			err.Error(),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
+	if sbom != nil {
+		packageTask.SBOM = sbom
+		packageTask.Steps = append(packageTask.Steps, builder.StepIDsFor(builder.SBOM.GenerateSBOM)...)
+	}
+
+	// Dependencies vulnerability scan
+	scan, err := t.vulnerabilityScan()
+	if err != nil {
+		if err := failIntegrationKit(
+			e,
+			"IntegrationKitVulnerabilityScanValid",
+			corev1.ConditionFalse,
+			"IntegrationKitVulnerabilityScanValid",
+			err.Error(),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
+	if scan != nil {
+		packageTask.VulnerabilityScan = scan
+		packageTask.Steps = append(packageTask.Steps, builder.StepIDsFor(builder.Vulnerabilities.ScanDependencies)...)
+	}
+
+	// Image signing
+	signing, err := t.signing(e)
+	if err != nil {
+		if err := failIntegrationKit(
+			e,
+			"IntegrationKitSigningValid",
+			corev1.ConditionFalse,
+			"IntegrationKitSigningValid",
+			err.Error(),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
+	if signing != nil && signing.Provenance {
+		packageTask.Provenance = &v1.ProvenanceSpec{}
+		if e.IntegrationKit != nil {
+			packageTask.Provenance.IntegrationDigest = e.IntegrationKit.Annotations[v1.IntegrationDigestAnnotation]
+		}
+		packageTask.Steps = append(packageTask.Steps, builder.StepIDsFor(builder.Provenance.GenerateProvenance)...)
+	}
+
 	// Publishing task
 	tag := getTag(e)
-	switch e.Platform.PublishStrategy {
+	publishStrategy := t.publishStrategy(e)
+	if err := publishStrategy.Validate(); err != nil {
+		if err := failIntegrationKit(
+			e,
+			"IntegrationKitPublishStrategyValid",
+			corev1.ConditionFalse,
+			"IntegrationKitPublishStrategyValid",
+			err.Error(),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
+	switch publishStrategy {
 	case v1.IntegrationPlatformBuildPublishStrategyJib:
 		jibTask := v1.Task{Jib: &v1.JibTask{
 			BaseTask: v1.BaseTask{
@@ -281,6 +610,8 @@ func (t *builderTrait) Apply(e *Environment) error {
 		if t.ImagePlatforms != nil {
 			jibTask.Jib.Configuration.ImagePlatforms = t.ImagePlatforms
 		}
+		jibTask.Jib.PushSBOM = sbom != nil && ptr.Deref(t.SBOMPush, false)
+		jibTask.Jib.Signing = signing
 		pipelineTasks = append(pipelineTasks, jibTask)
 	//nolint:staticcheck
 	case v1.IntegrationPlatformBuildPublishStrategyS2I:
@@ -295,6 +626,21 @@ func (t *builderTrait) Apply(e *Environment) error {
 			},
 			Tag: tag,
 		}})
+	case v1.IntegrationPlatformBuildPublishStrategyBuildpacks:
+		// The Maven project is built by the Buildpacks lifecycle, which requires a project descriptor
+		packageTask.Steps = append(packageTask.Steps, builder.StepIDsFor(builder.Buildpacks.GenerateProjectDescriptor)...)
+		pipelineTasks = append(pipelineTasks, v1.Task{Buildpacks: &v1.BuildpacksTask{
+			BaseTask: v1.BaseTask{
+				Name:          "buildpacks",
+				Configuration: *taskConfOrDefault(tasksConf, "buildpacks"),
+			},
+			PublishTask: v1.PublishTask{
+				Image:    imageName,
+				Registry: e.Platform.Registry,
+			},
+			BuilderImage: t.getBuildpacksBuilderImage(),
+			RunImage:     t.BuildpacksRunImage,
+		}})
 	}
 
 	// filter only those tasks required by the user
@@ -314,8 +660,23 @@ func (t *builderTrait) Apply(e *Environment) error {
 			return err
 		}
 	}
+	retryPolicy, err := t.retryPolicy()
+	if err != nil {
+		if err := failIntegrationKit(
+			e,
+			"IntegrationKitRetryPolicyValid",
+			corev1.ConditionFalse,
+			"IntegrationKitRetryPolicyValid",
+			err.Error(),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
 	// add local pipeline tasks to env pipeline
 	e.Pipeline = append(e.Pipeline, pipelineTasks...)
+	e.BuildRetryPolicy = retryPolicy
 
 	return nil
 }
@@ -416,6 +777,16 @@ func (t *builderTrait) builderTask(e *Environment, taskConf *v1.BuildConfigurati
 		task.Git = e.Integration.Spec.Git
 	}
 
+	strategy := taskConf.Strategy
+	if strategy == "" {
+		strategy = e.Platform.BuildConfiguration.Strategy
+	}
+	cache, err := t.mavenCache(e, strategy)
+	if err != nil {
+		return nil, err
+	}
+	task.Maven.Cache = cache
+
 	if task.Maven.Properties == nil {
 		task.Maven.Properties = make(map[string]string)
 	}
@@ -455,8 +826,11 @@ func (t *builderTrait) builderTask(e *Environment, taskConf *v1.BuildConfigurati
 		task.Maven.Profiles = mavenProfiles
 	}
 
-	steps := make([]builder.Step, 0, len(builder.Project.CommonSteps))
+	steps := make([]builder.Step, 0, len(builder.Project.CommonSteps)+1)
 	steps = append(steps, builder.Project.CommonSteps...)
+	if cache != nil && cache.EvictionPolicy != v1.MavenCacheEvictionPolicyNone {
+		steps = append(steps, builder.MavenCache.EvictLocalRepository)
+	}
 
 	// sort steps by phase
 	sort.SliceStable(steps, func(i, j int) bool {
@@ -501,6 +875,14 @@ func (t *builderTrait) getBaseImage(e *Environment) string {
 	return baseImage
 }
 
+func (t *builderTrait) getBuildpacksBuilderImage() string {
+	if t.BuildpacksBuilderImage != "" {
+		return t.BuildpacksBuilderImage
+	}
+
+	return builder.BuildpacksDefaultBuilderImage
+}
+
 func (t *builderTrait) determineCustomTasks(e *Environment, builderTask *v1.BuilderTask, tasksConf map[string]*v1.BuildConfiguration) ([]v1.Task, error) {
 	imageName := getImageName(e)
 
@@ -668,6 +1050,9 @@ func filter(tasks []v1.Task, filterTasks []string) ([]v1.Task, error) {
 			case t.Jib != nil && t.Jib.Name == f:
 				filteredTasks = append(filteredTasks, t)
 				found = true
+			case t.Buildpacks != nil && t.Buildpacks.Name == f:
+				filteredTasks = append(filteredTasks, t)
+				found = true
 			}
 		}
 
@@ -691,6 +1076,8 @@ func publishingOrUserTask(t v1.Task) bool {
 		return true
 	case t.Jib != nil:
 		return true
+	case t.Buildpacks != nil:
+		return true
 	}
 
 	return false
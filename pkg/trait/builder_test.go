@@ -20,15 +20,18 @@ package trait
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/builder"
 	"github.com/apache/camel-k/v2/pkg/internal"
 	"github.com/apache/camel-k/v2/pkg/platform"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
@@ -642,3 +645,419 @@ func TestBuilderTraitOrderStrategy(t *testing.T) {
 
 	assert.Equal(t, v1.BuildOrderStrategyFIFO, env.Pipeline[0].Builder.Configuration.OrderStrategy)
 }
+
+func TestBuildpacksBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyPod)
+	env.Platform.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategyBuildpacks
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.BuildpacksRunImage = "my-run-image"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Len(t, env.Pipeline, 3)
+	assert.NotNil(t, env.Pipeline[0].Builder)
+	assert.NotNil(t, env.Pipeline[1].Package)
+	assert.Contains(t, env.Pipeline[1].Package.Steps, builder.Buildpacks.GenerateProjectDescriptor.ID())
+	assert.NotNil(t, env.Pipeline[2].Buildpacks)
+	assert.Equal(t, "buildpacks", env.Pipeline[2].Buildpacks.Name)
+	assert.Equal(t, builder.BuildpacksDefaultBuilderImage, env.Pipeline[2].Buildpacks.BuilderImage)
+	assert.Equal(t, "my-run-image", env.Pipeline[2].Buildpacks.RunImage)
+	assert.Contains(t, env.Pipeline[2].Buildpacks.Image, "camel-k-my-kit")
+	assert.Equal(t, "registry", env.Pipeline[2].Buildpacks.Registry.Address)
+}
+
+func TestBuildpacksBuilderTraitPublishStrategyOverride(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.PublishStrategy = string(v1.IntegrationPlatformBuildPublishStrategyBuildpacks)
+	builderTrait.BuildpacksBuilderImage = "my-builder-image"
+
+	condition := builderTrait.configureForBuildpacks(env, nil)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "Buildpacks publishing")
+	assert.Equal(t, string(v1.BuildStrategyPod), builderTrait.Strategy)
+
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Len(t, env.Pipeline, 3)
+	assert.Equal(t, v1.BuildStrategyPod, env.Pipeline[0].Builder.Configuration.Strategy)
+	assert.NotNil(t, env.Pipeline[2].Buildpacks)
+	assert.Equal(t, "my-builder-image", env.Pipeline[2].Buildpacks.BuilderImage)
+}
+
+func TestBuildpacksBuilderTraitNotConfiguredWithJib(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+
+	condition := builderTrait.configureForBuildpacks(env, nil)
+	assert.Nil(t, condition)
+	assert.Empty(t, builderTrait.Strategy)
+}
+
+func TestS2IBuilderTraitPublishStrategyOverride(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.PublishStrategy = string(v1.IntegrationPlatformBuildPublishStrategyS2I)
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Len(t, env.Pipeline, 3)
+	assert.NotNil(t, env.Pipeline[2].S2i)
+	assert.Equal(t, "s2i", env.Pipeline[2].S2i.Name)
+}
+
+func TestBuilderTraitInvalidPublishStrategy(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.PublishStrategy = "Docker"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Empty(t, env.Pipeline)
+	assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+	assert.Equal(t, corev1.ConditionFalse, env.IntegrationKit.Status.GetCondition("IntegrationKitPublishStrategyValid").Status)
+}
+
+func TestBuilderMatchesPublishStrategy(t *testing.T) {
+	t1 := builderTrait{
+		BasePlatformTrait: NewBasePlatformTrait("builder", 600),
+		BuilderTrait: traitv1.BuilderTrait{
+			PublishStrategy: "Buildpacks",
+		},
+	}
+	t2 := builderTrait{
+		BasePlatformTrait: NewBasePlatformTrait("builder", 600),
+		BuilderTrait: traitv1.BuilderTrait{
+			PublishStrategy: "Buildpacks",
+		},
+	}
+	t3 := builderTrait{
+		BasePlatformTrait: NewBasePlatformTrait("builder", 600),
+		BuilderTrait: traitv1.BuilderTrait{
+			PublishStrategy:        "Buildpacks",
+			BuildpacksBuilderImage: "my-builder-image",
+		},
+	}
+	assert.True(t, t1.Matches(&t2))
+	assert.False(t, t1.Matches(&t3))
+}
+
+func TestBuilderTraitMavenCacheFromPlatform(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyPod)
+	env.Platform.Maven.Cache = &v1.MavenCacheSpec{
+		Enabled:          true,
+		StorageClassName: "my-storage-class",
+		EvictionPolicy:   v1.MavenCacheEvictionPolicyOldestFirst,
+	}
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenCacheSize = "20Gi"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	cache := env.Pipeline[0].Builder.Maven.Cache
+	require.NotNil(t, cache)
+	assert.Equal(t, builder.MavenCacheDefaultPersistentVolumeClaim, cache.PersistentVolumeClaim)
+	assert.Equal(t, "my-storage-class", cache.StorageClassName)
+	assert.Equal(t, corev1.ReadWriteMany, cache.AccessMode)
+	assert.Equal(t, "20Gi", cache.Size)
+	assert.Equal(t, v1.MavenCacheEvictionPolicyOldestFirst, cache.EvictionPolicy)
+	assert.Contains(t, env.Pipeline[0].Builder.Steps, builder.MavenCache.EvictLocalRepository.ID())
+	// the package task shares the same Maven configuration
+	assert.Equal(t, cache, env.Pipeline[1].Package.Maven.Cache)
+	assert.NotContains(t, env.Pipeline[1].Package.Steps, builder.MavenCache.EvictLocalRepository.ID())
+	// the platform configuration is left untouched
+	assert.Empty(t, env.Platform.Maven.Cache.Size)
+}
+
+func TestBuilderTraitMavenCacheFromTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyPod)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenCache = ptr.To(true)
+	builderTrait.MavenCachePVC = "my-pvc"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	cache := env.Pipeline[0].Builder.Maven.Cache
+	require.NotNil(t, cache)
+	assert.Equal(t, "my-pvc", cache.PersistentVolumeClaim)
+	assert.Equal(t, builder.MavenCacheDefaultSize, cache.Size)
+	assert.Equal(t, v1.MavenCacheEvictionPolicyNone, cache.EvictionPolicy)
+	assert.NotContains(t, env.Pipeline[0].Builder.Steps, builder.MavenCache.EvictLocalRepository.ID())
+}
+
+func TestBuilderTraitMavenCacheDisabled(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyPod)
+	env.Platform.Maven.Cache = &v1.MavenCacheSpec{Enabled: true}
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenCache = ptr.To(false)
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Nil(t, env.Pipeline[0].Builder.Maven.Cache)
+}
+
+func TestBuilderTraitMavenCacheRoutineStrategy(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyRoutine)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenCache = ptr.To(true)
+
+	condition := builderTrait.configureForMavenCache(env, nil)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "Maven cache is only available with build Pod strategy")
+
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+	assert.Nil(t, env.Pipeline[0].Builder.Maven.Cache)
+}
+
+func TestBuilderTraitMavenCacheInvalidEvictionPolicy(t *testing.T) {
+	env := createBuilderTestEnv(v1.BuildStrategyPod)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.MavenCache = ptr.To(true)
+	builderTrait.MavenCacheEvictionPolicy = "Random"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Empty(t, env.Pipeline)
+	assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+	assert.Contains(t, env.IntegrationKit.Status.GetCondition("IntegrationKitPropertiesFormatValid").Message, "MavenCacheEvictionPolicy")
+}
+
+func TestBuilderTraitRetryPolicy(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+	assert.Nil(t, env.BuildRetryPolicy)
+
+	env = createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait = createNominalBuilderTraitTest()
+	builderTrait.RetryMaxAttempts = ptr.To(int32(3))
+	builderTrait.RetryBackoff = "10s"
+	builderTrait.RetryMaxBackoff = "2m"
+	builderTrait.RetryOn = []string{"Network", "Dependency"}
+	err = builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	policy := env.BuildRetryPolicy
+	require.NotNil(t, policy)
+	assert.Equal(t, ptr.To(int32(3)), policy.MaxAttempts)
+	assert.Equal(t, 10*time.Second, policy.Backoff.Duration)
+	assert.Equal(t, 2*time.Minute, policy.MaxBackoff.Duration)
+	assert.Equal(t, []v1.BuildFailureClass{v1.BuildFailureClassNetwork, v1.BuildFailureClassDependency}, policy.RetryOn)
+}
+
+func TestBuilderTraitInvalidRetryPolicy(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.RetryOn = []string{"Network", "Timeout"}
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Empty(t, env.Pipeline)
+	assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+	assert.Contains(t, env.IntegrationKit.Status.GetCondition("IntegrationKitRetryPolicyValid").Message, "BuildFailureClass")
+}
+
+func TestBuilderTraitSBOM(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.SBOM = ptr.To(true)
+	builderTrait.SBOMFormat = "SPDX"
+	builderTrait.SBOMPush = ptr.To(true)
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Equal(t, &v1.SBOMSpec{Format: v1.SBOMFormatSPDX}, packageTask.SBOM)
+	assert.Contains(t, packageTask.Steps, builder.SBOM.GenerateSBOM.ID())
+	assert.Nil(t, env.Pipeline[0].Builder.SBOM)
+	require.NotNil(t, env.Pipeline[2].Jib)
+	assert.True(t, env.Pipeline[2].Jib.PushSBOM)
+}
+
+func TestBuilderTraitSBOMDisabled(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.SBOMPush = ptr.To(true)
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Nil(t, packageTask.SBOM)
+	assert.NotContains(t, packageTask.Steps, builder.SBOM.GenerateSBOM.ID())
+	assert.False(t, env.Pipeline[2].Jib.PushSBOM)
+}
+
+func TestBuilderTraitInvalidSBOMFormat(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.SBOM = ptr.To(true)
+	builderTrait.SBOMFormat = "SWID"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	assert.Empty(t, env.Pipeline)
+	assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+	assert.Contains(t, env.IntegrationKit.Status.GetCondition("IntegrationKitSBOMValid").Message, "SBOMFormat")
+}
+
+func TestBuilderTraitSigning(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	env.IntegrationKit.Annotations = map[string]string{v1.IntegrationDigestAnnotation: "my-digest"}
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.Sign = ptr.To(true)
+	builderTrait.SignSecret = "cosign"
+	builderTrait.Provenance = ptr.To(true)
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Equal(t, &v1.ProvenanceSpec{IntegrationDigest: "my-digest"}, packageTask.Provenance)
+	assert.Contains(t, packageTask.Steps, builder.Provenance.GenerateProvenance.ID())
+	require.NotNil(t, env.Pipeline[2].Jib)
+	assert.Equal(t, &v1.ImageSigningSpec{Secret: "cosign", Provenance: true}, env.Pipeline[2].Jib.Signing)
+}
+
+func TestBuilderTraitSigningWithoutProvenance(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.Sign = ptr.To(true)
+	builderTrait.SignSecret = "cosign"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Nil(t, packageTask.Provenance)
+	assert.NotContains(t, packageTask.Steps, builder.Provenance.GenerateProvenance.ID())
+	assert.Equal(t, &v1.ImageSigningSpec{Secret: "cosign"}, env.Pipeline[2].Jib.Signing)
+}
+
+func TestBuilderTraitInvalidSigning(t *testing.T) {
+	tests := []struct {
+		name    string
+		trait   func(*builderTrait)
+		message string
+	}{
+		{
+			name: "no secret",
+			trait: func(t *builderTrait) {
+				t.Sign = ptr.To(true)
+			},
+			message: "the Secret holding the signing key must be provided",
+		},
+		{
+			name: "provenance without signing",
+			trait: func(t *builderTrait) {
+				t.Provenance = ptr.To(true)
+			},
+			message: "the provenance attestation requires the image to be signed",
+		},
+		{
+			name: "buildpacks",
+			trait: func(t *builderTrait) {
+				t.Sign = ptr.To(true)
+				t.SignSecret = "cosign"
+				t.PublishStrategy = string(v1.IntegrationPlatformBuildPublishStrategyBuildpacks)
+			},
+			message: "the image cannot be signed with Buildpacks publish strategy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+			builderTrait := createNominalBuilderTraitTest()
+			test.trait(builderTrait)
+			err := builderTrait.Apply(env)
+			require.NoError(t, err)
+
+			assert.Empty(t, env.Pipeline)
+			assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+			assert.Equal(t, test.message, env.IntegrationKit.Status.GetCondition("IntegrationKitSigningValid").Message)
+		})
+	}
+}
+
+func TestBuilderTraitVulnerabilityScan(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.VulnerabilityDatabaseConfigMap = "osv"
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Equal(t, &v1.VulnerabilityScanSpec{
+		ConfigMap:        "osv",
+		FailureThreshold: v1.VulnerabilitySeverityHigh,
+	}, packageTask.VulnerabilityScan)
+	assert.Contains(t, packageTask.Steps, builder.Vulnerabilities.ScanDependencies.ID())
+	assert.Nil(t, env.Pipeline[0].Builder.VulnerabilityScan)
+}
+
+func TestBuilderTraitVulnerabilityScanPVC(t *testing.T) {
+	env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.VulnerabilityDatabasePVC = "osv"
+	builderTrait.VulnerabilityFailureThreshold = "Critical"
+
+	condition := builderTrait.configureForVulnerabilityScan(nil)
+	require.NotNil(t, condition)
+	assert.Contains(t, condition.message, "build Pod strategy")
+	assert.Equal(t, string(v1.BuildStrategyPod), builderTrait.Strategy)
+
+	err := builderTrait.Apply(env)
+	require.NoError(t, err)
+
+	packageTask := getPackageTask(env.Pipeline)
+	require.NotNil(t, packageTask)
+	assert.Equal(t, v1.BuildStrategyPod, env.Pipeline[0].Builder.Configuration.Strategy)
+	assert.Equal(t, &v1.VulnerabilityScanSpec{
+		PersistentVolumeClaim: "osv",
+		FailureThreshold:      v1.VulnerabilitySeverityCritical,
+	}, packageTask.VulnerabilityScan)
+}
+
+func TestBuilderTraitInvalidVulnerabilityScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		trait   func(t *builderTrait)
+		message string
+	}{
+		{
+			name: "no database",
+			trait: func(t *builderTrait) {
+				t.VulnerabilityFailureThreshold = "Low"
+			},
+			message: "the vulnerability failure threshold requires a vulnerability database",
+		},
+		{
+			name: "invalid threshold",
+			trait: func(t *builderTrait) {
+				t.VulnerabilityDatabaseConfigMap = "osv"
+				t.VulnerabilityFailureThreshold = "Moderate"
+			},
+			message: `invalid VulnerabilitySeverity: "Moderate"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := createBuilderTestEnv(platform.DefaultBuildStrategy)
+			builderTrait := createNominalBuilderTraitTest()
+			test.trait(builderTrait)
+			err := builderTrait.Apply(env)
+			require.NoError(t, err)
+
+			assert.Empty(t, env.Pipeline)
+			assert.Equal(t, v1.IntegrationKitPhaseError, env.IntegrationKit.Status.Phase)
+			assert.Equal(t, test.message, env.IntegrationKit.Status.GetCondition("IntegrationKitVulnerabilityScanValid").Message)
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
 	"github.com/apache/camel-k/v2/pkg/util/defaults"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/property"
 )
@@ -248,6 +249,10 @@ func (t *camelTrait) computeUserProperties(e *Environment) []ctrl.Object {
 		var userPropertiesSb245 strings.Builder
 		for _, prop := range t.Properties {
 			k, v := property.SplitPropertyFileEntry(prop)
+			if externalsecret.IsReference(v) {
+				// The properties referencing an external secret are resolved by the mount trait
+				continue
+			}
 			userPropertiesSb245.WriteString(fmt.Sprintf("%s=%s\n", k, v))
 		}
 		userProperties += userPropertiesSb245.String()
@@ -0,0 +1,311 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	canaryTraitID    = "canary"
+	canaryTraitOrder = 2460
+
+	defaultCanaryStepInterval = int32(60)
+)
+
+var defaultCanarySteps = []int32{10, 50}
+
+type canaryTrait struct {
+	BaseTrait
+	traitv1.CanaryTrait `property:",squash"`
+}
+
+func newCanaryTrait() Trait {
+	return &canaryTrait{
+		BaseTrait: NewBaseTrait(canaryTraitID, canaryTraitOrder),
+	}
+}
+
+func (t *canaryTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	steps := t.getSteps()
+	for i, step := range steps {
+		if step < 1 || step > 99 || (i > 0 && step <= steps[i-1]) {
+			return false, nil, fmt.Errorf("canary trait steps must be increasing percentages between 1 and 99: %v", steps)
+		}
+	}
+	if t.getStepInterval() < 0 {
+		return false, nil, fmt.Errorf("canary trait step interval must be positive: %d", t.getStepInterval())
+	}
+	switch t.TrafficRouting {
+	case "", traitv1.CanaryTrafficRoutingService, traitv1.CanaryTrafficRoutingGateway:
+	default:
+		return false, nil, fmt.Errorf("unsupported canary traffic routing: %s", t.TrafficRouting)
+	}
+
+	return true, nil, nil
+}
+
+func (t *canaryTrait) Apply(e *Environment) error {
+	// The canary only applies to Deployment based Integrations
+	deployment := e.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Name == e.Integration.Name
+	})
+	if deployment == nil {
+		return nil
+	}
+
+	// Track the pods of the Deployment, and the Integration revision they run
+	revision := e.Integration.Status.Digest
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = make(map[string]string)
+	}
+	deployment.Spec.Template.Labels[v1.IntegrationCanaryTrackLabel] = v1.IntegrationCanaryTrackStable
+	// The template annotations may be shared with the Deployment ones
+	deployment.Spec.Template.Annotations = maps.Clone(deployment.Spec.Template.Annotations)
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = make(map[string]string)
+	}
+	deployment.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation] = revision
+
+	stable := appsv1.Deployment{}
+	if err := t.Client.Get(e.Ctx, ctrl.ObjectKeyFromObject(deployment), &stable); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// This is the first revision of the Integration
+			return nil
+		}
+
+		return err
+	}
+	if stable.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation] == revision {
+		// The stable pods already run the current revision
+		return nil
+	}
+	if stable.Spec.Template.Labels[v1.IntegrationCanaryTrackLabel] != v1.IntegrationCanaryTrackStable {
+		// The stable pods are not tracked yet, the Deployment is updated with a rolling update
+		return nil
+	}
+
+	canary := e.Integration.Status.Canary
+	if canary == nil || canary.Revision != revision {
+		canary = &v1.IntegrationCanaryStatus{
+			Phase:              v1.IntegrationCanaryPhaseProgressing,
+			Revision:           revision,
+			StableImage:        integrationContainerImage(e, &stable.Spec.Template.Spec),
+			CanaryImage:        integrationContainerImage(e, &deployment.Spec.Template.Spec),
+			Weight:             t.getSteps()[0],
+			LastTransitionTime: metav1.Now(),
+		}
+		e.Integration.Status.Canary = canary
+	}
+
+	switch canary.Phase {
+	case v1.IntegrationCanaryPhasePromoted:
+		// The Deployment is updated with the canary revision
+		return nil
+	case v1.IntegrationCanaryPhaseRolledBack:
+		t.keepStable(deployment, &stable)
+
+		return nil
+	case v1.IntegrationCanaryPhaseFailed:
+		if ptr.Deref(t.AutoRollback, true) {
+			canary.Phase = v1.IntegrationCanaryPhaseRolledBack
+			canary.LastTransitionTime = metav1.Now()
+			canary.Message = fmt.Sprintf("canary rolled back at %d%% of the traffic: %s", canary.Weight, canary.Message)
+			t.keepStable(deployment, &stable)
+			t.deleteCanaryResources(e)
+
+			return nil
+		}
+	case v1.IntegrationCanaryPhaseProgressing:
+		interval := time.Duration(t.getStepInterval()) * time.Second
+		if canary.Replicas > 0 && canary.ReadyReplicas >= canary.Replicas && time.Since(canary.LastTransitionTime.Time) >= interval {
+			steps := t.getSteps()
+			canary.Step++
+			canary.LastTransitionTime = metav1.Now()
+			if int(canary.Step) >= len(steps) {
+				canary.Phase = v1.IntegrationCanaryPhasePromoted
+				canary.Weight = 100
+				canary.Message = "canary promoted"
+				t.deleteCanaryResources(e)
+
+				return nil
+			}
+			canary.Weight = steps[canary.Step]
+		}
+		canary.Message = fmt.Sprintf("%d%% of the traffic routed to the canary", canary.Weight)
+	}
+
+	return t.deployCanary(e, deployment, &stable, canary)
+}
+
+// deployCanary runs the current revision in the canary pods, while the stable pods keep running the previous one.
+func (t *canaryTrait) deployCanary(e *Environment, deployment *appsv1.Deployment, stable *appsv1.Deployment, canary *v1.IntegrationCanaryStatus) error {
+	replicas := ptr.Deref(e.Integration.Spec.Replicas, 1)
+	canaryReplicas, stableReplicas := t.getReplicas(replicas, canary.Weight)
+
+	canaryDeployment := deployment.DeepCopy()
+	canaryDeployment.Name = canaryName(e.Integration)
+	canaryDeployment.Labels[v1.IntegrationCanaryTrackLabel] = v1.IntegrationCanaryTrackCanary
+	canaryDeployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1.IntegrationLabel:            e.Integration.Name,
+			v1.IntegrationCanaryTrackLabel: v1.IntegrationCanaryTrackCanary,
+		},
+	}
+	canaryDeployment.Spec.Template.Labels[v1.IntegrationCanaryTrackLabel] = v1.IntegrationCanaryTrackCanary
+	canaryDeployment.Spec.Replicas = &canaryReplicas
+	e.Resources.Add(canaryDeployment)
+	canary.Replicas = canaryReplicas
+
+	t.keepStable(deployment, stable)
+	// The replicas may be managed by the autoscaler
+	if deployment.Spec.Replicas != nil {
+		deployment.Spec.Replicas = &stableReplicas
+	}
+
+	if t.TrafficRouting == traitv1.CanaryTrafficRoutingGateway {
+		return t.routeCanaryTraffic(e, canary.Weight)
+	}
+
+	return nil
+}
+
+// routeCanaryTraffic splits the traffic of the HTTPRoute generated by the Gateway trait between the stable
+// and the canary pods.
+func (t *canaryTrait) routeCanaryTraffic(e *Environment, weight int32) error {
+	service := e.Resources.GetUserServiceForIntegration(e.Integration)
+	route := e.Resources.GetHTTPRoute(func(r *gwv1.HTTPRoute) bool {
+		return r.Name == e.Integration.Name
+	})
+	if service == nil || route == nil {
+		return errors.New("canary trait gateway traffic routing requires the gateway trait to expose the Integration")
+	}
+
+	canaryService := service.DeepCopy()
+	canaryService.Name = canaryName(e.Integration)
+	// Not labelled as the Integration Service, so that it's not mistaken for it
+	canaryService.Labels = map[string]string{
+		"camel.apache.org/service.type": v1.ServiceTypeCanary,
+	}
+	canaryService.Spec.Selector = maps.Clone(service.Spec.Selector)
+	canaryService.Spec.Selector[v1.IntegrationCanaryTrackLabel] = v1.IntegrationCanaryTrackCanary
+	e.Resources.Add(canaryService)
+
+	service.Spec.Selector = maps.Clone(service.Spec.Selector)
+	service.Spec.Selector[v1.IntegrationCanaryTrackLabel] = v1.IntegrationCanaryTrackStable
+
+	for i := range route.Spec.Rules {
+		rule := &route.Spec.Rules[i]
+		for j := range rule.BackendRefs {
+			backendRef := &rule.BackendRefs[j]
+			if string(backendRef.Name) != service.Name {
+				continue
+			}
+			backendRef.Weight = ptr.To(100 - weight)
+			canaryRef := backendRef.DeepCopy()
+			canaryRef.Name = gwv1.ObjectName(canaryService.Name)
+			canaryRef.Weight = ptr.To(weight)
+			rule.BackendRefs = append(rule.BackendRefs, *canaryRef)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// keepStable keeps the stable pods running the previous revision.
+func (t *canaryTrait) keepStable(deployment *appsv1.Deployment, stable *appsv1.Deployment) {
+	deployment.Spec.Template = *stable.Spec.Template.DeepCopy()
+}
+
+// deleteCanaryResources registers a post action that deletes the canary resources, once the rollout is complete.
+func (t *canaryTrait) deleteCanaryResources(e *Environment) {
+	e.PostActions = append(e.PostActions, func(env *Environment) error {
+		resources := []ctrl.Object{
+			&appsv1.Deployment{},
+			&corev1.Service{},
+		}
+		for _, resource := range resources {
+			resource.SetNamespace(env.Integration.Namespace)
+			resource.SetName(canaryName(env.Integration))
+			if err := t.Client.Delete(env.Ctx, resource, ctrl.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// getReplicas returns the number of canary and stable pods, so that the given weight of the traffic is routed
+// to the canary pods. The weight is approximated by the number of pods behind the Integration Service,
+// when the traffic is not routed by the Gateway.
+func (t *canaryTrait) getReplicas(replicas int32, weight int32) (int32, int32) {
+	if t.TrafficRouting == traitv1.CanaryTrafficRoutingGateway {
+		return max(1, (replicas*weight+99)/100), replicas
+	}
+	canaryReplicas := max(1, (replicas*weight+50)/100)
+
+	return canaryReplicas, max(1, replicas-canaryReplicas)
+}
+
+func (t *canaryTrait) getSteps() []int32 {
+	if len(t.Steps) > 0 {
+		return slices.Clone(t.Steps)
+	}
+
+	return defaultCanarySteps
+}
+
+func (t *canaryTrait) getStepInterval() int32 {
+	return ptr.Deref(t.StepIntervalSeconds, defaultCanaryStepInterval)
+}
+
+func canaryName(it *v1.Integration) string {
+	return it.Name + "-canary"
+}
+
+func integrationContainerImage(e *Environment, spec *corev1.PodSpec) string {
+	for _, container := range spec.Containers {
+		if container.Name == e.GetIntegrationContainerName() {
+			return container.Image
+		}
+	}
+
+	return ""
+}
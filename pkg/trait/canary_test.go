@@ -0,0 +1,405 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestCanaryFirstRevision(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(canaryTraitID))
+
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, v1.IntegrationCanaryTrackStable, deployment.Spec.Template.Labels[v1.IntegrationCanaryTrackLabel])
+	assert.Equal(t, "new-digest", deployment.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation])
+	assert.Empty(t, deployment.Annotations[v1.IntegrationCanaryRevisionAnnotation])
+	assert.Nil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+	assert.Nil(t, environment.Integration.Status.Canary)
+}
+
+func TestCanaryRollingUpdateOfUntrackedStable(t *testing.T) {
+	stable := stableDeployment("old-digest")
+	delete(stable.Spec.Template.Labels, v1.IntegrationCanaryTrackLabel)
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, stable)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "my-image:2", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Nil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+	assert.Nil(t, environment.Integration.Status.Canary)
+}
+
+func TestCanaryStart(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, stableDeployment("old-digest"))
+	environment.Integration.Spec.Replicas = ptr.To(int32(4))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	// The stable pods keep running the previous revision
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "my-image:1", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "old-digest", deployment.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation])
+	assert.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+
+	canaryDeployment := getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary")
+	require.NotNil(t, canaryDeployment)
+	assert.Equal(t, "my-image:2", canaryDeployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "new-digest", canaryDeployment.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation])
+	assert.Equal(t, v1.IntegrationCanaryTrackCanary, canaryDeployment.Spec.Template.Labels[v1.IntegrationCanaryTrackLabel])
+	assert.Equal(t, map[string]string{
+		v1.IntegrationLabel:            ServiceTestName,
+		v1.IntegrationCanaryTrackLabel: v1.IntegrationCanaryTrackCanary,
+	}, canaryDeployment.Spec.Selector.MatchLabels)
+	assert.Equal(t, ptr.To(int32(1)), canaryDeployment.Spec.Replicas)
+	assert.Equal(t, v1.IntegrationCanaryTrackCanary, canaryDeployment.Labels[v1.IntegrationCanaryTrackLabel])
+	assert.Len(t, canaryDeployment.OwnerReferences, 1)
+
+	canary := environment.Integration.Status.Canary
+	require.NotNil(t, canary)
+	assert.Equal(t, v1.IntegrationCanaryPhaseProgressing, canary.Phase)
+	assert.Equal(t, "new-digest", canary.Revision)
+	assert.Equal(t, "my-image:1", canary.StableImage)
+	assert.Equal(t, "my-image:2", canary.CanaryImage)
+	assert.Equal(t, int32(0), canary.Step)
+	assert.Equal(t, int32(10), canary.Weight)
+	assert.Equal(t, int32(1), canary.Replicas)
+	assert.True(t, environment.Integration.IsCanaryInProgress())
+}
+
+func TestCanaryNextStep(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait:               traitv1.Trait{Enabled: ptr.To(true)},
+		Steps:               []int32{20, 50, 80},
+		StepIntervalSeconds: ptr.To(int32(30)),
+	}, stableDeployment("old-digest"))
+	environment.Integration.Spec.Replicas = ptr.To(int32(10))
+	environment.Integration.Status.Canary = progressingCanary(0, 20, 2, 2, time.Minute)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	canary := environment.Integration.Status.Canary
+	assert.Equal(t, v1.IntegrationCanaryPhaseProgressing, canary.Phase)
+	assert.Equal(t, int32(1), canary.Step)
+	assert.Equal(t, int32(50), canary.Weight)
+	assert.Equal(t, int32(5), canary.Replicas)
+
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(5)), deployment.Spec.Replicas)
+	canaryDeployment := getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary")
+	require.NotNil(t, canaryDeployment)
+	assert.Equal(t, ptr.To(int32(5)), canaryDeployment.Spec.Replicas)
+}
+
+func TestCanaryStepNotCompleted(t *testing.T) {
+	tests := []struct {
+		name   string
+		canary *v1.IntegrationCanaryStatus
+	}{
+		{
+			name:   "canary pods not ready",
+			canary: progressingCanary(0, 10, 2, 1, time.Hour),
+		},
+		{
+			name:   "step interval not elapsed",
+			canary: progressingCanary(0, 10, 1, 1, time.Second),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			environment := canaryEnv(t, &traitv1.CanaryTrait{
+				Trait: traitv1.Trait{Enabled: ptr.To(true)},
+			}, stableDeployment("old-digest"))
+			environment.Integration.Status.Canary = test.canary
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.NoError(t, err)
+
+			canary := environment.Integration.Status.Canary
+			assert.Equal(t, v1.IntegrationCanaryPhaseProgressing, canary.Phase)
+			assert.Equal(t, int32(0), canary.Step)
+			assert.Equal(t, int32(10), canary.Weight)
+			assert.NotNil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+		})
+	}
+}
+
+func TestCanaryPromotion(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, append(canaryResources(), stableDeployment("old-digest"))...)
+	environment.Integration.Status.Canary = progressingCanary(1, 50, 1, 1, time.Hour)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	canary := environment.Integration.Status.Canary
+	assert.Equal(t, v1.IntegrationCanaryPhasePromoted, canary.Phase)
+	assert.Equal(t, int32(100), canary.Weight)
+	assert.False(t, environment.Integration.IsCanaryInProgress())
+
+	// The Deployment is updated with the canary revision
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "my-image:2", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "new-digest", deployment.Spec.Template.Annotations[v1.IntegrationCanaryRevisionAnnotation])
+	assert.Nil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+	assertCanaryResourcesDeleted(t, &environment)
+}
+
+func TestCanaryRollback(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, append(canaryResources(), stableDeployment("old-digest"))...)
+	canary := progressingCanary(0, 10, 1, 0, time.Hour)
+	canary.Phase = v1.IntegrationCanaryPhaseFailed
+	canary.Message = "back-off restarting failed container"
+	environment.Integration.Status.Canary = canary
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	canary = environment.Integration.Status.Canary
+	assert.Equal(t, v1.IntegrationCanaryPhaseRolledBack, canary.Phase)
+	assert.Equal(t, "canary rolled back at 10% of the traffic: back-off restarting failed container", canary.Message)
+
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "my-image:1", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, ptr.To(int32(1)), deployment.Spec.Replicas)
+	assert.Nil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+	assertCanaryResourcesDeleted(t, &environment)
+
+	// The rolled back revision is not deployed again
+	environment = canaryEnv(t, &traitv1.CanaryTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, stableDeployment("old-digest"))
+	environment.Integration.Status.Canary = canary
+
+	_, _, err = environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.Equal(t, v1.IntegrationCanaryPhaseRolledBack, environment.Integration.Status.Canary.Phase)
+	deployment = getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, "my-image:1", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Nil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+}
+
+func TestCanaryFailedWithoutAutoRollback(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait:        traitv1.Trait{Enabled: ptr.To(true)},
+		AutoRollback: ptr.To(false),
+	}, stableDeployment("old-digest"))
+	canary := progressingCanary(0, 10, 1, 0, time.Hour)
+	canary.Phase = v1.IntegrationCanaryPhaseFailed
+	environment.Integration.Status.Canary = canary
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	// The rollout is paused
+	assert.Equal(t, v1.IntegrationCanaryPhaseFailed, environment.Integration.Status.Canary.Phase)
+	assert.Equal(t, int32(10), environment.Integration.Status.Canary.Weight)
+	assert.NotNil(t, getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary"))
+}
+
+func TestCanaryGatewayTrafficRouting(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait:          traitv1.Trait{Enabled: ptr.To(true)},
+		TrafficRouting: traitv1.CanaryTrafficRoutingGateway,
+	}, stableDeployment("old-digest"))
+	environment.Integration.Spec.Replicas = ptr.To(int32(2))
+	environment.Integration.Spec.Traits.Gateway = &traitv1.GatewayTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	// The stable pods are not scaled down, the traffic is weighted by the HTTPRoute
+	deployment := getCanaryTestDeployment(environment.Resources, ServiceTestName)
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(2)), deployment.Spec.Replicas)
+	canaryDeployment := getCanaryTestDeployment(environment.Resources, ServiceTestName+"-canary")
+	require.NotNil(t, canaryDeployment)
+	assert.Equal(t, ptr.To(int32(1)), canaryDeployment.Spec.Replicas)
+
+	service := environment.Resources.GetUserServiceForIntegration(environment.Integration)
+	require.NotNil(t, service)
+	assert.Equal(t, v1.IntegrationCanaryTrackStable, service.Spec.Selector[v1.IntegrationCanaryTrackLabel])
+	canaryService := environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == ServiceTestName+"-canary"
+	})
+	require.NotNil(t, canaryService)
+	assert.Equal(t, map[string]string{
+		v1.IntegrationLabel:            ServiceTestName,
+		v1.IntegrationCanaryTrackLabel: v1.IntegrationCanaryTrackCanary,
+	}, canaryService.Spec.Selector)
+	assert.Equal(t, v1.ServiceTypeCanary, canaryService.Labels["camel.apache.org/service.type"])
+
+	route := environment.Resources.GetHTTPRoute(func(r *gwv1.HTTPRoute) bool { return true })
+	require.NotNil(t, route)
+	require.Len(t, route.Spec.Rules, 1)
+	backendRefs := route.Spec.Rules[0].BackendRefs
+	require.Len(t, backendRefs, 2)
+	assert.Equal(t, gwv1.ObjectName(ServiceTestName), backendRefs[0].Name)
+	assert.Equal(t, ptr.To(int32(90)), backendRefs[0].Weight)
+	assert.Equal(t, gwv1.ObjectName(ServiceTestName+"-canary"), backendRefs[1].Name)
+	assert.Equal(t, ptr.To(int32(10)), backendRefs[1].Weight)
+	assert.Equal(t, backendRefs[0].Port, backendRefs[1].Port)
+}
+
+func TestCanaryGatewayTrafficRoutingWithoutGateway(t *testing.T) {
+	environment := canaryEnv(t, &traitv1.CanaryTrait{
+		Trait:          traitv1.Trait{Enabled: ptr.To(true)},
+		TrafficRouting: traitv1.CanaryTrafficRoutingGateway,
+	}, stableDeployment("old-digest"))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "canary trait gateway traffic routing requires the gateway trait to expose the Integration")
+}
+
+func TestCanaryInvalidSteps(t *testing.T) {
+	for _, steps := range [][]int32{{0, 50}, {50, 100}, {50, 20}, {10, 10}} {
+		environment := canaryEnv(t, &traitv1.CanaryTrait{
+			Trait: traitv1.Trait{Enabled: ptr.To(true)},
+			Steps: steps,
+		})
+
+		_, _, err := environment.Catalog.apply(&environment)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "canary trait steps must be increasing percentages between 1 and 99")
+	}
+}
+
+func assertCanaryResourcesDeleted(t *testing.T, environment *Environment) {
+	t.Helper()
+
+	// The canary resources are deleted by the last post action
+	require.NotEmpty(t, environment.PostActions)
+	require.NoError(t, environment.PostActions[len(environment.PostActions)-1](environment))
+
+	key := ctrl.ObjectKey{Namespace: "ns", Name: ServiceTestName + "-canary"}
+	assert.True(t, k8serrors.IsNotFound(environment.Client.Get(environment.Ctx, key, &appsv1.Deployment{})))
+	assert.True(t, k8serrors.IsNotFound(environment.Client.Get(environment.Ctx, key, &corev1.Service{})))
+}
+
+func canaryResources() []runtime.Object {
+	return []runtime.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: ServiceTestName + "-canary", Namespace: "ns"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: ServiceTestName + "-canary", Namespace: "ns"}},
+	}
+}
+
+func progressingCanary(step int32, weight int32, replicas int32, readyReplicas int32, age time.Duration) *v1.IntegrationCanaryStatus {
+	return &v1.IntegrationCanaryStatus{
+		Phase:              v1.IntegrationCanaryPhaseProgressing,
+		Revision:           "new-digest",
+		StableImage:        "my-image:1",
+		CanaryImage:        "my-image:2",
+		Step:               step,
+		Weight:             weight,
+		Replicas:           replicas,
+		ReadyReplicas:      readyReplicas,
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-age)),
+	}
+}
+
+func stableDeployment(revision string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: "ns",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel:            ServiceTestName,
+						v1.IntegrationCanaryTrackLabel: v1.IntegrationCanaryTrackStable,
+					},
+					Annotations: map[string]string{
+						v1.IntegrationCanaryRevisionAnnotation: revision,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  defaultContainerName,
+							Image: "my-image:1",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCanaryTestDeployment(resources *kubernetes.Collection, name string) *appsv1.Deployment {
+	return resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == name })
+}
+
+func canaryEnv(t *testing.T, canary *traitv1.CanaryTrait, objects ...runtime.Object) Environment {
+	t.Helper()
+
+	environment := newRouteTestEnv(t, `from("netty-http:test").log("hello");`, v1.Traits{Canary: canary}, objects...)
+	environment.Integration.TypeMeta = metav1.TypeMeta{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       v1.IntegrationKind,
+	}
+	environment.Integration.Status.Digest = "new-digest"
+	environment.Integration.Status.Image = "my-image:2"
+
+	return environment
+}
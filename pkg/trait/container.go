@@ -173,6 +173,18 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	}); err != nil {
 		return err
 	}
+	// StatefulSet
+	if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		for _, envVar := range e.EnvVars {
+			envvar.SetVar(&container.Env, envVar)
+		}
+		containers = &statefulSet.Spec.Template.Spec.Containers
+		visited = true
+
+		return nil
+	}); err != nil {
+		return err
+	}
 	// Knative Service
 	if err := e.Resources.VisitKnativeServiceE(func(service *serving.Service) error {
 		for _, env := range e.EnvVars {
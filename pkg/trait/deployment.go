@@ -190,6 +190,13 @@ func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 		}
 	}
 
+	// The replicas are left to the autoscaler, when enabled
+	if e.GetTrait(hpaTraitID) != nil {
+		deployment.Spec.Replicas = nil
+
+		return &deployment
+	}
+
 	// Reconcile the deployment replicas
 	replicas := e.Integration.Spec.Replicas
 	// Deployment replicas defaults to 1, so we avoid forcing
@@ -108,7 +108,7 @@ func TestGetDefaultMinimalGarbageCollectableTypes(t *testing.T) {
 	deletableTypes, err := gcTrait.getDeletableTypes(environment)
 
 	require.NoError(t, err)
-	assert.Len(t, deletableTypes, 6)
+	assert.Len(t, deletableTypes, 7)
 }
 
 func TestGarbageCollectResources(t *testing.T) {
@@ -0,0 +1,288 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+const (
+	hpaTraitID = "hpa"
+	// The HPA trait must be executed before the deployment trait, which leaves the replicas to the autoscaler.
+	hpaTraitOrder = 1050
+
+	defaultHPACPUUtilization = int32(80)
+)
+
+type hpaTrait struct {
+	BaseTrait
+	traitv1.HPATrait `property:",squash"`
+}
+
+func newHPATrait() Trait {
+	return &hpaTrait{
+		BaseTrait: NewBaseTrait(hpaTraitID, hpaTraitOrder),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	if strategy != ControllerStrategyDeployment {
+		return false, nil, fmt.Errorf("horizontalpodautoscaler isn't supported with %s controller strategy", strategy)
+	}
+
+	if kt, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait); ok && ptr.Deref(kt.Enabled, false) {
+		return false, nil, errors.New("hpa trait cannot be enabled along with keda trait, which manages its own horizontalpodautoscaler")
+	}
+
+	if err := t.validate(e.Integration); err != nil {
+		return false, nil, err
+	}
+
+	return true, nil, nil
+}
+
+func (t *hpaTrait) validate(it *v1.Integration) error {
+	if t.MaxReplicas == nil {
+		return errors.New("hpa trait requires max-replicas to be set")
+	}
+	if minReplicas := t.minReplicas(it); minReplicas < 1 || minReplicas > *t.MaxReplicas {
+		return fmt.Errorf("hpa trait min replicas (%d) must be between 1 and max-replicas (%d)", minReplicas, *t.MaxReplicas)
+	}
+
+	for _, m := range t.Metrics {
+		if m.Name == "" || m.Target == "" {
+			return fmt.Errorf("hpa trait metric %q requires a name and a target", m.Type)
+		}
+		if _, err := resource.ParseQuantity(m.Target); err != nil {
+			return fmt.Errorf("hpa trait metric %s has an invalid target %q: %w", m.Name, m.Target, err)
+		}
+		switch m.Type {
+		case string(autoscalingv2.PodsMetricSourceType):
+			if m.TargetType != "" && m.TargetType != string(autoscalingv2.AverageValueMetricType) {
+				return fmt.Errorf("hpa trait metric %s only supports an AverageValue target type", m.Name)
+			}
+		case string(autoscalingv2.ObjectMetricSourceType):
+			if m.ObjectKind == "" || m.ObjectName == "" {
+				return fmt.Errorf("hpa trait metric %s requires the kind and name of the object it describes", m.Name)
+			}
+			if err := validateMetricTargetType(m); err != nil {
+				return err
+			}
+		case string(autoscalingv2.ExternalMetricSourceType):
+			if err := validateMetricTargetType(m); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("hpa trait metric %s has an unsupported type %q", m.Name, m.Type)
+		}
+	}
+
+	return nil
+}
+
+// validateMetricTargetType checks the target type of an Object or External metric, which is compared either to
+// the metric value or to its average value per pod.
+func validateMetricTargetType(m traitv1.HPAMetric) error {
+	switch m.TargetType {
+	case "", string(autoscalingv2.ValueMetricType), string(autoscalingv2.AverageValueMetricType):
+		return nil
+	default:
+		return fmt.Errorf("hpa trait metric %s has an unsupported target type %q, either Value or AverageValue is supported", m.Name, m.TargetType)
+	}
+}
+
+// minReplicas returns the minimum number of replicas, which defaults to the Integration replicas, so that the replicas
+// set through the scale subresource of the Integration, or the Pipe, are honored by the autoscaler.
+func (t *hpaTrait) minReplicas(it *v1.Integration) int32 {
+	if t.MinReplicas != nil {
+		return *t.MinReplicas
+	}
+	if it.Spec.Replicas != nil {
+		return *it.Spec.Replicas
+	}
+
+	return 1
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			// The Deployment is named after the Integration
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       e.Integration.Name,
+			},
+			MinReplicas: ptr.To(t.minReplicas(e.Integration)),
+			MaxReplicas: *t.MaxReplicas,
+			Metrics:     t.metrics(),
+		},
+	}
+
+	if t.ScaleUp != nil || t.ScaleDown != nil {
+		hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleUp:   scalingRules(t.ScaleUp),
+			ScaleDown: scalingRules(t.ScaleDown),
+		}
+	}
+
+	e.Resources.Add(hpa)
+
+	return nil
+}
+
+func (t *hpaTrait) metrics() []autoscalingv2.MetricSpec {
+	metrics := make([]autoscalingv2.MetricSpec, 0, len(t.Metrics)+2)
+
+	cpu := t.CPUUtilization
+	if cpu == nil && t.MemoryUtilization == nil && len(t.Metrics) == 0 {
+		cpu = ptr.To(defaultHPACPUUtilization)
+	}
+	if cpu != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *cpu))
+	}
+	if t.MemoryUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *t.MemoryUtilization))
+	}
+
+	for _, m := range t.Metrics {
+		identifier := autoscalingv2.MetricIdentifier{
+			Name: m.Name,
+		}
+		if len(m.Selector) > 0 {
+			identifier.Selector = &metav1.LabelSelector{
+				MatchLabels: m.Selector,
+			}
+		}
+		// The target has been validated already
+		target := metricTarget(m.TargetType, resource.MustParse(m.Target))
+
+		switch m.Type {
+		case string(autoscalingv2.PodsMetricSourceType):
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: identifier,
+					Target: target,
+				},
+			})
+		case string(autoscalingv2.ObjectMetricSourceType):
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.ObjectMetricSourceType,
+				Object: &autoscalingv2.ObjectMetricSource{
+					DescribedObject: autoscalingv2.CrossVersionObjectReference{
+						APIVersion: m.ObjectAPIVersion,
+						Kind:       m.ObjectKind,
+						Name:       m.ObjectName,
+					},
+					Metric: identifier,
+					Target: target,
+				},
+			})
+		case string(autoscalingv2.ExternalMetricSourceType):
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					Metric: identifier,
+					Target: target,
+				},
+			})
+		}
+	}
+
+	return metrics
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(utilization),
+			},
+		},
+	}
+}
+
+func metricTarget(targetType string, quantity resource.Quantity) autoscalingv2.MetricTarget {
+	if targetType == string(autoscalingv2.ValueMetricType) {
+		return autoscalingv2.MetricTarget{
+			Type:  autoscalingv2.ValueMetricType,
+			Value: &quantity,
+		}
+	}
+
+	return autoscalingv2.MetricTarget{
+		Type:         autoscalingv2.AverageValueMetricType,
+		AverageValue: &quantity,
+	}
+}
+
+func scalingRules(rules *traitv1.HPAScalingRules) *autoscalingv2.HPAScalingRules {
+	if rules == nil {
+		return nil
+	}
+
+	r := autoscalingv2.HPAScalingRules{
+		StabilizationWindowSeconds: rules.StabilizationWindowSeconds,
+	}
+	if rules.SelectPolicy != "" {
+		r.SelectPolicy = ptr.To(autoscalingv2.ScalingPolicySelect(rules.SelectPolicy))
+	}
+	for _, p := range rules.Policies {
+		r.Policies = append(r.Policies, autoscalingv2.HPAScalingPolicy{
+			Type:          autoscalingv2.HPAScalingPolicyType(p.Type),
+			Value:         p.Value,
+			PeriodSeconds: p.PeriodSeconds,
+		})
+	}
+
+	return &r
+}
@@ -0,0 +1,261 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestHPA(t *testing.T) {
+	environment := hpaEnv(t, &traitv1.HPATrait{
+		Trait:       traitv1.Trait{Enabled: ptr.To(true)},
+		MaxReplicas: ptr.To(int32(5)),
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(hpaTraitID))
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, ServiceTestName, hpa.Name)
+	assert.Equal(t, "ns", hpa.Namespace)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       ServiceTestName,
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, ptr.To(int32(1)), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Equal(t, []autoscalingv2.MetricSpec{resourceMetric(corev1.ResourceCPU, 80)}, hpa.Spec.Metrics)
+	assert.Nil(t, hpa.Spec.Behavior)
+
+	deployment := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == ServiceTestName })
+	require.NotNil(t, deployment)
+	assert.Nil(t, deployment.Spec.Replicas)
+}
+
+func TestHPAMinReplicasFromIntegrationReplicas(t *testing.T) {
+	environment := hpaEnv(t, &traitv1.HPATrait{
+		Trait:       traitv1.Trait{Enabled: ptr.To(true)},
+		MaxReplicas: ptr.To(int32(5)),
+	})
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, ptr.To(int32(3)), hpa.Spec.MinReplicas)
+
+	deployment := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == ServiceTestName })
+	require.NotNil(t, deployment)
+	assert.Nil(t, deployment.Spec.Replicas)
+}
+
+func TestHPAMetricsAndBehavior(t *testing.T) {
+	environment := hpaEnv(t, &traitv1.HPATrait{
+		Trait:             traitv1.Trait{Enabled: ptr.To(true)},
+		MinReplicas:       ptr.To(int32(2)),
+		MaxReplicas:       ptr.To(int32(10)),
+		MemoryUtilization: ptr.To(int32(70)),
+		Metrics: []traitv1.HPAMetric{
+			{Type: "Pods", Name: "application_camel_exchanges_inflight", Target: "1k"},
+			{Type: "Object", Name: "requests_per_second", Target: "100", TargetType: "Value",
+				ObjectAPIVersion: "networking.k8s.io/v1", ObjectKind: "Ingress", ObjectName: "my-ingress"},
+			{Type: "External", Name: "queue_messages_ready", Target: "30", Selector: map[string]string{"queue": "orders"}},
+		},
+		ScaleDown: &traitv1.HPAScalingRules{
+			StabilizationWindowSeconds: ptr.To(int32(300)),
+			SelectPolicy:               "Min",
+			Policies: []traitv1.HPAScalingPolicy{
+				{Type: "Percent", Value: 10, PeriodSeconds: 60},
+				{Type: "Pods", Value: 1, PeriodSeconds: 60},
+			},
+		},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	hpa := getHPA(environment.Resources)
+	require.NotNil(t, hpa)
+	assert.Equal(t, ptr.To(int32(2)), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+
+	// The CPU utilization is not defaulted when other metrics are set
+	require.Len(t, hpa.Spec.Metrics, 4)
+	assert.Equal(t, resourceMetric(corev1.ResourceMemory, 70), hpa.Spec.Metrics[0])
+	assert.Equal(t, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "application_camel_exchanges_inflight"},
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("1k"))},
+		},
+	}, hpa.Spec.Metrics[1])
+	assert.Equal(t, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ObjectMetricSourceType,
+		Object: &autoscalingv2.ObjectMetricSource{
+			DescribedObject: autoscalingv2.CrossVersionObjectReference{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Name: "my-ingress"},
+			Metric:          autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+			Target:          autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: ptr.To(resource.MustParse("100"))},
+		},
+	}, hpa.Spec.Metrics[2])
+	assert.Equal(t, autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name:     "queue_messages_ready",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}},
+			},
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("30"))},
+		},
+	}, hpa.Spec.Metrics[3])
+
+	require.NotNil(t, hpa.Spec.Behavior)
+	assert.Nil(t, hpa.Spec.Behavior.ScaleUp)
+	assert.Equal(t, &autoscalingv2.HPAScalingRules{
+		StabilizationWindowSeconds: ptr.To(int32(300)),
+		SelectPolicy:               ptr.To(autoscalingv2.MinChangePolicySelect),
+		Policies: []autoscalingv2.HPAScalingPolicy{
+			{Type: autoscalingv2.PercentScalingPolicy, Value: 10, PeriodSeconds: 60},
+			{Type: autoscalingv2.PodsScalingPolicy, Value: 1, PeriodSeconds: 60},
+		},
+	}, hpa.Spec.Behavior.ScaleDown)
+}
+
+func TestHPAInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait traitv1.HPATrait
+		err   string
+	}{
+		{
+			name:  "no max replicas",
+			trait: traitv1.HPATrait{},
+			err:   "hpa trait requires max-replicas to be set",
+		},
+		{
+			name:  "min replicas above max replicas",
+			trait: traitv1.HPATrait{MinReplicas: ptr.To(int32(3)), MaxReplicas: ptr.To(int32(2))},
+			err:   "hpa trait min replicas (3) must be between 1 and max-replicas (2)",
+		},
+		{
+			name:  "unsupported metric type",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{{Type: "Resource", Name: "cpu", Target: "1"}}},
+			err:   `hpa trait metric cpu has an unsupported type "Resource"`,
+		},
+		{
+			name:  "invalid target",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{{Type: "Pods", Name: "m", Target: "one"}}},
+			err:   `hpa trait metric m has an invalid target "one": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		},
+		{
+			name:  "pods metric value target",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{{Type: "Pods", Name: "m", Target: "1", TargetType: "Value"}}},
+			err:   "hpa trait metric m only supports an AverageValue target type",
+		},
+		{
+			name:  "object metric without object",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{{Type: "Object", Name: "m", Target: "1"}}},
+			err:   "hpa trait metric m requires the kind and name of the object it describes",
+		},
+		{
+			name: "object metric utilization target",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{
+				{Type: "Object", Name: "m", Target: "1", TargetType: "Utilization", ObjectKind: "Service", ObjectName: "s"},
+			}},
+			err: `hpa trait metric m has an unsupported target type "Utilization", either Value or AverageValue is supported`,
+		},
+		{
+			name:  "external metric invalid target type",
+			trait: traitv1.HPATrait{MaxReplicas: ptr.To(int32(2)), Metrics: []traitv1.HPAMetric{{Type: "External", Name: "m", Target: "1", TargetType: "Average"}}},
+			err:   `hpa trait metric m has an unsupported target type "Average", either Value or AverageValue is supported`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hpa := test.trait
+			hpa.Enabled = ptr.To(true)
+			environment := hpaEnv(t, &hpa)
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestHPAWithKeda(t *testing.T) {
+	environment := hpaEnv(t, &traitv1.HPATrait{
+		Trait:       traitv1.Trait{Enabled: ptr.To(true)},
+		MaxReplicas: ptr.To(int32(5)),
+	})
+	environment.Integration.Spec.Traits.Keda = &traitv1.KedaTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hpa trait cannot be enabled along with keda trait")
+}
+
+func TestDeploymentReplicasWithoutHPA(t *testing.T) {
+	environment := hpaEnv(t, nil)
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.Nil(t, getHPA(environment.Resources))
+
+	deployment := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == ServiceTestName })
+	require.NotNil(t, deployment)
+	assert.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+}
+
+func getHPA(resources *kubernetes.Collection) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, r := range resources.Items() {
+		if hpa, ok := r.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			return hpa
+		}
+	}
+
+	return nil
+}
+
+func hpaEnv(t *testing.T, hpa *traitv1.HPATrait) Environment {
+	t.Helper()
+
+	return newRouteTestEnv(t, `from("timer:tick").log("hello");`, v1.Traits{HPA: hpa})
+}
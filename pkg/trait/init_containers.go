@@ -41,6 +41,7 @@ type containerTask struct {
 	name      string
 	image     string
 	command   string
+	args      []string
 	isSidecar bool
 	env       []corev1.EnvVar
 }
@@ -134,6 +135,16 @@ func (t *initContainersTrait) Configure(e *Environment) (bool, *TraitCondition,
 			t.tasks = append(t.tasks, caCertTask)
 		}
 	}
+	// Set the log forwarder sidecar if the logs are shipped
+	if trait := e.Catalog.GetTrait(logShippingTraitID); trait != nil {
+		if logShipping, ok := trait.(*logShippingTrait); ok && logShipping.isEnabled() {
+			logShippingTask, err := logShipping.sidecarTask(e)
+			if err != nil {
+				return false, nil, err
+			}
+			t.tasks = append(t.tasks, logShippingTask)
+		}
+	}
 
 	return len(t.tasks) > 0, nil, nil
 }
@@ -145,6 +156,13 @@ func (t *initContainersTrait) Apply(e *Environment) error {
 		// Deployment
 		initContainers = &deployment.Spec.Template.Spec.InitContainers
 
+		return nil
+	}); err != nil {
+		return err
+	} else if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		// StatefulSet
+		initContainers = &statefulSet.Spec.Template.Spec.InitContainers
+
 		return nil
 	}); err != nil {
 		return err
@@ -178,6 +196,7 @@ func (t *initContainersTrait) configureContainers(containers *[]corev1.Container
 			Name:    task.name,
 			Image:   task.image,
 			Command: splitContainerCommand(task.command),
+			Args:    task.args,
 			Env:     task.env,
 		}
 		if task.isSidecar {
@@ -66,6 +66,9 @@ func (t *istioTrait) Apply(e *Environment) error {
 		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
 			d.Spec.Template.Annotations = t.injectIstioAnnotation(d.Spec.Template.Annotations, true)
 		})
+		e.Resources.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+			s.Spec.Template.Annotations = t.injectIstioAnnotation(s.Spec.Template.Annotations, true)
+		})
 		e.Resources.VisitKnativeConfigurationSpec(func(cs *servingv1.ConfigurationSpec) {
 			cs.Template.Annotations = t.injectIstioAnnotation(cs.Template.Annotations, false)
 		})
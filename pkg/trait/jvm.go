@@ -158,7 +158,12 @@ func (t *jvmTrait) Apply(e *Environment) error {
 	// This is configured off-container, thus is limited to explicit user configuration.
 	// We may want to inject a wrapper script into the container image, so that it can
 	// be performed in-container, based on CGroups memory resource control files.
-	if memory, hasLimit := container.Resources.Limits[corev1.ResourceMemory]; !hasHeapSizeOption && hasLimit {
+	memory, hasLimit := container.Resources.Limits[corev1.ResourceMemory]
+	if vt, ok := e.GetTrait(vpaTraitID).(*vpaTrait); ok && !hasHeapSizeOption && vt.setsResources() {
+		// The VerticalPodAutoscaler sets the memory of the pods, so that the heap size is left to the JVM,
+		// which sizes it according to the actual container memory limit
+		args = append(args, fmt.Sprintf("-XX:MaxRAMPercentage=%d", defaultMaxMemoryPercentage))
+	} else if !hasHeapSizeOption && hasLimit {
 		// Simple heuristic that caps the maximum heap size to 50% of the memory limit
 		percentage := defaultMaxMemoryPercentage
 		// Unless the memory limit is lower than 300M, in which case we leave more room for the non-heap memory
@@ -18,7 +18,6 @@ limitations under the License.
 package trait
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -207,29 +206,11 @@ func (t *kameletsTrait) calculateNamespaces(e *Environment, defaultNamespaces ..
 	if err != nil {
 		return namespaces, err
 	}
-	if len(namespaces) > 0 {
-		if e.Integration.Spec.ServiceAccountName == "" {
-			return nil, errors.New("you must to use an authorized ServiceAccount to access cross-namespace resources kamelets. " +
-				"Set it in the Integration spec accordingly")
-		}
-		// verify an SA exists and it is authorized for Kamelets in that namespace
-		for _, ns := range namespaces {
-			ok, err := kubernetes.CheckServiceAccountPermission(
-				e.Ctx,
-				e.Client,
-				fmt.Sprintf("system:serviceaccount:%s:%s", e.Integration.Namespace, e.Integration.Spec.ServiceAccountName),
-				v1.SchemeGroupVersion.Group,
-				"kamelets",
-				ns,
-				"get",
-			)
-			if err != nil {
-				return nil, err
-			}
-			if !ok {
-				return nil, fmt.Errorf("cross-namespace Integration reference authorization denied for the ServiceAccount %s and resources kamelets",
-					e.Integration.Spec.ServiceAccountName)
-			}
+	// verify an SA exists and it is authorized for Kamelets in that namespace
+	for _, ns := range namespaces {
+		if err := kubernetes.CheckCrossNamespaceAccess(e.Ctx, e.Client, v1.IntegrationKind, e.Integration.Namespace,
+			e.Integration.Spec.ServiceAccountName, v1.SchemeGroupVersion.Group, "kamelets", ns, ""); err != nil {
+			return nil, err
 		}
 	}
 
@@ -835,7 +835,7 @@ func TestKameletMultiNamespace(t *testing.T) {
 	err = trait.Apply(environment)
 	require.Error(t, err)
 	assert.Equal(t, "cross-namespace Integration reference authorization denied for the ServiceAccount unauth-sa "+
-		"and resources kamelets", err.Error())
+		"and resources kamelets in namespace ns1", err.Error())
 	// Now we should good to go
 	environment.Integration.Namespace = "default"
 	environment.Integration.Spec.ServiceAccountName = "cross-ns-sa"
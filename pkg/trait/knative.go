@@ -519,7 +519,7 @@ func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference
 		if err != nil {
 			return err
 		}
-	case ControllerStrategyDeployment:
+	case ControllerStrategyDeployment, ControllerStrategyStatefulSet:
 		trigger, err = knativeutil.CreateServiceTrigger(*ref, e.Integration.Name, eventType, path, attributes)
 		if err != nil {
 			return err
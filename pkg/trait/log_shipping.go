@@ -0,0 +1,225 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/boolean"
+)
+
+const (
+	logShippingTraitID    = "log-shipping"
+	logShippingTraitOrder = 810
+
+	logShippingProtocolOTLPHTTP = "otlp-http"
+	logShippingProtocolSyslog   = "syslog"
+
+	defaultLogShippingImage         = "cr.fluentbit.io/fluent/fluent-bit:3.2"
+	defaultLogShippingContainerName = "log-shipping"
+	defaultLogShippingVolume        = "camel-k-logs"
+	defaultLogShippingDir           = "/var/log/camel"
+	defaultLogShippingFile          = "integration.log"
+	logShippingCommand              = "/fluent-bit/bin/fluent-bit"
+)
+
+type logShippingTrait struct {
+	BaseTrait
+	traitv1.LogShippingTrait `property:",squash"`
+}
+
+func newLogShippingTrait() Trait {
+	return &logShippingTrait{
+		BaseTrait: NewBaseTrait(logShippingTraitID, logShippingTraitOrder),
+	}
+}
+
+func (t *logShippingTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !t.isEnabled() || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+	if _, err := t.outputArgs(); err != nil {
+		return false, nil, err
+	}
+
+	return true, nil, nil
+}
+
+func (t *logShippingTrait) Apply(e *Environment) error {
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	// The console logs are left as they are, the forwarder tails a dedicated JSON file
+	e.ApplicationProperties["quarkus.log.file.enable"] = boolean.TrueString
+	e.ApplicationProperties["quarkus.log.file.path"] = path.Join(defaultLogShippingDir, defaultLogShippingFile)
+	e.ApplicationProperties["quarkus.log.file.json"] = boolean.TrueString
+	e.ApplicationProperties["quarkus.log.file.rotation.max-file-size"] = "10M"
+	e.ApplicationProperties["quarkus.log.file.rotation.max-backup-index"] = "1"
+
+	return nil
+}
+
+func (t *logShippingTrait) isEnabled() bool {
+	return ptr.Deref(t.Enabled, false)
+}
+
+func (t *logShippingTrait) getImage() string {
+	if t.Image == "" {
+		return defaultLogShippingImage
+	}
+
+	return t.Image
+}
+
+// sidecarTask returns the log forwarder, which is added as a sidecar container by the init-containers trait.
+func (t *logShippingTrait) sidecarTask(e *Environment) (containerTask, error) {
+	output, err := t.outputArgs()
+	if err != nil {
+		return containerTask{}, err
+	}
+	args := []string{
+		"-R", "/fluent-bit/etc/parsers.conf",
+		"-i", "tail",
+		"-p", "path=" + path.Join(defaultLogShippingDir, defaultLogShippingFile),
+		"-p", "parser=json",
+		"-p", "tag=camel",
+		"-p", "read_from_head=true",
+		"-F", "modify", "-m", "*",
+	}
+	for _, label := range t.enrichmentLabels(e) {
+		args = append(args, "-p", "add="+label)
+	}
+	args = append(args, output...)
+
+	return containerTask{
+		name:      defaultLogShippingContainerName,
+		image:     t.getImage(),
+		command:   logShippingCommand,
+		args:      args,
+		isSidecar: true,
+	}, nil
+}
+
+// enrichmentLabels returns the labels added to each log record, with format `<key> <value>`.
+func (t *logShippingTrait) enrichmentLabels(e *Environment) []string {
+	labels := []string{
+		"integration " + e.Integration.Name,
+		"namespace " + e.Integration.Namespace,
+	}
+	if e.Integration.Status.IntegrationKit != nil {
+		labels = append(labels, "kit "+e.Integration.Status.IntegrationKit.Name)
+	}
+	if e.Integration.Status.Digest != "" {
+		labels = append(labels, "digest "+e.Integration.Status.Digest)
+	}
+	keys := make([]string, 0, len(t.Labels))
+	for k := range t.Labels {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		labels = append(labels, fmt.Sprintf("%s %s", k, t.Labels[k]))
+	}
+
+	return labels
+}
+
+// outputArgs returns the arguments configuring the output of the log forwarder according to the protocol.
+func (t *logShippingTrait) outputArgs() ([]string, error) {
+	if t.Endpoint == "" {
+		return nil, errors.New("log-shipping trait requires an endpoint")
+	}
+	endpoint, err := url.Parse(t.Endpoint)
+	if err != nil || endpoint.Hostname() == "" {
+		return nil, fmt.Errorf("could not parse log-shipping endpoint %s", t.Endpoint)
+	}
+
+	switch t.Protocol {
+	case "", logShippingProtocolOTLPHTTP:
+		return otlpHTTPOutputArgs(endpoint)
+	case logShippingProtocolSyslog:
+		return syslogOutputArgs(endpoint)
+	default:
+		return nil, fmt.Errorf("unsupported log-shipping protocol %s: must be one of %s, %s",
+			t.Protocol, logShippingProtocolOTLPHTTP, logShippingProtocolSyslog)
+	}
+}
+
+func otlpHTTPOutputArgs(endpoint *url.URL) ([]string, error) {
+	tls := "off"
+	switch endpoint.Scheme {
+	case "http":
+	case "https":
+		tls = "on"
+	default:
+		return nil, fmt.Errorf("log-shipping endpoint %s must be an http or https URL with the %s protocol",
+			endpoint, logShippingProtocolOTLPHTTP)
+	}
+	port := endpoint.Port()
+	if port == "" {
+		port = "4318"
+	}
+	uri := endpoint.Path
+	if uri == "" || uri == "/" {
+		uri = "/v1/logs"
+	}
+
+	return []string{
+		"-o", "opentelemetry", "-m", "*",
+		"-p", "host=" + endpoint.Hostname(),
+		"-p", "port=" + port,
+		"-p", "tls=" + tls,
+		"-p", "logs_uri=" + uri,
+		"-p", "logs_body_key=$message",
+		"-p", "logs_body_key_attributes=true",
+	}, nil
+}
+
+func syslogOutputArgs(endpoint *url.URL) ([]string, error) {
+	port := endpoint.Port()
+	switch endpoint.Scheme {
+	case "tcp", "udp":
+		if port == "" {
+			port = "514"
+		}
+	case "tls":
+		if port == "" {
+			port = "6514"
+		}
+	default:
+		return nil, fmt.Errorf("log-shipping endpoint %s must be a tcp, udp or tls address with the %s protocol",
+			endpoint, logShippingProtocolSyslog)
+	}
+
+	return []string{
+		"-o", "syslog", "-m", "*",
+		"-p", "host=" + endpoint.Hostname(),
+		"-p", "port=" + port,
+		"-p", "mode=" + endpoint.Scheme,
+		"-p", "syslog_format=rfc5424",
+		"-p", "syslog_message_key=message",
+		"-p", "syslog_appname_key=integration",
+	}, nil
+}
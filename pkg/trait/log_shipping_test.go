@@ -0,0 +1,167 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+)
+
+func TestLogShippingDisabled(t *testing.T) {
+	environment := logShippingEnv(t, nil)
+	_, _, err := environment.Catalog.apply(environment)
+	require.NoError(t, err)
+
+	assert.Nil(t, environment.GetTrait(logShippingTraitID))
+	deploy := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deploy)
+	assert.Empty(t, deploy.Spec.Template.Spec.InitContainers)
+	assert.Empty(t, environment.ApplicationProperties["quarkus.log.file.enable"])
+}
+
+func TestLogShippingOTLPHTTP(t *testing.T) {
+	environment := logShippingEnv(t, &traitv1.LogShippingTrait{
+		Trait:    traitv1.Trait{Enabled: ptr.To(true)},
+		Endpoint: "https://collector.observability:4318",
+		Labels:   map[string]string{"tenant": "acme", "env": "prod"},
+	})
+	_, _, err := environment.Catalog.apply(environment)
+	require.NoError(t, err)
+
+	assert.NotNil(t, environment.GetTrait(logShippingTraitID))
+	assert.Equal(t, "true", environment.ApplicationProperties["quarkus.log.file.enable"])
+	assert.Equal(t, "/var/log/camel/integration.log", environment.ApplicationProperties["quarkus.log.file.path"])
+	assert.Equal(t, "true", environment.ApplicationProperties["quarkus.log.file.json"])
+
+	deploy := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	require.NotNil(t, deploy)
+	require.Len(t, deploy.Spec.Template.Spec.InitContainers, 1)
+	sidecar := deploy.Spec.Template.Spec.InitContainers[0]
+	assert.Equal(t, defaultLogShippingContainerName, sidecar.Name)
+	assert.Equal(t, defaultLogShippingImage, sidecar.Image)
+	assert.Equal(t, []string{logShippingCommand}, sidecar.Command)
+	assert.Equal(t, ptr.To(corev1.ContainerRestartPolicyAlways), sidecar.RestartPolicy)
+	assert.Equal(t, []string{
+		"-R", "/fluent-bit/etc/parsers.conf",
+		"-i", "tail",
+		"-p", "path=/var/log/camel/integration.log",
+		"-p", "parser=json",
+		"-p", "tag=camel",
+		"-p", "read_from_head=true",
+		"-F", "modify", "-m", "*",
+		"-p", "add=integration my-it",
+		"-p", "add=namespace ns",
+		"-p", "add=kit my-kit",
+		"-p", "add=digest my-digest",
+		"-p", "add=env prod",
+		"-p", "add=tenant acme",
+		"-o", "opentelemetry", "-m", "*",
+		"-p", "host=collector.observability",
+		"-p", "port=4318",
+		"-p", "tls=on",
+		"-p", "logs_uri=/v1/logs",
+		"-p", "logs_body_key=$message",
+		"-p", "logs_body_key_attributes=true",
+	}, sidecar.Args)
+
+	logsMount := corev1.VolumeMount{Name: defaultLogShippingVolume, MountPath: defaultLogShippingDir}
+	assert.Contains(t, sidecar.VolumeMounts, logsMount)
+	container := environment.GetIntegrationContainer()
+	require.NotNil(t, container)
+	assert.Contains(t, container.VolumeMounts, logsMount)
+	volumeNames := make([]string, 0)
+	for _, v := range deploy.Spec.Template.Spec.Volumes {
+		volumeNames = append(volumeNames, v.Name)
+	}
+	assert.Contains(t, volumeNames, defaultLogShippingVolume)
+}
+
+func TestLogShippingSyslog(t *testing.T) {
+	trait := logShippingTrait{
+		LogShippingTrait: traitv1.LogShippingTrait{
+			Endpoint: "tcp://syslog.observability",
+			Protocol: logShippingProtocolSyslog,
+		},
+	}
+	args, err := trait.outputArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"-o", "syslog", "-m", "*",
+		"-p", "host=syslog.observability",
+		"-p", "port=514",
+		"-p", "mode=tcp",
+		"-p", "syslog_format=rfc5424",
+		"-p", "syslog_message_key=message",
+		"-p", "syslog_appname_key=integration",
+	}, args)
+}
+
+func TestLogShippingWrongConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trait    traitv1.LogShippingTrait
+		expected string
+	}{
+		{
+			name:     "no endpoint",
+			trait:    traitv1.LogShippingTrait{},
+			expected: "log-shipping trait requires an endpoint",
+		},
+		{
+			name:     "syslog endpoint with otlp-http",
+			trait:    traitv1.LogShippingTrait{Endpoint: "udp://syslog:514"},
+			expected: "log-shipping endpoint udp://syslog:514 must be an http or https URL with the otlp-http protocol",
+		},
+		{
+			name:     "http endpoint with syslog",
+			trait:    traitv1.LogShippingTrait{Endpoint: "http://collector:4318", Protocol: "syslog"},
+			expected: "log-shipping endpoint http://collector:4318 must be a tcp, udp or tls address with the syslog protocol",
+		},
+		{
+			name:     "unknown protocol",
+			trait:    traitv1.LogShippingTrait{Endpoint: "http://collector:4318", Protocol: "gelf"},
+			expected: "unsupported log-shipping protocol gelf: must be one of otlp-http, syslog",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trait := logShippingTrait{LogShippingTrait: tc.trait}
+			_, err := trait.outputArgs()
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func logShippingEnv(t *testing.T, logShipping *traitv1.LogShippingTrait) *Environment {
+	t.Helper()
+
+	environment := newRouteTestEnv(t, `from("timer:tick").log("hello");`, v1.Traits{LogShipping: logShipping})
+	environment.Integration.Name = "my-it"
+	environment.Integration.Status.Digest = "my-digest"
+	environment.Integration.Status.IntegrationKit = &corev1.ObjectReference{Name: "my-kit", Namespace: "ns"}
+
+	return &environment
+}
@@ -34,6 +34,7 @@ import (
 	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/v2/pkg/util/boolean"
 	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
 	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/apache/camel-k/v2/pkg/util/property"
@@ -66,13 +67,13 @@ func (t *mountTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
 	}
 	// Validate resources and pvcs
 	for _, c := range t.Configs {
-		if !strings.HasPrefix(c, "configmap:") && !strings.HasPrefix(c, "secret:") {
-			return false, nil, fmt.Errorf("unsupported config %s, must be a configmap or secret resource", c)
+		if !strings.HasPrefix(c, "configmap:") && !strings.HasPrefix(c, "secret:") && !externalsecret.IsReference(c) {
+			return false, nil, fmt.Errorf("unsupported config %s, must be a configmap, secret or external secret resource", c)
 		}
 	}
 	for _, r := range t.Resources {
-		if !strings.HasPrefix(r, "configmap:") && !strings.HasPrefix(r, "secret:") {
-			return false, nil, fmt.Errorf("unsupported resource %s, must be a configmap or secret resource", r)
+		if !strings.HasPrefix(r, "configmap:") && !strings.HasPrefix(r, "secret:") && !externalsecret.IsReference(r) {
+			return false, nil, fmt.Errorf("unsupported resource %s, must be a configmap, secret or external secret resource", r)
 		}
 	}
 
@@ -100,6 +101,17 @@ func (t *mountTrait) Apply(e *Environment) error {
 		return err
 	}
 
+	// StatefulSet
+	if err := e.Resources.VisitStatefulSetE(func(statefulSet *appsv1.StatefulSet) error {
+		volumes = &statefulSet.Spec.Template.Spec.Volumes
+		initContainers = &statefulSet.Spec.Template.Spec.InitContainers
+		visited = true
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	// Knative Service
 	if err := e.Resources.VisitKnativeServiceE(func(service *serving.Service) error {
 		volumes = &service.Spec.Template.Spec.Volumes
@@ -153,7 +165,21 @@ func (t *mountTrait) configureVolumesAndMounts(
 	icnts *[]corev1.Container,
 ) error {
 	for _, c := range t.Configs {
+		if externalsecret.IsReference(c) {
+			// Let Camel parse these resources as properties
+			destFilePath, err := t.mountExternalSecret(e, vols, mnts, icnts, c, utilResource.ContentTypeText)
+			if err != nil {
+				return err
+			}
+			e.appendCloudPropertiesLocation(destFilePath)
+
+			continue
+		}
 		if conf, parseErr := utilResource.ParseConfig(c); parseErr == nil {
+			conf, err := t.copyCrossNamespaceResource(e, conf)
+			if err != nil {
+				return err
+			}
 			// Let Camel parse these resources as properties
 			destFilePath := t.mountResource(vols, mnts, icnts, conf)
 			e.appendCloudPropertiesLocation(destFilePath)
@@ -162,12 +188,26 @@ func (t *mountTrait) configureVolumesAndMounts(
 		}
 	}
 	for _, r := range t.Resources {
+		if externalsecret.IsReference(r) {
+			if _, err := t.mountExternalSecret(e, vols, mnts, icnts, r, utilResource.ContentTypeData); err != nil {
+				return err
+			}
+
+			continue
+		}
 		if res, parseErr := utilResource.ParseResource(r); parseErr == nil {
+			res, err := t.copyCrossNamespaceResource(e, res)
+			if err != nil {
+				return err
+			}
 			t.mountResource(vols, mnts, icnts, res)
 		} else {
 			return parseErr
 		}
 	}
+	if err := t.mountExternalProperties(e, vols, mnts, icnts); err != nil {
+		return err
+	}
 	for _, v := range t.Volumes {
 		volume, volumeMount, parseErr := ParseAndCreateVolume(e, v)
 		if parseErr != nil {
@@ -190,6 +230,33 @@ func (t *mountTrait) configureVolumesAndMounts(
 			(*icnts)[i].VolumeMounts = append((*icnts)[i].VolumeMounts, *volumeMount)
 		}
 	}
+	// Mount the volumes claimed by each replica of the StatefulSet
+	if trait := e.GetTrait(statefulSetTraitID); trait != nil {
+		if statefulSet, ok := trait.(*statefulSetTrait); ok {
+			for _, v := range statefulSet.VolumeClaimTemplates {
+				_, volumeMount, parseErr := ParseVolumeClaimTemplate(v)
+				if parseErr != nil {
+					return parseErr
+				}
+				*mnts = append(*mnts, *volumeMount)
+				for i := range *icnts {
+					(*icnts)[i].VolumeMounts = append((*icnts)[i].VolumeMounts, *volumeMount)
+				}
+			}
+		}
+	}
+	// Mount the logs volume shared with the log forwarder if the logs are shipped
+	if logShipping, ok := e.Catalog.GetTrait(logShippingTraitID).(*logShippingTrait); ok && logShipping.isEnabled() {
+		volume, volumeMount, parseErr := ParseEmptyDirVolume(fmt.Sprintf("%s:%s", defaultLogShippingVolume, defaultLogShippingDir))
+		if parseErr != nil {
+			return parseErr
+		}
+		*vols = append(*vols, *volume)
+		*mnts = append(*mnts, *volumeMount)
+		for i := range *icnts {
+			(*icnts)[i].VolumeMounts = append((*icnts)[i].VolumeMounts, *volumeMount)
+		}
+	}
 	// Mount the agent volume if any agent exists
 	trait := e.Catalog.GetTrait(jvmTraitID)
 	if trait != nil {
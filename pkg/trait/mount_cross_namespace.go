@@ -0,0 +1,136 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+)
+
+const crossNamespaceSourceAnnotation = "camel.apache.org/source.resource"
+
+// copyCrossNamespaceResource copies a Configmap or a Secret of another namespace into the Integration namespace,
+// provided the Integration ServiceAccount is authorized to read it. It returns the config referencing the copy.
+func (t *mountTrait) copyCrossNamespaceResource(e *Environment, conf *utilResource.Config) (*utilResource.Config, error) {
+	if conf.Namespace() == "" {
+		return conf, nil
+	}
+	if conf.Namespace() == e.Integration.Namespace {
+		return conf.WithLocalName(conf.Name()), nil
+	}
+	if err := kubernetes.CheckCrossNamespaceAccess(e.Ctx, e.Client, v1.IntegrationKind, e.Integration.Namespace,
+		e.Integration.Spec.ServiceAccountName, "", string(conf.StorageType())+"s", conf.Namespace(), conf.Name()); err != nil {
+		return nil, err
+	}
+
+	name := crossNamespaceResourceName(e.Integration.Name, conf.Namespace(), conf.Name())
+	source := fmt.Sprintf("%s/%s", conf.Namespace(), conf.Name())
+	meta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: e.Integration.Namespace,
+		Labels: map[string]string{
+			v1.IntegrationLabel: e.Integration.Name,
+		},
+		Annotations: map[string]string{
+			crossNamespaceSourceAnnotation: source,
+		},
+	}
+	key := ctrl.ObjectKey{Namespace: conf.Namespace(), Name: conf.Name()}
+
+	switch conf.StorageType() {
+	case utilResource.StorageTypeConfigmap:
+		// The copy is shared by the configs and resources referencing the same source
+		if cm := e.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool { return cm.Name == name }); cm != nil {
+			if cm.Annotations[crossNamespaceSourceAnnotation] != source {
+				return nil, crossNamespaceResourceConflict(conf, name)
+			}
+
+			break
+		}
+		source := corev1.ConfigMap{}
+		if err := e.Client.Get(e.Ctx, key, &source); err != nil {
+			return nil, crossNamespaceResourceError(conf, err)
+		}
+		e.Resources.Add(&corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: "v1",
+			},
+			ObjectMeta: meta,
+			Data:       source.Data,
+			BinaryData: source.BinaryData,
+		})
+	case utilResource.StorageTypeSecret:
+		if secret := e.Resources.GetSecret(func(s *corev1.Secret) bool { return s.Name == name }); secret != nil {
+			if secret.Annotations[crossNamespaceSourceAnnotation] != source {
+				return nil, crossNamespaceResourceConflict(conf, name)
+			}
+
+			break
+		}
+		source := corev1.Secret{}
+		if err := e.Client.Get(e.Ctx, key, &source); err != nil {
+			return nil, crossNamespaceResourceError(conf, err)
+		}
+		e.Resources.Add(&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: meta,
+			Type:       source.Type,
+			Data:       source.Data,
+		})
+	default:
+		return nil, fmt.Errorf("cross-namespace %s resources are not supported", conf.StorageType())
+	}
+
+	return conf.WithLocalName(name), nil
+}
+
+func crossNamespaceResourceError(conf *utilResource.Config, err error) error {
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("%s %s not found in namespace %s", conf.StorageType(), conf.Name(), conf.Namespace())
+	}
+
+	return err
+}
+
+func crossNamespaceResourceConflict(conf *utilResource.Config, name string) error {
+	return fmt.Errorf("cannot copy %s %s of namespace %s: %s %s already exists with another source",
+		conf.StorageType(), conf.Name(), conf.Namespace(), conf.StorageType(), name)
+}
+
+// crossNamespaceResourceName returns the name of the copy of a Configmap or a Secret of another namespace,
+// in the namespace of the given Integration. The name is suffixed with a hash of the source namespace and name,
+// so that the copies of different resources do not collide.
+func crossNamespaceResourceName(integration, namespace, name string) string {
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s/%s", namespace, name))
+
+	return fmt.Sprintf("%s-%s-%s", integration, name, hex.EncodeToString(hash[:])[:10])
+}
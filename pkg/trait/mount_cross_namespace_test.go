@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func applyCrossNamespaceEnv(t *testing.T, serviceAccountName string, mount *traitv1.MountTrait) (*Environment, error) {
+	t.Helper()
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	fakeClient, err := internal.NewFakeClient(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-cm"},
+			Data:       map[string]string{"my.key": "my-value"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-sec"},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("changeit")},
+		},
+	)
+	require.NoError(t, err)
+	environment.Client = fakeClient
+	environment.Integration.Spec.ServiceAccountName = serviceAccountName
+	environment.Integration.Spec.Traits.Mount = mount
+	_, _, err = traitCatalog.apply(environment)
+
+	return environment, err
+}
+
+func TestMountCrossNamespaceResources(t *testing.T) {
+	environment, err := applyCrossNamespaceEnv(t, "cross-ns-sa", &traitv1.MountTrait{
+		Configs:   []string{"configmap:shared:my-cm"},
+		Resources: []string{"secret:shared:my-sec/password@/etc/db/password"},
+	})
+	require.NoError(t, err)
+
+	cm := environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool { return cm.Name == "hello-my-cm-5f043e1f80" })
+	require.NotNil(t, cm)
+	assert.Equal(t, "default", cm.Namespace)
+	assert.Equal(t, map[string]string{"my.key": "my-value"}, cm.Data)
+	assert.Equal(t, "hello", cm.Labels[v1.IntegrationLabel])
+	assert.Equal(t, "shared/my-cm", cm.Annotations[crossNamespaceSourceAnnotation])
+
+	secret := environment.Resources.GetSecret(func(s *corev1.Secret) bool { return s.Name == "hello-my-sec-6403ef4f83" })
+	require.NotNil(t, secret)
+	assert.Equal(t, "default", secret.Namespace)
+	assert.Equal(t, map[string][]byte{"password": []byte("changeit")}, secret.Data)
+	assert.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+	spec := getHelloPodSpec(t, environment)
+	assert.Contains(t, spec.Volumes, corev1.Volume{
+		Name: "hello-my-cm-5f043e1f80",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "hello-my-cm-5f043e1f80"},
+			},
+		},
+	})
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "hello-my-cm-5f043e1f80",
+		MountPath: "/etc/camel/conf.d/_configmaps/hello-my-cm-5f043e1f80",
+		ReadOnly:  true,
+	})
+	assert.Contains(t, spec.Volumes, corev1.Volume{
+		Name: "hello-my-sec-6403ef4f83",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "hello-my-sec-6403ef4f83",
+				Items:      []corev1.KeyToPath{{Key: "password", Path: "password"}},
+			},
+		},
+	})
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "hello-my-sec-6403ef4f83",
+		MountPath: "/etc/db/password",
+		SubPath:   "password",
+		ReadOnly:  true,
+	})
+}
+
+func TestMountSameNamespaceResources(t *testing.T) {
+	environment, err := applyCrossNamespaceEnv(t, "", &traitv1.MountTrait{
+		Configs: []string{"configmap:default:my-local-cm"},
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool {
+		return cm.Name == crossNamespaceResourceName("hello", "default", "my-local-cm")
+	}))
+	spec := getHelloPodSpec(t, environment)
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "my-local-cm",
+		MountPath: "/etc/camel/conf.d/_configmaps/my-local-cm",
+		ReadOnly:  true,
+	})
+}
+
+func TestMountCrossNamespaceResourcesErrors(t *testing.T) {
+	_, err := applyCrossNamespaceEnv(t, "", &traitv1.MountTrait{
+		Configs: []string{"configmap:shared:my-cm"},
+	})
+	require.ErrorContains(t, err, "you must to use an authorized ServiceAccount to access cross-namespace resources configmaps")
+
+	_, err = applyCrossNamespaceEnv(t, "unauth-sa", &traitv1.MountTrait{
+		Configs: []string{"secret:shared:my-sec"},
+	})
+	require.ErrorContains(t, err,
+		"cross-namespace Integration reference authorization denied for the ServiceAccount unauth-sa and resources secrets in namespace shared")
+
+	_, err = applyCrossNamespaceEnv(t, "cross-ns-sa", &traitv1.MountTrait{
+		Configs: []string{"configmap:shared:missing"},
+	})
+	require.ErrorContains(t, err, "configmap missing not found in namespace shared")
+}
+
+func TestCrossNamespaceResourceName(t *testing.T) {
+	assert.Equal(t, "hello-my-cm-5f043e1f80", crossNamespaceResourceName("hello", "shared", "my-cm"))
+	// The names would collide if only joined together
+	assert.NotEqual(t, crossNamespaceResourceName("hello", "team-a", "db"), crossNamespaceResourceName("hello", "team", "a-db"))
+}
+
+func TestMountCrossNamespaceResourceConflict(t *testing.T) {
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	fakeClient, err := internal.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "my-cm"},
+		Data:       map[string]string{"my.key": "my-value"},
+	})
+	require.NoError(t, err)
+	environment.Client = fakeClient
+	environment.Integration.Spec.ServiceAccountName = "cross-ns-sa"
+	environment.Integration.Spec.Traits.Mount = &traitv1.MountTrait{
+		Configs: []string{"configmap:shared:my-cm"},
+	}
+	// A resource with the name of the copy, which is not a copy of the referenced resource
+	environment.Resources.Add(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hello-my-cm-5f043e1f80"},
+	})
+
+	_, _, err = traitCatalog.apply(environment)
+	require.ErrorContains(t, err,
+		"cannot copy configmap my-cm of namespace shared: configmap hello-my-cm-5f043e1f80 already exists with another source")
+}
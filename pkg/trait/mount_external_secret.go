@@ -0,0 +1,177 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
+	"github.com/apache/camel-k/v2/pkg/util/property"
+	utilResource "github.com/apache/camel-k/v2/pkg/util/resource"
+)
+
+const externalSecretReferenceAnnotation = "camel.apache.org/external-secret.reference"
+
+// mountExternalSecret resolves a secret stored out of the cluster, and mounts either the Secret it is synced into,
+// or the volume projecting it. It returns the path where the secret is mounted.
+func (t *mountTrait) mountExternalSecret(
+	e *Environment,
+	vols *[]corev1.Volume,
+	mnts *[]corev1.VolumeMount,
+	icnts *[]corev1.Container,
+	value string,
+	contentType utilResource.ContentType,
+) (string, error) {
+	ref, err := externalsecret.ParseReference(value)
+	if err != nil {
+		return "", err
+	}
+	content, err := externalsecret.Resolve(t.externalSecretContext(e), *ref)
+	if err != nil {
+		return "", err
+	}
+	secretName := ref.SecretName(e.Integration.Name)
+
+	if content.Volume == nil {
+		secret := newExternalSecret(e, secretName, content.Data)
+		secret.Annotations = map[string]string{
+			externalSecretReferenceAnnotation: ref.String(),
+		}
+		e.Resources.Add(secret)
+
+		conf, err := parseExternalSecretConfig(secretName, ref, contentType)
+		if err != nil {
+			return "", err
+		}
+
+		return t.mountResource(vols, mnts, icnts, conf), nil
+	}
+
+	if ref.Key != "" {
+		return "", fmt.Errorf("external secret %s cannot be filtered by key, as the %s provider projects the whole secret", value, ref.Provider)
+	}
+	refName, _ := sanitizeVolumeName(secretName, vols)
+	vol := corev1.Volume{
+		Name:         refName,
+		VolumeSource: *content.Volume,
+	}
+	mnt := getMount(refName, getMountPoint(secretName, ref.DestinationPath, secretStorageType, string(contentType)), "", true)
+
+	*vols = append(*vols, vol)
+	*mnts = append(*mnts, *mnt)
+	for i := range *icnts {
+		(*icnts)[i].VolumeMounts = append((*icnts)[i].VolumeMounts, *mnt)
+	}
+
+	return mnt.MountPath, nil
+}
+
+// mountExternalProperties syncs the Camel properties referencing an external secret into a Secret,
+// which is mounted as a location of properties.
+func (t *mountTrait) mountExternalProperties(
+	e *Environment,
+	vols *[]corev1.Volume,
+	mnts *[]corev1.VolumeMount,
+	icnts *[]corev1.Container,
+) error {
+	ct, ok := e.Catalog.GetTrait(camelTraitID).(*camelTrait)
+	if !ok {
+		return nil
+	}
+	data := make(map[string][]byte)
+	for _, prop := range ct.Properties {
+		name, value := property.SplitPropertyFileEntry(prop)
+		if !externalsecret.IsReference(value) {
+			continue
+		}
+		ref, err := externalsecret.ParseReference(value)
+		if err != nil {
+			return err
+		}
+		if ref.Key == "" || ref.DestinationPath != "" {
+			return fmt.Errorf("property %s must reference a key of an external secret, with the provider:path#key syntax", name)
+		}
+		content, err := externalsecret.Resolve(t.externalSecretContext(e), *ref)
+		if err != nil {
+			return err
+		}
+		if content.Volume != nil {
+			return fmt.Errorf("property %s cannot reference an external secret of the %s provider, which projects the secret in a volume", name, ref.Provider)
+		}
+		data[name] = content.Data[ref.Key]
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	secretName := externalsecret.PropertiesSecretName(e.Integration.Name)
+	e.Resources.Add(newExternalSecret(e, secretName, data))
+	conf, err := utilResource.ParseConfig("secret:" + secretName)
+	if err != nil {
+		return err
+	}
+	e.appendCloudPropertiesLocation(t.mountResource(vols, mnts, icnts, conf))
+
+	return nil
+}
+
+func (t *mountTrait) externalSecretContext(e *Environment) externalsecret.Context {
+	return externalsecret.Context{
+		Ctx:            e.Ctx,
+		Client:         e.Client,
+		Namespace:      e.Integration.Namespace,
+		ServiceAccount: e.Integration.Spec.ServiceAccountName,
+	}
+}
+
+func parseExternalSecretConfig(secretName string, ref *externalsecret.Reference, contentType utilResource.ContentType) (*utilResource.Config, error) {
+	value := "secret:" + secretName
+	if ref.Key != "" {
+		value = fmt.Sprintf("%s/%s", value, ref.Key)
+	}
+	if ref.DestinationPath != "" {
+		value = fmt.Sprintf("%s@%s", value, ref.DestinationPath)
+	}
+	if contentType == utilResource.ContentTypeData {
+		return utilResource.ParseResource(value)
+	}
+
+	return utilResource.ParseConfig(value)
+}
+
+func newExternalSecret(e *Environment, name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/externalsecret"
+)
+
+// localSecretProvider is a stand-in external secret provider, resolving the secrets from memory.
+type localSecretProvider map[string]map[string][]byte
+
+func (p localSecretProvider) ID() string {
+	return "local"
+}
+
+func (p localSecretProvider) Resolve(ctx externalsecret.Context, ref externalsecret.Reference) (*externalsecret.Content, error) {
+	secret, ok := p[ref.Path]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	data := make(map[string][]byte, len(secret))
+	for k, v := range secret {
+		data[k] = v
+	}
+
+	return &externalsecret.Content{Data: data}, nil
+}
+
+func init() {
+	externalsecret.RegisterProvider(localSecretProvider{
+		"db": {
+			"user":     []byte("admin"),
+			"password": []byte("changeit"),
+		},
+		"certs": {
+			"ca.crt": []byte("-----BEGIN CERTIFICATE-----"),
+		},
+	})
+}
+
+func applyExternalSecretEnv(t *testing.T, mount *traitv1.MountTrait, camel *traitv1.CamelTrait) (*Environment, error) {
+	t.Helper()
+	traitCatalog := NewCatalog(nil)
+	environment := getNominalEnv(t, traitCatalog)
+	environment.Integration.Spec.Traits.Mount = mount
+	environment.Integration.Spec.Traits.Camel = camel
+	_, _, err := traitCatalog.apply(environment)
+
+	return environment, err
+}
+
+func getExternalSecret(e *Environment, name string) *corev1.Secret {
+	var secret *corev1.Secret
+	e.Resources.VisitSecret(func(s *corev1.Secret) {
+		if s.Name == name {
+			secret = s
+		}
+	})
+
+	return secret
+}
+
+func getHelloPodSpec(t *testing.T, e *Environment) corev1.PodSpec {
+	t.Helper()
+	deployment := e.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == "hello" })
+	require.NotNil(t, deployment)
+
+	return deployment.Spec.Template.Spec
+}
+
+func TestMountExternalSecrets(t *testing.T) {
+	environment, err := applyExternalSecretEnv(t, &traitv1.MountTrait{
+		Configs:   []string{"local:db#password"},
+		Resources: []string{"local:certs@/etc/certs"},
+	}, nil)
+	require.NoError(t, err)
+
+	passwordRef := externalsecret.Reference{Provider: "local", Path: "db", Key: "password"}
+	password := getExternalSecret(environment, passwordRef.SecretName("hello"))
+	require.NotNil(t, password)
+	assert.Equal(t, map[string][]byte{"password": []byte("changeit")}, password.Data)
+	assert.Equal(t, "hello", password.Labels["camel.apache.org/integration"])
+	assert.Equal(t, "local:db#password", password.Annotations[externalSecretReferenceAnnotation])
+
+	certsRef := externalsecret.Reference{Provider: "local", Path: "certs"}
+	certs := getExternalSecret(environment, certsRef.SecretName("hello"))
+	require.NotNil(t, certs)
+	assert.Equal(t, map[string][]byte{"ca.crt": []byte("-----BEGIN CERTIFICATE-----")}, certs.Data)
+
+	spec := getHelloPodSpec(t, environment)
+	assert.Contains(t, spec.Volumes, corev1.Volume{
+		Name: passwordRef.SecretName("hello"),
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: passwordRef.SecretName("hello"),
+				Items:      []corev1.KeyToPath{{Key: "password", Path: "password"}},
+			},
+		},
+	})
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      passwordRef.SecretName("hello"),
+		MountPath: "/etc/camel/conf.d/_secrets/" + passwordRef.SecretName("hello"),
+		ReadOnly:  true,
+	})
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      certsRef.SecretName("hello"),
+		MountPath: "/etc/certs",
+		ReadOnly:  true,
+	})
+	assert.Contains(t, environment.ApplicationProperties["camel.main.cloud-properties-location"],
+		"/etc/camel/conf.d/_secrets/"+passwordRef.SecretName("hello"))
+}
+
+func TestMountExternalSecretsCSI(t *testing.T) {
+	environment, err := applyExternalSecretEnv(t, &traitv1.MountTrait{
+		Configs: []string{"csi:my-provider-class"},
+	}, nil)
+	require.NoError(t, err)
+
+	ref := externalsecret.Reference{Provider: "csi", Path: "my-provider-class"}
+	assert.Nil(t, getExternalSecret(environment, ref.SecretName("hello")))
+
+	spec := getHelloPodSpec(t, environment)
+	var volume *corev1.Volume
+	for i := range spec.Volumes {
+		if spec.Volumes[i].Name == ref.SecretName("hello") {
+			volume = &spec.Volumes[i]
+		}
+	}
+	require.NotNil(t, volume)
+	require.NotNil(t, volume.CSI)
+	assert.Equal(t, externalsecret.CSIDriver, volume.CSI.Driver)
+	assert.Equal(t, "my-provider-class", volume.CSI.VolumeAttributes[externalsecret.CSISecretProviderClassAttribute])
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      ref.SecretName("hello"),
+		MountPath: "/etc/camel/conf.d/_secrets/" + ref.SecretName("hello"),
+		ReadOnly:  true,
+	})
+}
+
+func TestMountExternalProperties(t *testing.T) {
+	environment, err := applyExternalSecretEnv(t, nil, &traitv1.CamelTrait{
+		Properties: []string{
+			"my.user=admin",
+			"my.password=local:db#password",
+		},
+	})
+	require.NoError(t, err)
+
+	secret := getExternalSecret(environment, "hello-external-properties")
+	require.NotNil(t, secret)
+	assert.Equal(t, map[string][]byte{"my.password": []byte("changeit")}, secret.Data)
+
+	userProperties := environment.Resources.GetConfigMap(func(cm *corev1.ConfigMap) bool { return cm.Name == "hello-user-properties" })
+	require.NotNil(t, userProperties)
+	assert.Equal(t, "my.user=admin\n", userProperties.Data["application.properties"])
+
+	spec := getHelloPodSpec(t, environment)
+	assert.Contains(t, spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "hello-external-properties",
+		MountPath: "/etc/camel/conf.d/_secrets/hello-external-properties",
+		ReadOnly:  true,
+	})
+	assert.Contains(t, environment.ApplicationProperties["camel.main.cloud-properties-location"],
+		"/etc/camel/conf.d/_secrets/hello-external-properties")
+}
+
+func TestMountExternalSecretsErrors(t *testing.T) {
+	_, err := applyExternalSecretEnv(t, &traitv1.MountTrait{
+		Configs: []string{"local:missing"},
+	}, nil)
+	require.ErrorContains(t, err, "could not resolve external secret local:missing: secret not found")
+
+	_, err = applyExternalSecretEnv(t, &traitv1.MountTrait{
+		Configs: []string{"csi:my-provider-class#password"},
+	}, nil)
+	require.ErrorContains(t, err, "external secret csi:my-provider-class#password cannot be filtered by key, as the csi provider projects the whole secret")
+
+	_, err = applyExternalSecretEnv(t, nil, &traitv1.CamelTrait{
+		Properties: []string{"my.password=local:db"},
+	})
+	require.ErrorContains(t, err, "property my.password must reference a key of an external secret, with the provider:path#key syntax")
+
+	_, err = applyExternalSecretEnv(t, &traitv1.MountTrait{
+		Configs: []string{"unknown:db#password"},
+	}, nil)
+	require.ErrorContains(t, err, "unsupported config unknown:db#password, must be a configmap, secret or external secret resource")
+}
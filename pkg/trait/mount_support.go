@@ -26,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ParseEmptyDirVolume will parse and return an empty-dir volume.
@@ -61,6 +62,57 @@ func ParseEmptyDirVolume(item string) (*corev1.Volume, *corev1.VolumeMount, erro
 	return volume, volumeMount, nil
 }
 
+// ParseVolumeClaimTemplate will parse and return a persistent volume claim template, claimed by each replica of a StatefulSet.
+// item is expected to be as: name:path/to/mount:size<:accessMode<:storageClassName>>.
+func ParseVolumeClaimTemplate(item string) (*corev1.PersistentVolumeClaim, *corev1.VolumeMount, error) {
+	volumeParts := strings.Split(item, ":")
+
+	if len(volumeParts) < 3 || len(volumeParts) > 5 {
+		return nil, nil, fmt.Errorf(
+			"volume claim template syntax error, must be name:path/to/mount:size<:accessMode<:storageClassName>> was %s", item,
+		)
+	}
+
+	refName := kubernetes.SanitizeLabel(volumeParts[0])
+	size, err := resource.ParseQuantity(volumeParts[2])
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse size %s, %s", volumeParts[2], err.Error())
+	}
+	accessMode := corev1.ReadWriteOnce
+	if len(volumeParts) > 3 && volumeParts[3] != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(volumeParts[3])
+	}
+	switch accessMode {
+	case corev1.ReadWriteOnce, corev1.ReadOnlyMany, corev1.ReadWriteMany, corev1.ReadWriteOncePod:
+	default:
+		return nil, nil, fmt.Errorf("unsupported access mode %s for volume claim template %s", accessMode, volumeParts[0])
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: refName,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				accessMode,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+	// The cluster default Storage Class is used otherwise
+	if len(volumeParts) == 5 && volumeParts[4] != "" {
+		pvc.Spec.StorageClassName = &volumeParts[4]
+	}
+
+	volumeMount := getMount(refName, volumeParts[1], "", false)
+
+	return pvc, volumeMount, nil
+}
+
 // ParseAndCreateVolume will parse a volume configuration. If the volume does not exist it tries to create one based on the storage
 // class configuration provided or default.
 // item is expected to be as: name:path/to/mount<:size:accessMode<:storageClassName>>.
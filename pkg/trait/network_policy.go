@@ -0,0 +1,449 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/metadata"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+const (
+	networkPolicyTraitID    = "network-policy"
+	networkPolicyTraitOrder = 2450
+
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+	dnsPort            = 53
+)
+
+var (
+	defaultKnativeNamespaces   = []string{"knative-serving", "knative-eventing", "kourier-system"}
+	defaultPrometheusPodLabels = []string{"app.kubernetes.io/name=prometheus"}
+)
+
+type networkPolicyTrait struct {
+	BaseTrait
+	traitv1.NetworkPolicyTrait `property:",squash"`
+}
+
+func newNetworkPolicyTrait() Trait {
+	return &networkPolicyTrait{
+		BaseTrait: NewBaseTrait(networkPolicyTraitID, networkPolicyTraitOrder),
+	}
+}
+
+func (t *networkPolicyTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) {
+		return false, nil, nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	if _, err := keyValuePairArrayAsStringMap(t.PrometheusPodLabels); err != nil {
+		return false, nil, err
+	}
+	for _, host := range t.EgressHosts {
+		if _, ok := parseEgressHost(host); !ok {
+			return false, nil, fmt.Errorf("could not parse egress host %s: expected format \"host[:port]\"", host)
+		}
+	}
+	for _, cidr := range t.EgressCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return false, nil, fmt.Errorf("could not parse egress CIDR %s: %w", cidr, err)
+		}
+	}
+
+	return true, nil, nil
+}
+
+func (t *networkPolicyTrait) Apply(e *Environment) error {
+	networkPolicy := networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			// No ingress rule denies any ingress traffic
+			Ingress: make([]networkingv1.NetworkPolicyIngressRule, 0),
+		},
+	}
+
+	ingress, err := t.ingressRules(e)
+	if err != nil {
+		return err
+	}
+	networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, ingress...)
+
+	if ptr.Deref(t.Egress, false) {
+		egress, err := t.egressRules(e)
+		if err != nil {
+			return err
+		}
+		networkPolicy.Spec.PolicyTypes = append(networkPolicy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		networkPolicy.Spec.Egress = egress
+	}
+
+	e.Resources.Add(&networkPolicy)
+
+	return nil
+}
+
+func (t *networkPolicyTrait) ingressRules(e *Environment) ([]networkingv1.NetworkPolicyIngressRule, error) {
+	var rules []networkingv1.NetworkPolicyIngressRule
+
+	// The ports exposed by the service and container traits
+	if ports := exposedPorts(e); len(ports) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{
+			Ports: ports,
+		}
+		if len(t.IngressNamespaces) > 0 {
+			namespaces := append([]string{e.Integration.Namespace}, t.IngressNamespaces...)
+			rule.From = []networkingv1.NetworkPolicyPeer{namespacesPeer(namespaces)}
+		}
+		rules = append(rules, rule)
+	}
+
+	// The Knative activator and ingress gateway, and the eventing components delivering events
+	if e.GetTrait(knativeServiceTraitID) != nil || e.GetTrait(knativeTraitID) != nil {
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{namespacesPeer(t.knativeNamespaces())},
+		})
+	}
+
+	// The Prometheus scraper, on the metrics port
+	if e.GetTrait(prometheusTraitID) != nil {
+		if port := e.getIntegrationContainerPort(); port != nil {
+			podLabels := t.PrometheusPodLabels
+			if len(podLabels) == 0 {
+				podLabels = defaultPrometheusPodLabels
+			}
+			labels, err := keyValuePairArrayAsStringMap(podLabels)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+				Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(port.Protocol, intstr.FromInt32(port.ContainerPort))},
+				From: []networkingv1.NetworkPolicyPeer{
+					{
+						// Any namespace
+						NamespaceSelector: &metav1.LabelSelector{},
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: labels,
+						},
+					},
+				},
+			})
+		}
+	}
+
+	return rules, nil
+}
+
+// exposedPorts returns the container ports targeted by the Integration Service, and the ones declared with the container trait.
+func exposedPorts(e *Environment) []networkingv1.NetworkPolicyPort {
+	var ports []networkingv1.NetworkPolicyPort
+	add := func(port networkingv1.NetworkPolicyPort) {
+		if !slices.ContainsFunc(ports, func(p networkingv1.NetworkPolicyPort) bool {
+			return *p.Protocol == *port.Protocol && *p.Port == *port.Port
+		}) {
+			ports = append(ports, port)
+		}
+	}
+
+	if service := e.Resources.GetServiceForIntegration(e.Integration); service != nil {
+		for _, servicePort := range service.Spec.Ports {
+			targetPort := servicePort.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				targetPort = intstr.FromInt32(servicePort.Port)
+			}
+			add(networkPolicyPort(servicePort.Protocol, targetPort))
+		}
+	}
+	if trait, ok := e.GetTrait(containerTraitID).(*containerTrait); ok {
+		for _, cp := range trait.containerPorts {
+			add(networkPolicyPort(corev1.Protocol(cp.protocol), intstr.FromInt32(cp.port)))
+		}
+	}
+
+	return ports
+}
+
+func (t *networkPolicyTrait) egressRules(e *Environment) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			// DNS
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolUDP, intstr.FromInt32(dnsPort)),
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(dnsPort)),
+			},
+		},
+	}
+
+	if e.GetTrait(knativeServiceTraitID) != nil || e.GetTrait(knativeTraitID) != nil {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{namespacesPeer(t.knativeNamespaces())},
+		})
+	}
+
+	endpoints, err := t.egressEndpoints(e)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoint := range endpoints {
+		rule, err := t.egressRuleFor(e, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil && !slices.ContainsFunc(rules, func(r networkingv1.NetworkPolicyEgressRule) bool {
+			return equality.Semantic.DeepEqual(r, *rule)
+		}) {
+			rules = append(rules, *rule)
+		}
+	}
+
+	for _, cidr := range t.EgressCIDRs {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}},
+		})
+	}
+
+	return rules, nil
+}
+
+// egressEndpoints returns the endpoints inferred from the Integration routes, along with the configured egress hosts.
+func (t *networkPolicyTrait) egressEndpoints(e *Environment) ([]uri.Endpoint, error) {
+	var endpoints []uri.Endpoint
+	add := func(endpoint uri.Endpoint) {
+		if !slices.Contains(endpoints, endpoint) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	if _, err := e.ConsumeMeta(false, func(meta metadata.IntegrationMetadata) bool {
+		for _, u := range append(meta.FromURIs, meta.ToURIs...) {
+			for _, endpoint := range uri.GetEndpoints(u) {
+				add(endpoint)
+			}
+		}
+
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	for _, host := range t.EgressHosts {
+		if endpoint, ok := parseEgressHost(host); ok {
+			add(endpoint)
+		}
+	}
+	// Keep the rules stable across reconciliations
+	slices.SortFunc(endpoints, func(a, b uri.Endpoint) int {
+		if c := strings.Compare(a.Host, b.Host); c != 0 {
+			return c
+		}
+
+		return int(a.Port - b.Port)
+	})
+
+	return endpoints, nil
+}
+
+// egressRuleFor returns the rule allowing the traffic to the given endpoint, either to the pods of an in-cluster
+// Service, to its IP address, or to its port for the external hosts. The hosts are never resolved, so that the rules
+// do not depend on the addresses they resolve to at the time the Integration is deployed.
+func (t *networkPolicyTrait) egressRuleFor(e *Environment, endpoint uri.Endpoint) (*networkingv1.NetworkPolicyEgressRule, error) {
+	if ip := net.ParseIP(endpoint.Host); ip != nil {
+		return &networkingv1.NetworkPolicyEgressRule{
+			To:    []networkingv1.NetworkPolicyPeer{ipPeer(ip)},
+			Ports: endpointPorts(endpoint.Port),
+		}, nil
+	}
+
+	if rule := t.serviceEgressRuleFor(e, endpoint); rule != nil {
+		return rule, nil
+	}
+
+	if endpoint.Port == 0 {
+		t.L.ForIntegration(e.Integration).Infof("Skipping egress host %s whose port cannot be inferred, "+
+			"its IP block must be declared with the egress-cidrs option", endpoint.Host)
+
+		return nil, nil
+	}
+
+	// Any destination on the endpoint port, as the host addresses may change over time
+	return &networkingv1.NetworkPolicyEgressRule{
+		Ports: endpointPorts(endpoint.Port),
+	}, nil
+}
+
+// serviceEgressRuleFor returns the rule allowing the traffic to the pods of the in-cluster Service the endpoint host
+// refers to, ie, `service`, `service.namespace.svc` or `service.namespace.svc.cluster.local`, if any.
+func (t *networkPolicyTrait) serviceEgressRuleFor(e *Environment, endpoint uri.Endpoint) *networkingv1.NetworkPolicyEgressRule {
+	name, namespace, ok := serviceHost(endpoint.Host, e.Integration.Namespace)
+	if !ok {
+		return nil
+	}
+
+	service := corev1.Service{}
+	if err := t.Client.Get(e.Ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, &service); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			t.L.ForIntegration(e.Integration).Infof("Skipping lookup of egress Service %s/%s: %v", namespace, name, err)
+		}
+
+		return nil
+	}
+	if len(service.Spec.Selector) == 0 {
+		// The Service does not target pods, e.g., ExternalName Services
+		return nil
+	}
+
+	rule := networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{namespaceNameLabel: namespace},
+				},
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: service.Spec.Selector,
+				},
+			},
+		},
+	}
+	// The policy applies to the pods ports the Service port targets
+	for _, servicePort := range service.Spec.Ports {
+		if endpoint.Port != 0 && servicePort.Port != endpoint.Port {
+			continue
+		}
+		targetPort := servicePort.TargetPort
+		if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+			targetPort = intstr.FromInt32(servicePort.Port)
+		}
+		rule.Ports = append(rule.Ports, networkPolicyPort(servicePort.Protocol, targetPort))
+	}
+
+	return &rule
+}
+
+// serviceHost returns the name and namespace of the Service the host refers to, when it uses one of the in-cluster
+// Service DNS names. The `service.namespace` form is not matched, as it cannot be told from an external host.
+func serviceHost(host string, namespace string) (string, string, bool) {
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 1:
+		return parts[0], namespace, true
+	case len(parts) == 3 && parts[2] == "svc":
+		return parts[0], parts[1], true
+	case len(parts) == 5 && parts[2] == "svc" && parts[3] == "cluster" && parts[4] == "local":
+		return parts[0], parts[1], true
+	default:
+		return "", "", false
+	}
+}
+
+func (t *networkPolicyTrait) knativeNamespaces() []string {
+	if len(t.KnativeNamespaces) > 0 {
+		return t.KnativeNamespaces
+	}
+
+	return defaultKnativeNamespaces
+}
+
+func parseEgressHost(host string) (uri.Endpoint, bool) {
+	h, p, err := net.SplitHostPort(host)
+	if err != nil {
+		return uri.Endpoint{Host: strings.Trim(host, "[]")}, host != ""
+	}
+	port, err := strconv.ParseInt(p, 10, 32)
+	if err != nil || h == "" {
+		return uri.Endpoint{}, false
+	}
+
+	return uri.Endpoint{Host: h, Port: int32(port)}, true
+}
+
+func namespacesPeer(namespaces []string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      namespaceNameLabel,
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   namespaces,
+				},
+			},
+		},
+	}
+}
+
+func ipPeer(ip net.IP) networkingv1.NetworkPolicyPeer {
+	cidr := ip.String() + "/32"
+	if ip.To4() == nil {
+		cidr = ip.String() + "/128"
+	}
+
+	return networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}
+}
+
+func endpointPorts(port int32) []networkingv1.NetworkPolicyPort {
+	if port == 0 {
+		// Any port
+		return nil
+	}
+
+	return []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(port))}
+}
+
+func networkPolicyPort(protocol corev1.Protocol, port intstr.IntOrString) networkingv1.NetworkPolicyPort {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+
+	return networkingv1.NetworkPolicyPort{
+		Protocol: &protocol,
+		Port:     &port,
+	}
+}
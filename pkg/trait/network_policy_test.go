@@ -0,0 +1,296 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestNetworkPolicyDisabledByDefault(t *testing.T) {
+	environment := networkPolicyEnv(t, `from("netty-http:test").log("hello");`, nil)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.Nil(t, environment.GetTrait(networkPolicyTraitID))
+	assert.Nil(t, getNetworkPolicy(environment.Resources))
+}
+
+func TestNetworkPolicyIngressOnExposedPorts(t *testing.T) {
+	environment := networkPolicyEnv(t, `from("netty-http:test").log("hello");`, &traitv1.NetworkPolicyTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(networkPolicyTraitID))
+
+	networkPolicy := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, networkPolicy)
+	assert.Equal(t, ServiceTestName, networkPolicy.Name)
+	assert.Equal(t, "ns", networkPolicy.Namespace)
+	assert.Equal(t, map[string]string{v1.IntegrationLabel: ServiceTestName}, networkPolicy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, networkPolicy.Spec.PolicyTypes)
+	assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromString(defaultContainerPortName)),
+			},
+		},
+	}, networkPolicy.Spec.Ingress)
+	assert.Empty(t, networkPolicy.Spec.Egress)
+}
+
+func TestNetworkPolicyIngressNamespacesAndContainerPorts(t *testing.T) {
+	environment := networkPolicyEnv(t, `from("netty-http:test").log("hello");`, &traitv1.NetworkPolicyTrait{
+		Trait:             traitv1.Trait{Enabled: ptr.To(true)},
+		IngressNamespaces: []string{"frontend"},
+	})
+	environment.Integration.Spec.Traits.Container = &traitv1.ContainerTrait{
+		Ports: []string{"grpc;9090", "udp;5000;UDP"},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	networkPolicy := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, networkPolicy)
+	require.Len(t, networkPolicy.Spec.Ingress, 1)
+	assert.Equal(t, []networkingv1.NetworkPolicyPort{
+		networkPolicyPort(corev1.ProtocolTCP, intstr.FromString(defaultContainerPortName)),
+		networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(9090)),
+		networkPolicyPort(corev1.ProtocolUDP, intstr.FromInt32(5000)),
+	}, networkPolicy.Spec.Ingress[0].Ports)
+	assert.Equal(t, []networkingv1.NetworkPolicyPeer{namespacesPeer([]string{"ns", "frontend"})}, networkPolicy.Spec.Ingress[0].From)
+}
+
+func TestNetworkPolicyDenyIngressWithoutExposedPorts(t *testing.T) {
+	environment := networkPolicyEnv(t, `from("timer:tick").log("hello");`, &traitv1.NetworkPolicyTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	networkPolicy := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, networkPolicy)
+	assert.NotNil(t, networkPolicy.Spec.Ingress)
+	assert.Empty(t, networkPolicy.Spec.Ingress)
+}
+
+func TestNetworkPolicyIngressFromPrometheus(t *testing.T) {
+	environment := networkPolicyEnv(t, `from("timer:tick").log("hello");`, &traitv1.NetworkPolicyTrait{
+		Trait:               traitv1.Trait{Enabled: ptr.To(true)},
+		PrometheusPodLabels: []string{"app=prometheus"},
+	})
+	environment.Integration.Spec.Traits.Prometheus = &traitv1.PrometheusTrait{
+		Trait:      traitv1.Trait{Enabled: ptr.To(true)},
+		PodMonitor: ptr.To(false),
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	networkPolicy := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, networkPolicy)
+	assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(defaultContainerPort)),
+			},
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "prometheus"},
+					},
+				},
+			},
+		},
+	}, networkPolicy.Spec.Ingress)
+}
+
+func TestNetworkPolicyEgress(t *testing.T) {
+	environment := networkPolicyEnv(t, `
+		from("timer:tick")
+			.to("http://my-service:8080/orders")
+			.to("https://api.example.com/v1")
+			.to("https://other.example.com")
+			.to("kafka:orders?brokers=10.0.0.1:9092,broker.example");`,
+		&traitv1.NetworkPolicyTrait{
+			Trait:       traitv1.Trait{Enabled: ptr.To(true)},
+			Egress:      ptr.To(true),
+			EgressHosts: []string{"db.other.svc:5432"},
+			EgressCIDRs: []string{"192.168.0.0/16"},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-service", Namespace: "ns"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "orders"},
+				Ports: []corev1.ServicePort{
+					{Port: 8080, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+					{Port: 9090, TargetPort: intstr.FromInt32(9090), Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "other"},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "db"},
+				Ports:    []corev1.ServicePort{{Port: 5432}},
+			},
+		},
+	)
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	networkPolicy := getNetworkPolicy(environment.Resources)
+	require.NotNil(t, networkPolicy)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, networkPolicy.Spec.PolicyTypes)
+	assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolUDP, intstr.FromInt32(53)),
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(53)),
+			},
+		},
+		{
+			To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}},
+			Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(9092))},
+		},
+		{
+			// the external hosts are not resolved
+			Ports: []networkingv1.NetworkPolicyPort{networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(443))},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "other"}},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromInt32(5432)),
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "ns"}},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "orders"}},
+				},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolTCP, intstr.FromString("http")),
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "192.168.0.0/16"}}},
+		},
+	}, networkPolicy.Spec.Egress)
+}
+
+func TestNetworkPolicyServiceHost(t *testing.T) {
+	tests := []struct {
+		host      string
+		name      string
+		namespace string
+		ok        bool
+	}{
+		{host: "my-service", name: "my-service", namespace: "ns", ok: true},
+		{host: "my-service.other.svc", name: "my-service", namespace: "other", ok: true},
+		{host: "my-service.other.svc.cluster.local", name: "my-service", namespace: "other", ok: true},
+		{host: "broker.example"},
+		{host: "my-service.other.svc.example.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			name, namespace, ok := serviceHost(test.host, "ns")
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.name, name)
+			assert.Equal(t, test.namespace, namespace)
+		})
+	}
+}
+
+func TestNetworkPolicyInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait traitv1.NetworkPolicyTrait
+		err   string
+	}{
+		{
+			name:  "invalid egress CIDR",
+			trait: traitv1.NetworkPolicyTrait{EgressCIDRs: []string{"10.0.0.0/33"}},
+			err:   "could not parse egress CIDR 10.0.0.0/33",
+		},
+		{
+			name:  "invalid egress host",
+			trait: traitv1.NetworkPolicyTrait{EgressHosts: []string{"db:port"}},
+			err:   "could not parse egress host db:port",
+		},
+		{
+			name:  "invalid prometheus pod labels",
+			trait: traitv1.NetworkPolicyTrait{PrometheusPodLabels: []string{"prometheus"}},
+			err:   "unable to parse key/value pair: prometheus",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			networkPolicy := test.trait
+			networkPolicy.Enabled = ptr.To(true)
+			environment := networkPolicyEnv(t, `from("timer:tick").log("hello");`, &networkPolicy)
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func getNetworkPolicy(resources *kubernetes.Collection) *networkingv1.NetworkPolicy {
+	for _, r := range resources.Items() {
+		if networkPolicy, ok := r.(*networkingv1.NetworkPolicy); ok {
+			return networkPolicy
+		}
+	}
+
+	return nil
+}
+
+func networkPolicyEnv(t *testing.T, route string, networkPolicy *traitv1.NetworkPolicyTrait, objects ...runtime.Object) Environment {
+	t.Helper()
+
+	return newRouteTestEnv(t, route, v1.Traits{NetworkPolicy: networkPolicy}, objects...)
+}
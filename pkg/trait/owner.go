@@ -103,6 +103,10 @@ func (t *ownerTrait) Apply(e *Environment) error {
 		t.propagateLabelAndAnnotations(&deployment.Spec.Template, targetLabels, targetAnnotations)
 	})
 
+	e.Resources.VisitStatefulSet(func(statefulSet *appsv1.StatefulSet) {
+		t.propagateLabelAndAnnotations(&statefulSet.Spec.Template, targetLabels, targetAnnotations)
+	})
+
 	e.Resources.VisitKnativeService(func(service *serving.Service) {
 		t.propagateLabelAndAnnotations(&service.Spec.Template, targetLabels, targetAnnotations)
 	})
@@ -96,6 +96,15 @@ func (t *podTrait) Apply(e *Environment) error {
 			}
 		})
 
+	case ControllerStrategyStatefulSet:
+		e.Resources.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+			if s.Name == e.Integration.Name {
+				if patchedPodSpec, err = t.applyChangesTo(&s.Spec.Template.Spec, changes); err == nil {
+					s.Spec.Template.Spec = *patchedPodSpec
+				}
+			}
+		})
+
 	case ControllerStrategyKnativeService:
 		e.Resources.VisitKnativeService(func(s *serving.Service) {
 			if s.Name == e.Integration.Name {
@@ -289,6 +289,10 @@ func (t *quarkusTrait) newIntegrationKit(e *Environment, packageType quarkusPack
 		v1.SetAnnotation(&kit.ObjectMeta, v1.PlatformSelectorAnnotation, v)
 	}
 
+	if integration.Status.Digest != "" {
+		v1.SetAnnotation(&kit.ObjectMeta, v1.IntegrationDigestAnnotation, integration.Status.Digest)
+	}
+
 	if v, ok := integration.Annotations[v1.IntegrationProfileAnnotation]; ok {
 		v1.SetAnnotation(&kit.ObjectMeta, v1.IntegrationProfileAnnotation, v)
 
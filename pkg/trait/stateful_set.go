@@ -0,0 +1,363 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	statefulSetTraitID               = "stateful-set"
+	statefulSetTraitOrder            = 1100
+	statefulSetStrategySelectorOrder = 50
+
+	statefulSetHeadlessServiceSuffix = "-headless"
+)
+
+type statefulSetTrait struct {
+	BaseTrait
+	traitv1.StatefulSetTrait `property:",squash"`
+}
+
+var _ ControllerStrategySelector = &statefulSetTrait{}
+
+func newStatefulSetTrait() Trait {
+	return &statefulSetTrait{
+		BaseTrait: NewBaseTrait(statefulSetTraitID, statefulSetTraitOrder),
+	}
+}
+
+func (t *statefulSetTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil {
+		return false, nil, nil
+	}
+	if !ptr.Deref(t.Enabled, true) {
+		return false, NewIntegrationConditionUserDisabled("StatefulSet"), nil
+	}
+	if !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	if e.IntegrationInPhase(v1.IntegrationPhaseRunning, v1.IntegrationPhaseError) {
+		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionStatefulSetAvailable)
+
+		return condition != nil && condition.Status == corev1.ConditionTrue, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, t.notAvailableCondition(err.Error()), err
+	}
+	if strategy != ControllerStrategyStatefulSet {
+		return false, nil, nil
+	}
+
+	if t.ServiceName == e.Integration.Name {
+		err := fmt.Errorf("the service name of the stateful-set trait must differ from the integration name %s", e.Integration.Name)
+
+		return false, t.notAvailableCondition(err.Error()), err
+	}
+	switch t.PodManagementPolicy {
+	case "", appsv1.OrderedReadyPodManagement, appsv1.ParallelPodManagement:
+	default:
+		err := fmt.Errorf("unsupported pod management policy %s for the stateful-set trait: must be one of %s, %s",
+			t.PodManagementPolicy, appsv1.OrderedReadyPodManagement, appsv1.ParallelPodManagement)
+
+		return false, t.notAvailableCondition(err.Error()), err
+	}
+	switch t.UpdateStrategy {
+	case "", appsv1.RollingUpdateStatefulSetStrategyType, appsv1.OnDeleteStatefulSetStrategyType:
+	default:
+		err := fmt.Errorf("unsupported update strategy %s for the stateful-set trait: must be one of %s, %s",
+			t.UpdateStrategy, appsv1.RollingUpdateStatefulSetStrategyType, appsv1.OnDeleteStatefulSetStrategyType)
+
+		return false, t.notAvailableCondition(err.Error()), err
+	}
+	for _, v := range t.VolumeClaimTemplates {
+		if _, _, err := ParseVolumeClaimTemplate(v); err != nil {
+			return false, t.notAvailableCondition(err.Error()), err
+		}
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying) {
+		return false, nil, nil
+	}
+	if err := t.checkImmutableFields(e); err != nil {
+		return false, t.notAvailableCondition(err.Error()), err
+	}
+
+	return true, nil, nil
+}
+
+// checkImmutableFields fails when the StatefulSet already exists with different values for the fields
+// that cannot be updated, as the changes would otherwise be rejected by the cluster when applied.
+func (t *statefulSetTrait) checkImmutableFields(e *Environment) error {
+	statefulSet, err := t.getStatefulSetFor(e)
+	if err != nil {
+		return err
+	}
+	existing := &appsv1.StatefulSet{}
+	err = e.Client.Get(e.Ctx, ctrl.ObjectKeyFromObject(statefulSet), existing)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	changed := changedStatefulSetImmutableFields(existing, statefulSet)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"the %s of the StatefulSet %s cannot be changed: delete the StatefulSet, for instance with "+
+			"`kubectl delete statefulset %s --cascade=orphan` to keep the pods running, so that it's re-created",
+		strings.Join(changed, ", "), existing.Name, existing.Name,
+	)
+}
+
+// changedStatefulSetImmutableFields returns the fields of the existing StatefulSet that cannot be updated
+// and that differ from the expected StatefulSet.
+func changedStatefulSetImmutableFields(existing *appsv1.StatefulSet, expected *appsv1.StatefulSet) []string {
+	changed := make([]string, 0)
+	if existing.Spec.ServiceName != expected.Spec.ServiceName {
+		changed = append(changed, "serviceName")
+	}
+	// The policy is defaulted by the cluster
+	if existing.Spec.PodManagementPolicy != expected.Spec.PodManagementPolicy &&
+		(existing.Spec.PodManagementPolicy != appsv1.OrderedReadyPodManagement || expected.Spec.PodManagementPolicy != "") {
+		changed = append(changed, "podManagementPolicy")
+	}
+	if !equality.Semantic.DeepEqual(existing.Spec.Selector, expected.Spec.Selector) {
+		changed = append(changed, "selector")
+	}
+	if !sameVolumeClaimTemplates(existing.Spec.VolumeClaimTemplates, expected.Spec.VolumeClaimTemplates) {
+		changed = append(changed, "volumeClaimTemplates")
+	}
+
+	return changed
+}
+
+// sameVolumeClaimTemplates compares the fields set from the trait configuration only, as the other
+// fields of the existing templates are defaulted by the cluster.
+func sameVolumeClaimTemplates(existing []corev1.PersistentVolumeClaim, expected []corev1.PersistentVolumeClaim) bool {
+	if len(existing) != len(expected) {
+		return false
+	}
+	for i := range expected {
+		if existing[i].Name != expected[i].Name ||
+			!slices.Equal(existing[i].Spec.AccessModes, expected[i].Spec.AccessModes) ||
+			ptr.Deref(existing[i].Spec.StorageClassName, "") != ptr.Deref(expected[i].Spec.StorageClassName, "") {
+			return false
+		}
+		existingSize := existing[i].Spec.Resources.Requests[corev1.ResourceStorage]
+		if existingSize.Cmp(expected[i].Spec.Resources.Requests[corev1.ResourceStorage]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *statefulSetTrait) notAvailableCondition(message string) *TraitCondition {
+	return NewIntegrationCondition(
+		"StatefulSet",
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionFalse,
+		v1.IntegrationConditionStatefulSetNotAvailableReason,
+		message,
+	)
+}
+
+// SelectControllerStrategy selects the StatefulSet strategy when the trait is explicitly enabled.
+func (t *statefulSetTrait) SelectControllerStrategy(e *Environment) (*ControllerStrategy, error) {
+	if ptr.Deref(t.Enabled, false) {
+		statefulSetStrategy := ControllerStrategyStatefulSet
+
+		return &statefulSetStrategy, nil
+	}
+
+	return nil, nil
+}
+
+func (t *statefulSetTrait) ControllerStrategySelectorOrder() int {
+	return statefulSetStrategySelectorOrder
+}
+
+func (t *statefulSetTrait) Apply(e *Environment) error {
+	statefulSet, err := t.getStatefulSetFor(e)
+	if err != nil {
+		return err
+	}
+	e.Resources.Add(statefulSet)
+	// The headless Service is not labelled with the integration label yet, so that it's not
+	// mistaken for the Integration Service by the other traits. The label is added afterward
+	// by the gc trait, along with the generation label.
+	e.Resources.Add(t.getHeadlessServiceFor(e, statefulSet.Spec.ServiceName))
+
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionStatefulSetAvailableReason,
+		"statefulset name is "+statefulSet.Name,
+	)
+
+	return nil
+}
+
+func (t *statefulSetTrait) getServiceName(e *Environment) string {
+	if t.ServiceName != "" {
+		return t.ServiceName
+	}
+
+	return e.Integration.Name + statefulSetHeadlessServiceSuffix
+}
+
+func (t *statefulSetTrait) getStatefulSetFor(e *Environment) (*appsv1.StatefulSet, error) {
+	// create a copy to avoid sharing the underlying annotation map
+	annotations := make(map[string]string)
+	if e.Integration.Annotations != nil {
+		maps.Copy(annotations, filterTransferableAnnotations(e.Integration.Annotations))
+	}
+
+	// Set the default container annotation for kubectl commands
+	annotations[defaultContainerAnnotation] = defaultContainerName
+
+	// StatefulSet replicas defaults to 1, so we avoid forcing
+	// an update to nil that will result to another update cycle
+	// back to that default value by the StatefulSet controller.
+	replicas := e.Integration.Spec.Replicas
+	if replicas == nil {
+		replicas = ptr.To(int32(1))
+	}
+
+	statefulSet := appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        e.Integration.Name,
+			Namespace:   e.Integration.Namespace,
+			Labels:      kubernetes.DeploymentLabels(e.Integration.Name),
+			Annotations: annotations,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            replicas,
+			ServiceName:         t.getServiceName(e),
+			PodManagementPolicy: t.PodManagementPolicy,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						v1.IntegrationLabel: e.Integration.Name,
+					},
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: e.Integration.Spec.ServiceAccountName,
+				},
+			},
+		},
+	}
+
+	switch t.UpdateStrategy {
+	case appsv1.OnDeleteStatefulSetStrategyType:
+		statefulSet.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: t.UpdateStrategy,
+		}
+	case appsv1.RollingUpdateStatefulSetStrategyType, "":
+		statefulSet.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		}
+		if t.RollingUpdatePartition != nil {
+			statefulSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{
+				Partition: t.RollingUpdatePartition,
+			}
+		}
+	}
+
+	if t.PersistentVolumeClaimWhenDeleted != "" || t.PersistentVolumeClaimWhenScaled != "" {
+		policy := appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+			WhenDeleted: appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+			WhenScaled:  appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+		}
+		if t.PersistentVolumeClaimWhenDeleted != "" {
+			policy.WhenDeleted = t.PersistentVolumeClaimWhenDeleted
+		}
+		if t.PersistentVolumeClaimWhenScaled != "" {
+			policy.WhenScaled = t.PersistentVolumeClaimWhenScaled
+		}
+		statefulSet.Spec.PersistentVolumeClaimRetentionPolicy = &policy
+	}
+
+	// The volumes are mounted in the integration container by the mount trait
+	for _, v := range t.VolumeClaimTemplates {
+		pvc, _, err := ParseVolumeClaimTemplate(v)
+		if err != nil {
+			return nil, err
+		}
+		statefulSet.Spec.VolumeClaimTemplates = append(statefulSet.Spec.VolumeClaimTemplates, *pvc)
+	}
+
+	return &statefulSet, nil
+}
+
+func (t *statefulSetTrait) getHeadlessServiceFor(e *Environment, name string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				"camel.apache.org/service.type": v1.ServiceTypeHeadless,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+			// The pods are resolvable as soon as they are created, so that
+			// the replicas can discover each other while starting up
+			PublishNotReadyAddresses: true,
+		},
+	}
+}
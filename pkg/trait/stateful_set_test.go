@@ -0,0 +1,311 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestStatefulSet(t *testing.T) {
+	environment := statefulSetEnv(t, v1.Traits{
+		StatefulSet: &traitv1.StatefulSetTrait{
+			Trait: traitv1.Trait{Enabled: ptr.To(true)},
+		},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(statefulSetTraitID))
+	assert.Nil(t, environment.GetTrait(deploymentTraitID))
+	assert.Nil(t, environment.Resources.GetDeploymentForIntegration(environment.Integration))
+
+	statefulSet := getStatefulSet(environment.Resources)
+	require.NotNil(t, statefulSet)
+	assert.Equal(t, ServiceTestName, statefulSet.Name)
+	assert.Equal(t, ServiceTestName+"-headless", statefulSet.Spec.ServiceName)
+	assert.Equal(t, ptr.To(int32(1)), statefulSet.Spec.Replicas)
+	assert.Equal(t, appsv1.RollingUpdateStatefulSetStrategyType, statefulSet.Spec.UpdateStrategy.Type)
+	assert.Nil(t, statefulSet.Spec.UpdateStrategy.RollingUpdate)
+	assert.Nil(t, statefulSet.Spec.PersistentVolumeClaimRetentionPolicy)
+	assert.Empty(t, statefulSet.Spec.VolumeClaimTemplates)
+	assert.Equal(t, ServiceTestName, statefulSet.Spec.Selector.MatchLabels[v1.IntegrationLabel])
+	require.Len(t, statefulSet.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, defaultContainerName, statefulSet.Spec.Template.Spec.Containers[0].Name)
+
+	service := environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == ServiceTestName+"-headless"
+	})
+	require.NotNil(t, service)
+	assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.Equal(t, v1.ServiceTypeHeadless, service.Labels["camel.apache.org/service.type"])
+	assert.Equal(t, ServiceTestName, service.Spec.Selector[v1.IntegrationLabel])
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionStatefulSetAvailable)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "statefulset name is "+ServiceTestName, condition.Message)
+}
+
+func TestStatefulSetWithDeployerKind(t *testing.T) {
+	environment := statefulSetEnv(t, v1.Traits{
+		Deployer: &traitv1.DeployerTrait{
+			Kind: "stateful-set",
+		},
+	})
+	environment.Integration.Spec.Replicas = ptr.To(int32(3))
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(statefulSetTraitID))
+
+	statefulSet := getStatefulSet(environment.Resources)
+	require.NotNil(t, statefulSet)
+	assert.Equal(t, ptr.To(int32(3)), statefulSet.Spec.Replicas)
+}
+
+func TestStatefulSetVolumeClaimTemplates(t *testing.T) {
+	environment := statefulSetEnv(t, v1.Traits{
+		StatefulSet: &traitv1.StatefulSetTrait{
+			Trait:                           traitv1.Trait{Enabled: ptr.To(true)},
+			ServiceName:                     "my-service",
+			PodManagementPolicy:             appsv1.ParallelPodManagement,
+			RollingUpdatePartition:          ptr.To(int32(2)),
+			PersistentVolumeClaimWhenScaled: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			VolumeClaimTemplates:            []string{"data:/var/data:1Gi", "markers:/var/markers:500Mi:ReadWriteOncePod:fast"},
+		},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	statefulSet := getStatefulSet(environment.Resources)
+	require.NotNil(t, statefulSet)
+	assert.Equal(t, "my-service", statefulSet.Spec.ServiceName)
+	assert.Equal(t, appsv1.ParallelPodManagement, statefulSet.Spec.PodManagementPolicy)
+	assert.Equal(t, &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To(int32(2))}, statefulSet.Spec.UpdateStrategy.RollingUpdate)
+	assert.Equal(t, &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: appsv1.RetainPersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+	}, statefulSet.Spec.PersistentVolumeClaimRetentionPolicy)
+
+	require.Len(t, statefulSet.Spec.VolumeClaimTemplates, 2)
+	data := statefulSet.Spec.VolumeClaimTemplates[0]
+	assert.Equal(t, "data", data.Name)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, data.Spec.AccessModes)
+	assert.Equal(t, resource.MustParse("1Gi"), data.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Nil(t, data.Spec.StorageClassName)
+	markers := statefulSet.Spec.VolumeClaimTemplates[1]
+	assert.Equal(t, "markers", markers.Name)
+	assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod}, markers.Spec.AccessModes)
+	assert.Equal(t, ptr.To("fast"), markers.Spec.StorageClassName)
+
+	// The claimed volumes are mounted in the integration container
+	container := environment.GetIntegrationContainer()
+	require.NotNil(t, container)
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "data", MountPath: "/var/data"})
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "markers", MountPath: "/var/markers"})
+	for _, volume := range statefulSet.Spec.Template.Spec.Volumes {
+		assert.NotEqual(t, "data", volume.Name)
+		assert.NotEqual(t, "markers", volume.Name)
+	}
+
+	assert.NotNil(t, environment.Resources.GetService(func(s *corev1.Service) bool {
+		return s.Name == "my-service"
+	}))
+}
+
+func TestStatefulSetInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait *traitv1.StatefulSetTrait
+		err   string
+	}{
+		{
+			name:  "missing size",
+			trait: &traitv1.StatefulSetTrait{VolumeClaimTemplates: []string{"data:/var/data"}},
+			err:   "volume claim template syntax error",
+		},
+		{
+			name:  "invalid size",
+			trait: &traitv1.StatefulSetTrait{VolumeClaimTemplates: []string{"data:/var/data:big"}},
+			err:   "could not parse size big",
+		},
+		{
+			name:  "invalid access mode",
+			trait: &traitv1.StatefulSetTrait{VolumeClaimTemplates: []string{"data:/var/data:1Gi:ReadWriteSometimes"}},
+			err:   "unsupported access mode ReadWriteSometimes",
+		},
+		{
+			name:  "invalid pod management policy",
+			trait: &traitv1.StatefulSetTrait{PodManagementPolicy: "Random"},
+			err:   "unsupported pod management policy Random",
+		},
+		{
+			name:  "invalid update strategy",
+			trait: &traitv1.StatefulSetTrait{UpdateStrategy: "Recreate"},
+			err:   "unsupported update strategy Recreate",
+		},
+		{
+			name:  "integration service name",
+			trait: &traitv1.StatefulSetTrait{ServiceName: ServiceTestName},
+			err:   "must differ from the integration name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.trait.Enabled = ptr.To(true)
+			environment := statefulSetEnv(t, v1.Traits{StatefulSet: test.trait})
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestStatefulSetImmutableFields(t *testing.T) {
+	existing := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: "ns",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			ServiceName:         ServiceTestName + "-headless",
+			PodManagementPolicy: appsv1.OrderedReadyPodManagement,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: ServiceTestName,
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("1024Mi"),
+							},
+						},
+						VolumeMode: ptr.To(corev1.PersistentVolumeFilesystem),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		trait *traitv1.StatefulSetTrait
+		err   string
+	}{
+		{
+			name:  "unchanged",
+			trait: &traitv1.StatefulSetTrait{VolumeClaimTemplates: []string{"data:/var/data:1Gi"}},
+		},
+		{
+			name: "changed service name",
+			trait: &traitv1.StatefulSetTrait{
+				ServiceName:          "my-service",
+				VolumeClaimTemplates: []string{"data:/var/data:1Gi"},
+			},
+			err: "the serviceName of the StatefulSet " + ServiceTestName + " cannot be changed",
+		},
+		{
+			name: "changed pod management policy and volume claim templates",
+			trait: &traitv1.StatefulSetTrait{
+				PodManagementPolicy:  appsv1.ParallelPodManagement,
+				VolumeClaimTemplates: []string{"data:/var/data:2Gi"},
+			},
+			err: "the podManagementPolicy, volumeClaimTemplates of the StatefulSet " + ServiceTestName + " cannot be changed",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.trait.Enabled = ptr.To(true)
+			environment := statefulSetEnv(t, v1.Traits{StatefulSet: test.trait}, existing.DeepCopy())
+
+			conditions, _, err := environment.Catalog.apply(&environment)
+			if test.err == "" {
+				require.NoError(t, err)
+				assert.NotNil(t, getStatefulSet(environment.Resources))
+
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+			assert.Contains(t, err.Error(), "--cascade=orphan")
+			assert.Contains(t, conditions, NewIntegrationCondition(
+				"StatefulSet",
+				v1.IntegrationConditionStatefulSetAvailable,
+				corev1.ConditionFalse,
+				v1.IntegrationConditionStatefulSetNotAvailableReason,
+				errors.Unwrap(err).Error(),
+			))
+		})
+	}
+}
+
+func TestConfigureStatefulSetTraitWhileIntegrationIsRunning(t *testing.T) {
+	environment := statefulSetEnv(t, v1.Traits{})
+	environment.Integration.Status.Phase = v1.IntegrationPhaseRunning
+	trait, _ := newStatefulSetTrait().(*statefulSetTrait)
+
+	configured, condition, err := trait.Configure(&environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.False(t, configured)
+
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionStatefulSetAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionStatefulSetAvailableReason,
+		"statefulset name is "+ServiceTestName,
+	)
+	configured, condition, err = trait.Configure(&environment)
+	require.NoError(t, err)
+	assert.Nil(t, condition)
+	assert.True(t, configured)
+}
+
+func getStatefulSet(resources *kubernetes.Collection) *appsv1.StatefulSet {
+	return resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == ServiceTestName
+	})
+}
+
+func statefulSetEnv(t *testing.T, traits v1.Traits, objects ...runtime.Object) Environment {
+	t.Helper()
+
+	return newRouteTestEnv(t, `from("file:/var/data/in?idempotent=true").log("${body}");`, traits, objects...)
+}
@@ -21,8 +21,10 @@ func init() {
 	// List of default trait factories.
 	// Declaration order is not important, but let's keep them sorted for debugging.
 	AddToTraits(newAffinityTrait)
+	AddToTraits(newAutoRollbackTrait)
 	AddToTraits(newBuilderTrait)
 	AddToTraits(newCamelTrait)
+	AddToTraits(newCanaryTrait)
 	AddToTraits(newContainerTrait)
 	AddToTraits(newCronTrait)
 	AddToTraits(newDependenciesTrait)
@@ -34,6 +36,7 @@ func init() {
 	AddToTraits(newGitTrait)
 	AddToTraits(newGitOpsTrait)
 	AddToTraits(newHealthTrait)
+	AddToTraits(newHPATrait)
 	AddToTraits(newInitContainersTrait)
 	AddToTraits(NewInitTrait)
 	AddToTraits(newIngressTrait)
@@ -44,9 +47,11 @@ func init() {
 	AddToTraits(newKedaTrait)
 	AddToTraits(newKnativeTrait)
 	AddToTraits(newKnativeServiceTrait)
+	AddToTraits(newLogShippingTrait)
 	AddToTraits(newLoggingTraitTrait)
 	AddToTraits(NewMasterTrait)
 	AddToTraits(newMountTrait)
+	AddToTraits(newNetworkPolicyTrait)
 	AddToTraits(newOwnerTrait)
 	AddToTraits(newPdbTrait)
 	AddToTraits(newPodTrait)
@@ -56,7 +61,9 @@ func init() {
 	AddToTraits(newRouteTrait)
 	AddToTraits(newSecurityContextTrait)
 	AddToTraits(newServiceTrait)
+	AddToTraits(newStatefulSetTrait)
 	AddToTraits(NewTelemetryTrait)
 	AddToTraits(newTolerationTrait)
+	AddToTraits(newVPATrait)
 	// ^^ Declaration order is not important, but let's keep them sorted for debugging.
 }
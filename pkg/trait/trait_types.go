@@ -232,6 +232,8 @@ type Environment struct {
 	ExecutedTraits        []Trait
 	EnvVars               []corev1.EnvVar
 	ApplicationProperties map[string]string
+	// The policy used to retry the Build executing the pipeline.
+	BuildRetryPolicy *v1.BuildRetryPolicy
 }
 
 // ControllerStrategy is used to determine the kind of controller that needs to be created for the integration.
@@ -240,6 +242,7 @@ type ControllerStrategy string
 // List of controller strategies.
 const (
 	ControllerStrategyDeployment     ControllerStrategy = "deployment"
+	ControllerStrategyStatefulSet    ControllerStrategy = "stateful-set"
 	ControllerStrategyKnativeService ControllerStrategy = "knative-service"
 	ControllerStrategyCronJob        ControllerStrategy = "cron-job"
 
@@ -354,6 +357,14 @@ func (e *Environment) GetIntegrationPodSpec() *corev1.PodSpec {
 		return &deployment.Spec.Template.Spec
 	}
 
+	// StatefulSet
+	statefulSet := e.Resources.GetStatefulSet(func(s *appsv1.StatefulSet) bool {
+		return s.Name == e.Integration.Name
+	})
+	if statefulSet != nil {
+		return &statefulSet.Spec.Template.Spec
+	}
+
 	// Knative service
 	knativeService := e.Resources.GetKnativeService(func(s *serving.Service) bool {
 		return s.Name == e.Integration.Name
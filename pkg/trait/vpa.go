@@ -0,0 +1,217 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	vpav1 "github.com/apache/camel-k/v2/pkg/apis/duck/vpa/v1"
+)
+
+const (
+	vpaTraitID    = "vpa"
+	vpaTraitOrder = 1060
+)
+
+type vpaTrait struct {
+	BaseTrait
+	traitv1.VPATrait `property:",squash"`
+
+	targetKind string
+}
+
+func newVPATrait() Trait {
+	return &vpaTrait{
+		BaseTrait: NewBaseTrait(vpaTraitID, vpaTraitOrder),
+	}
+}
+
+func (t *vpaTrait) Configure(e *Environment) (bool, *TraitCondition, error) {
+	if e.Integration == nil || !ptr.Deref(t.Enabled, false) || !e.IntegrationInRunningPhases() {
+		return false, nil, nil
+	}
+
+	strategy, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return false, nil, errors.New("unable to determine the controller strategy")
+	}
+	switch strategy {
+	case ControllerStrategyDeployment:
+		t.targetKind = "Deployment"
+	case ControllerStrategyStatefulSet:
+		t.targetKind = "StatefulSet"
+	default:
+		return false, nil, fmt.Errorf("verticalpodautoscaler isn't supported with %s controller strategy", strategy)
+	}
+
+	switch vpav1.UpdateMode(t.UpdateMode) {
+	case "", vpav1.UpdateModeOff:
+	case vpav1.UpdateModeInitial, vpav1.UpdateModeRecreate, vpav1.UpdateModeAuto:
+		if ht, ok := e.Catalog.GetTrait(hpaTraitID).(*hpaTrait); ok && ptr.Deref(ht.Enabled, false) {
+			return false, nil, errors.New("vpa trait cannot update the pod resources along with hpa trait: use the Off update mode")
+		}
+		if kt, ok := e.Catalog.GetTrait(kedaTraitID).(*kedaTrait); ok && ptr.Deref(kt.Enabled, false) {
+			return false, nil, errors.New("vpa trait cannot update the pod resources along with keda trait: use the Off update mode")
+		}
+	default:
+		return false, nil, fmt.Errorf("unsupported vpa trait update mode: %s", t.UpdateMode)
+	}
+
+	for name, value := range map[string]string{
+		"min-allowed-cpu":    t.MinAllowedCPU,
+		"min-allowed-memory": t.MinAllowedMemory,
+		"max-allowed-cpu":    t.MaxAllowedCPU,
+		"max-allowed-memory": t.MaxAllowedMemory,
+	} {
+		if _, err := parseOptionalQuantity(value); err != nil {
+			return false, nil, fmt.Errorf("vpa trait %s is invalid: %w", name, err)
+		}
+	}
+
+	return true, nil, nil
+}
+
+func (t *vpaTrait) Apply(e *Environment) error {
+	containerName := e.GetIntegrationContainerName()
+	vpa := &vpav1.VerticalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VerticalPodAutoscaler",
+			APIVersion: vpav1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: e.Integration.Name,
+			},
+		},
+		Spec: vpav1.VerticalPodAutoscalerSpec{
+			// The controller is named after the Integration
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       t.targetKind,
+				Name:       e.Integration.Name,
+			},
+			UpdatePolicy: &vpav1.PodUpdatePolicy{
+				UpdateMode: ptr.To(t.updateMode()),
+			},
+			ResourcePolicy: &vpav1.PodResourcePolicy{
+				ContainerPolicies: []vpav1.ContainerResourcePolicy{
+					{
+						ContainerName: containerName,
+						MinAllowed:    resourceList(t.MinAllowedCPU, t.MinAllowedMemory),
+						MaxAllowed:    resourceList(t.MaxAllowedCPU, t.MaxAllowedMemory),
+					},
+					{
+						// Leave the resources of the other containers alone
+						ContainerName: "*",
+						Mode:          ptr.To(vpav1.ContainerScalingModeOff),
+					},
+				},
+			},
+		},
+	}
+	e.Resources.Add(vpa)
+
+	return t.reportRecommendation(e, containerName)
+}
+
+// reportRecommendation reports the resources recommended by the VerticalPodAutoscaler into the Integration status.
+func (t *vpaTrait) reportRecommendation(e *Environment, containerName string) error {
+	e.Integration.Status.ResourcesRecommendation = nil
+
+	vpa := &vpav1.VerticalPodAutoscaler{}
+	key := ctrl.ObjectKey{Namespace: e.Integration.Namespace, Name: e.Integration.Name}
+	if err := t.Client.Get(e.Ctx, key, vpa); err != nil {
+		// The VerticalPodAutoscaler has not been created yet, or it is not installed
+		if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return err
+	}
+	if vpa.Status.Recommendation == nil {
+		return nil
+	}
+	for _, r := range vpa.Status.Recommendation.ContainerRecommendations {
+		if r.ContainerName == containerName {
+			e.Integration.Status.ResourcesRecommendation = &v1.IntegrationResourcesRecommendation{
+				Target:     r.Target,
+				LowerBound: r.LowerBound,
+				UpperBound: r.UpperBound,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *vpaTrait) updateMode() vpav1.UpdateMode {
+	if t.UpdateMode == "" {
+		return vpav1.UpdateModeOff
+	}
+
+	return vpav1.UpdateMode(t.UpdateMode)
+}
+
+// setsResources tells if the VerticalPodAutoscaler sets the resources of the pods. The recommendations are never
+// set into the pod template, as they would roll out the pods each time they change.
+func (t *vpaTrait) setsResources() bool {
+	return t.updateMode() != vpav1.UpdateModeOff
+}
+
+func parseOptionalQuantity(value string) (*resource.Quantity, error) {
+	if value == "" {
+		return nil, nil
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &q, nil
+}
+
+func resourceList(cpu string, memory string) corev1.ResourceList {
+	list := corev1.ResourceList{}
+	// The quantities have been validated already
+	if cpu != "" {
+		list[corev1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if memory != "" {
+		list[corev1.ResourceMemory] = resource.MustParse(memory)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	return list
+}
@@ -0,0 +1,252 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	vpav1 "github.com/apache/camel-k/v2/pkg/apis/duck/vpa/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+func TestVPA(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	})
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, environment.GetTrait(vpaTraitID))
+
+	vpa := getVPA(environment.Resources)
+	require.NotNil(t, vpa)
+	assert.Equal(t, ServiceTestName, vpa.Name)
+	assert.Equal(t, "ns", vpa.Namespace)
+	assert.Equal(t, &autoscalingv1.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       ServiceTestName,
+	}, vpa.Spec.TargetRef)
+	assert.Equal(t, ptr.To(vpav1.UpdateModeOff), vpa.Spec.UpdatePolicy.UpdateMode)
+	assert.Equal(t, []vpav1.ContainerResourcePolicy{
+		{ContainerName: defaultContainerName},
+		{ContainerName: "*", Mode: ptr.To(vpav1.ContainerScalingModeOff)},
+	}, vpa.Spec.ResourcePolicy.ContainerPolicies)
+	assert.Nil(t, environment.Integration.Status.ResourcesRecommendation)
+}
+
+func TestVPAAllowedResources(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait:            traitv1.Trait{Enabled: ptr.To(true)},
+		UpdateMode:       "Auto",
+		MinAllowedCPU:    "250m",
+		MinAllowedMemory: "256Mi",
+		MaxAllowedMemory: "2Gi",
+	})
+	environment.Integration.Spec.Traits.Container = &traitv1.ContainerTrait{
+		Name: "my-container",
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	vpa := getVPA(environment.Resources)
+	require.NotNil(t, vpa)
+	assert.Equal(t, ptr.To(vpav1.UpdateModeAuto), vpa.Spec.UpdatePolicy.UpdateMode)
+	assert.Equal(t, vpav1.ContainerResourcePolicy{
+		ContainerName: "my-container",
+		MinAllowed: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+		MaxAllowed: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}, vpa.Spec.ResourcePolicy.ContainerPolicies[0])
+}
+
+func TestVPAStatefulSet(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	})
+	environment.Integration.Spec.Traits.StatefulSet = &traitv1.StatefulSetTrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	vpa := getVPA(environment.Resources)
+	require.NotNil(t, vpa)
+	assert.Equal(t, "StatefulSet", vpa.Spec.TargetRef.Kind)
+}
+
+func TestVPARecommendation(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait:      traitv1.Trait{Enabled: ptr.To(true)},
+		UpdateMode: "Auto",
+	}, recommendingVPA())
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+
+	recommendation := environment.Integration.Status.ResourcesRecommendation
+	require.NotNil(t, recommendation)
+	assert.Equal(t, resource.MustParse("1Gi"), recommendation.Target[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("500m"), recommendation.Target[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("512Mi"), recommendation.LowerBound[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("2Gi"), recommendation.UpperBound[corev1.ResourceMemory])
+
+	// The JVM heap size follows the memory limit set by the autoscaler, so that the recommendation does not change the pod template
+	deployment := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == ServiceTestName })
+	require.NotNil(t, deployment)
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Args, "-XX:MaxRAMPercentage=50")
+	for _, arg := range deployment.Spec.Template.Spec.Containers[0].Args {
+		assert.NotContains(t, arg, "-Xmx")
+	}
+	assert.Equal(t, resource.MustParse("512Mi"), deployment.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory])
+}
+
+func TestVPARecommendationOffMode(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait: traitv1.Trait{Enabled: ptr.To(true)},
+	}, recommendingVPA())
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	require.NotNil(t, environment.Integration.Status.ResourcesRecommendation)
+
+	// The JVM heap size is computed from the container limits, as the recommendation is not applied to the pods
+	deployment := environment.Resources.GetDeployment(func(d *appsv1.Deployment) bool { return d.Name == ServiceTestName })
+	require.NotNil(t, deployment)
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Args, "-Xmx268M")
+}
+
+func TestVPAInvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		trait traitv1.VPATrait
+		err   string
+	}{
+		{
+			name:  "unsupported update mode",
+			trait: traitv1.VPATrait{UpdateMode: "Always"},
+			err:   "unsupported vpa trait update mode: Always",
+		},
+		{
+			name:  "invalid quantity",
+			trait: traitv1.VPATrait{MaxAllowedMemory: "lots"},
+			err:   "vpa trait max-allowed-memory is invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vpa := test.trait
+			vpa.Enabled = ptr.To(true)
+			environment := vpaEnv(t, &vpa)
+
+			_, _, err := environment.Catalog.apply(&environment)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestVPAWithHPA(t *testing.T) {
+	environment := vpaEnv(t, &traitv1.VPATrait{
+		Trait:      traitv1.Trait{Enabled: ptr.To(true)},
+		UpdateMode: "Recreate",
+	})
+	environment.Integration.Spec.Traits.HPA = &traitv1.HPATrait{
+		Trait:       traitv1.Trait{Enabled: ptr.To(true)},
+		MaxReplicas: ptr.To(int32(5)),
+	}
+
+	_, _, err := environment.Catalog.apply(&environment)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vpa trait cannot update the pod resources along with hpa trait")
+
+	// Recommendations only are fine
+	environment.Integration.Spec.Traits.VPA.UpdateMode = "Off"
+	environment.Catalog = NewCatalog(environment.Client)
+	_, _, err = environment.Catalog.apply(&environment)
+	require.NoError(t, err)
+	assert.NotNil(t, getVPA(environment.Resources))
+}
+
+func getVPA(resources *kubernetes.Collection) *vpav1.VerticalPodAutoscaler {
+	for _, r := range resources.Items() {
+		if vpa, ok := r.(*vpav1.VerticalPodAutoscaler); ok {
+			return vpa
+		}
+	}
+
+	return nil
+}
+
+func recommendingVPA() *vpav1.VerticalPodAutoscaler {
+	return &vpav1.VerticalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VerticalPodAutoscaler",
+			APIVersion: vpav1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: "ns",
+		},
+		Status: vpav1.VerticalPodAutoscalerStatus{
+			Recommendation: &vpav1.RecommendedPodResources{
+				ContainerRecommendations: []vpav1.RecommendedContainerResources{
+					{
+						ContainerName: defaultContainerName,
+						Target: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						LowerBound: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+						UpperBound: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func vpaEnv(t *testing.T, vpa *traitv1.VPATrait, objects ...runtime.Object) Environment {
+	t.Helper()
+
+	return newRouteTestEnv(t, `from("timer:tick").log("hello");`, v1.Traits{VPA: vpa}, objects...)
+}
@@ -44,6 +44,12 @@ type Binding struct {
 	Traits v1.Traits
 	// ApplicationProperties contain properties that should be set on the integration for the binding to work
 	ApplicationProperties map[string]string
+	// Configs contain the configmaps or secrets (with the mount trait syntax) that should be mounted on the integration
+	// and parsed as properties for the binding to work
+	Configs []string
+	// Resources contain the configmaps or secrets (with the mount trait syntax) that should be mounted on the integration
+	// as files for the binding to work
+	Resources []string
 }
 
 // BindingProvider maps a Binding endpoint into Camel K resources.
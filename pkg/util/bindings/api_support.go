@@ -17,7 +17,15 @@ limitations under the License.
 
 package bindings
 
-import "fmt"
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+)
 
 // AsYamlDSL construct proper Camel Yaml DSL from given binding.
 func (b Binding) AsYamlDSL() map[string]any {
@@ -40,3 +48,38 @@ func (c EndpointContext) GenerateID() string {
 
 	return id
 }
+
+// mountConfig returns the mount trait syntax of a configmap or secret key, which can be located in another namespace.
+func mountConfig(ctx BindingContext, storageType, namespace, name, key string) string {
+	if namespace != ctx.Namespace {
+		return fmt.Sprintf("%s:%s:%s/%s", storageType, namespace, name, key)
+	}
+
+	return fmt.Sprintf("%s:%s/%s", storageType, name, key)
+}
+
+// mountSecretProperty returns the mount trait syntax of a secret key, which is mounted as the given property.
+// The key is mounted under the namespace and the name of the secret, so that the properties of the different
+// endpoints of a Pipe do not collide, even when they reference secrets with the same name in different namespaces.
+func mountSecretProperty(ctx BindingContext, namespace, name, key, property string) string {
+	return mountConfig(ctx, "secret", namespace, name, key) + "@" +
+		path.Join(camel.ConfigSecretsMountPath, "_bindings", namespace, name, property)
+}
+
+// rawPlaceholder returns the property placeholder of an endpoint parameter which value must not be parsed.
+func rawPlaceholder(property string) string {
+	return "RAW({{" + property + "}})"
+}
+
+// appendRawParameters appends the parameters to the URI without escaping them, so that they can hold property placeholders.
+func appendRawParameters(uri string, params map[string]string) string {
+	for _, k := range slices.Sorted(maps.Keys(params)) {
+		separator := "&"
+		if !strings.Contains(uri, "?") {
+			separator = "?"
+		}
+		uri += separator + k + "=" + params[k]
+	}
+
+	return uri
+}
@@ -240,7 +240,7 @@ func TestValidateEndpointKameletCrossNSDenied(t *testing.T) {
 	err = validateEndpoint(bindingContext, endpoint)
 	require.Error(t, err)
 	require.Equal(t, "cross-namespace Pipe reference authorization denied for the ServiceAccount my-sa"+
-		" and resources kamelets", err.Error())
+		" and resources kamelets in namespace kamelet-ns", err.Error())
 }
 
 func TestValidateEndpointErrorKnativeCrossNS(t *testing.T) {
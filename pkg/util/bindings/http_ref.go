@@ -0,0 +1,427 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+const (
+	// HTTPSchemeAnnotation sets the scheme (`http` or `https`) used to call the referenced resource.
+	HTTPSchemeAnnotation = "camel.apache.org/http.scheme"
+	// HTTPPortAnnotation sets the name or the number of the Service port to call.
+	HTTPPortAnnotation = "camel.apache.org/http.port"
+	// HTTPBasePathAnnotation sets the base path of the API exposed by the referenced resource.
+	HTTPBasePathAnnotation = "camel.apache.org/http.base-path"
+	// HTTPAuthSecretAnnotation sets the name of the Secret providing the credentials to call the referenced resource,
+	// either `username` and `password` for the basic authentication or `token` for the bearer authentication.
+	HTTPAuthSecretAnnotation = "camel.apache.org/http.auth-secret"
+	// HTTPTruststoreSecretAnnotation sets the name of the Secret providing the CA bundle to trust, as a PKCS12 truststore
+	// in the `truststore.p12` key protected by the password in the `truststore.password` key.
+	HTTPTruststoreSecretAnnotation = "camel.apache.org/http.truststore-secret"
+
+	httpAuthUsernameKey       = "username"
+	httpAuthPasswordKey       = "password"
+	httpAuthTokenKey          = "token"
+	httpTruststoreKey         = "truststore.p12"
+	httpTruststorePasswordKey = "truststore.password"
+
+	sslContextParametersClass = "org.apache.camel.support.jsse.SSLContextParameters"
+	trustManagersClass        = "org.apache.camel.support.jsse.TrustManagersParameters"
+	keyStoreClass             = "org.apache.camel.support.jsse.KeyStoreParameters"
+)
+
+// HTTPRefBindingProvider converts a Service, an Ingress, a Route or an HTTPRoute into a Camel http endpoint,
+// according to the well-known annotations describing the HTTP API they expose.
+type HTTPRefBindingProvider struct{}
+
+// ID --.
+func (k HTTPRefBindingProvider) ID() string {
+	return "http-ref"
+}
+
+// Translate resolves the URI of the HTTP API exposed by the referenced resource. Services are only managed
+// when they are annotated, the others are left to the service-ref binding provider.
+func (k HTTPRefBindingProvider) Translate(ctx BindingContext, _ EndpointContext, e v1.Endpoint) (*Binding, error) {
+	if e.Ref == nil {
+		return nil, nil
+	}
+	namespace := e.Ref.Namespace
+	if namespace == "" {
+		namespace = ctx.Namespace
+	}
+	key := ctrl.ObjectKey{Namespace: namespace, Name: e.Ref.Name}
+
+	var endpoint *httpEndpoint
+	switch {
+	case isService(e.Ref):
+		svc := corev1.Service{}
+		if err := ctx.Client.Get(ctx.Ctx, key, &svc); err != nil && k8serrors.IsNotFound(err) {
+			// let the service-ref binding provider report it
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("could not load a Service with name %s in namespace %s: %w", e.Ref.Name, namespace, err)
+		}
+		if !hasHTTPAnnotations(svc.Annotations) {
+			// plain Services are managed by the service-ref binding provider
+			return nil, nil
+		}
+		ep, err := serviceHTTPEndpoint(&svc)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = ep
+	case isIngress(e.Ref):
+		ingress := networkingv1.Ingress{}
+		if err := ctx.Client.Get(ctx.Ctx, key, &ingress); err != nil {
+			return nil, fmt.Errorf("could not load an Ingress with name %s in namespace %s: %w", e.Ref.Name, namespace, err)
+		}
+		ep, err := ingressHTTPEndpoint(&ingress)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = ep
+	case isRoute(e.Ref):
+		route := routev1.Route{}
+		if err := ctx.Client.Get(ctx.Ctx, key, &route); err != nil {
+			return nil, fmt.Errorf("could not load a Route with name %s in namespace %s: %w", e.Ref.Name, namespace, err)
+		}
+		ep, err := routeHTTPEndpoint(&route)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = ep
+	case isHTTPRoute(e.Ref):
+		httpRoute := gwv1.HTTPRoute{}
+		if err := ctx.Client.Get(ctx.Ctx, key, &httpRoute); err != nil {
+			return nil, fmt.Errorf("could not load an HTTPRoute with name %s in namespace %s: %w", e.Ref.Name, namespace, err)
+		}
+		ep, err := httpRouteHTTPEndpoint(&httpRoute)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = ep
+	default:
+		return nil, nil
+	}
+
+	props, err := e.Properties.GetPropertyMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.toBinding(ctx, namespace, props)
+}
+
+// httpEndpoint represents the HTTP API exposed by a resource.
+type httpEndpoint struct {
+	scheme      string
+	host        string
+	port        int32
+	annotations map[string]string
+}
+
+func (h *httpEndpoint) toBinding(ctx BindingContext, namespace string, props map[string]string) (*Binding, error) {
+	if scheme := h.annotations[HTTPSchemeAnnotation]; scheme != "" {
+		if scheme != "http" && scheme != "https" {
+			return nil, fmt.Errorf("unsupported %s annotation value %s: must be http or https", HTTPSchemeAnnotation, scheme)
+		}
+		h.scheme = scheme
+	}
+	host := h.host
+	if h.port != 0 && !(h.scheme == "http" && h.port == 80) && !(h.scheme == "https" && h.port == 443) {
+		host += ":" + strconv.Itoa(int(h.port))
+	}
+	httpURI := url.URL{
+		Scheme: h.scheme,
+		Host:   host,
+		Path:   path.Join("/", h.annotations[HTTPBasePathAnnotation], props["path"]),
+	}
+	if httpURI.Path == "/" {
+		httpURI.Path = ""
+	}
+	delete(props, "path")
+
+	binding := Binding{
+		ApplicationProperties: make(map[string]string),
+	}
+	params := make(map[string]string)
+	if secretName := h.annotations[HTTPAuthSecretAnnotation]; secretName != "" {
+		authParams, err := h.configureAuth(ctx, namespace, secretName, &binding)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(params, authParams)
+	}
+	if secretName := h.annotations[HTTPTruststoreSecretAnnotation]; secretName != "" {
+		bean, err := h.configureTruststore(ctx, namespace, secretName, &binding)
+		if err != nil {
+			return nil, err
+		}
+		params["sslContextParameters"] = "#bean:" + bean
+	}
+	binding.URI = appendRawParameters(uri.AppendParameters(httpURI.String(), props), params)
+
+	return &binding, nil
+}
+
+// configureAuth returns the authentication parameters of the endpoint. The credentials are mounted as properties,
+// so that they never appear in the Integration. The properties are named after the namespace and the name of the Secret,
+// so that they don't collide with the ones of the other endpoints.
+func (h *httpEndpoint) configureAuth(ctx BindingContext, namespace, secretName string, binding *Binding) (map[string]string, error) {
+	secret, err := lookupHTTPSecret(ctx, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	tokenProperty := httpSecretProperty(namespace, secretName, httpAuthTokenKey)
+	usernameProperty := httpSecretProperty(namespace, secretName, httpAuthUsernameKey)
+	passwordProperty := httpSecretProperty(namespace, secretName, httpAuthPasswordKey)
+	switch {
+	case hasSecretKeys(secret, httpAuthTokenKey):
+		binding.Configs = append(binding.Configs, mountSecretProperty(ctx, namespace, secretName, httpAuthTokenKey, tokenProperty))
+
+		return map[string]string{
+			"authBearerToken": rawPlaceholder(tokenProperty),
+		}, nil
+	case hasSecretKeys(secret, httpAuthUsernameKey, httpAuthPasswordKey):
+		binding.Configs = append(binding.Configs,
+			mountSecretProperty(ctx, namespace, secretName, httpAuthUsernameKey, usernameProperty),
+			mountSecretProperty(ctx, namespace, secretName, httpAuthPasswordKey, passwordProperty),
+		)
+
+		return map[string]string{
+			"authMethod":   "Basic",
+			"authUsername": rawPlaceholder(usernameProperty),
+			"authPassword": rawPlaceholder(passwordProperty),
+		}, nil
+	default:
+		return nil, fmt.Errorf("secret %s must provide either %s and %s or %s keys", secretName,
+			httpAuthUsernameKey, httpAuthPasswordKey, httpAuthTokenKey)
+	}
+}
+
+// configureTruststore declares an SSLContextParameters bean with the truststore, which is mounted as a file, and returns
+// its name. The bean is only set on the endpoint, so that the other endpoints keep their own SSL configuration.
+func (h *httpEndpoint) configureTruststore(ctx BindingContext, namespace, secretName string, binding *Binding) (string, error) {
+	if h.scheme != "https" {
+		return "", fmt.Errorf("%s annotation requires the https scheme", HTTPTruststoreSecretAnnotation)
+	}
+	secret, err := lookupHTTPSecret(ctx, namespace, secretName)
+	if err != nil {
+		return "", err
+	}
+	if !hasSecretKeys(secret, httpTruststoreKey, httpTruststorePasswordKey) {
+		return "", fmt.Errorf("secret %s must provide %s and %s keys", secretName, httpTruststoreKey, httpTruststorePasswordKey)
+	}
+	truststorePath := path.Join(camel.ResourcesDefaultMountPath, "_http", namespace, secretName, httpTruststoreKey)
+	passwordProperty := httpSecretProperty(namespace, secretName, httpTruststorePasswordKey)
+	binding.Configs = append(binding.Configs, mountSecretProperty(ctx, namespace, secretName, httpTruststorePasswordKey, passwordProperty))
+	binding.Resources = append(binding.Resources,
+		mountConfig(ctx, "secret", namespace, secretName, httpTruststoreKey)+"@"+truststorePath)
+
+	bean := httpSSLContextParametersBean(namespace, secretName)
+	prefix := "camel.beans." + bean
+	binding.ApplicationProperties[prefix] = "#class:" + sslContextParametersClass
+	binding.ApplicationProperties[prefix+".trustManagers"] = "#class:" + trustManagersClass
+	binding.ApplicationProperties[prefix+".trustManagers.keyStore"] = "#class:" + keyStoreClass
+	binding.ApplicationProperties[prefix+".trustManagers.keyStore.resource"] = "file:" + truststorePath
+	binding.ApplicationProperties[prefix+".trustManagers.keyStore.password"] = "{{" + passwordProperty + "}}"
+	binding.ApplicationProperties[prefix+".trustManagers.keyStore.type"] = "PKCS12"
+
+	return bean, nil
+}
+
+// httpSecretProperty returns the name of the property a key of a Secret is mounted as.
+func httpSecretProperty(namespace, secretName, key string) string {
+	return fmt.Sprintf("camel.k.binding.http.%s.%s.%s", namespace, secretName, key)
+}
+
+// httpSSLContextParametersBean returns the name of the SSLContextParameters bean configured with the truststore
+// of a Secret, ie, httpSslMyNs_MyCa for the my-ca Secret of the my-ns namespace.
+func httpSSLContextParametersBean(namespace, secretName string) string {
+	return "httpSsl" + upperCamelCase(namespace) + "_" + upperCamelCase(secretName)
+}
+
+func upperCamelCase(name string) string {
+	camelCase := ""
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '.' }) {
+		camelCase += strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return camelCase
+}
+
+func serviceHTTPEndpoint(svc *corev1.Service) (*httpEndpoint, error) {
+	endpoint := httpEndpoint{
+		scheme:      "http",
+		host:        fmt.Sprintf("%s.%s.svc.cluster.local", svc.Name, svc.Namespace),
+		annotations: svc.Annotations,
+	}
+	if svc.Spec.Type == corev1.ServiceTypeExternalName {
+		endpoint.host = svc.Spec.ExternalName
+	}
+	portName := svc.Annotations[HTTPPortAnnotation]
+	for _, port := range svc.Spec.Ports {
+		if portName != "" && port.Name != portName && strconv.Itoa(int(port.Port)) != portName {
+			continue
+		}
+		endpoint.port = port.Port
+		if port.Port == 443 || port.Name == "https" || ptr.Deref(port.AppProtocol, "") == "https" {
+			endpoint.scheme = "https"
+		}
+
+		return &endpoint, nil
+	}
+	if portName != "" {
+		return nil, fmt.Errorf("could not find port %s in Service %s", portName, svc.Name)
+	}
+
+	return &endpoint, nil
+}
+
+func ingressHTTPEndpoint(ingress *networkingv1.Ingress) (*httpEndpoint, error) {
+	host := ""
+	if len(ingress.Spec.Rules) > 0 {
+		host = ingress.Spec.Rules[0].Host
+	}
+	if host == "" && len(ingress.Status.LoadBalancer.Ingress) > 0 {
+		host = ingress.Status.LoadBalancer.Ingress[0].Hostname
+		if host == "" {
+			host = ingress.Status.LoadBalancer.Ingress[0].IP
+		}
+	}
+	if host == "" {
+		return nil, fmt.Errorf("could not determine the host of Ingress %s", ingress.Name)
+	}
+	endpoint := httpEndpoint{
+		scheme:      "http",
+		host:        host,
+		annotations: ingress.Annotations,
+	}
+	for _, tls := range ingress.Spec.TLS {
+		if len(tls.Hosts) == 0 || slices.Contains(tls.Hosts, host) {
+			endpoint.scheme = "https"
+		}
+	}
+
+	return &endpoint, nil
+}
+
+func routeHTTPEndpoint(route *routev1.Route) (*httpEndpoint, error) {
+	host := route.Spec.Host
+	if host == "" && len(route.Status.Ingress) > 0 {
+		host = route.Status.Ingress[0].Host
+	}
+	if host == "" {
+		return nil, fmt.Errorf("could not determine the host of Route %s", route.Name)
+	}
+	endpoint := httpEndpoint{
+		scheme:      "http",
+		host:        host,
+		annotations: route.Annotations,
+	}
+	if route.Spec.TLS != nil {
+		endpoint.scheme = "https"
+	}
+
+	return &endpoint, nil
+}
+
+func httpRouteHTTPEndpoint(httpRoute *gwv1.HTTPRoute) (*httpEndpoint, error) {
+	if len(httpRoute.Spec.Hostnames) == 0 {
+		return nil, fmt.Errorf("could not determine the host of HTTPRoute %s", httpRoute.Name)
+	}
+
+	return &httpEndpoint{
+		scheme:      "http",
+		host:        string(httpRoute.Spec.Hostnames[0]),
+		annotations: httpRoute.Annotations,
+	}, nil
+}
+
+func lookupHTTPSecret(ctx BindingContext, namespace, name string) (*corev1.Secret, error) {
+	secret := corev1.Secret{}
+	if err := ctx.Client.Get(ctx.Ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+		return nil, fmt.Errorf("could not load a Secret with name %s in namespace %s: %w", name, namespace, err)
+	}
+
+	return &secret, nil
+}
+
+func hasSecretKeys(secret *corev1.Secret, keys ...string) bool {
+	for _, k := range keys {
+		if _, ok := secret.Data[k]; !ok {
+			if _, ok := secret.StringData[k]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func hasHTTPAnnotations(annotations map[string]string) bool {
+	for k := range annotations {
+		if strings.HasPrefix(k, "camel.apache.org/http.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIngress(ref *corev1.ObjectReference) bool {
+	return ref.APIVersion == networkingv1.SchemeGroupVersion.String() && ref.Kind == "Ingress"
+}
+
+func isRoute(ref *corev1.ObjectReference) bool {
+	return ref.APIVersion == routev1.GroupVersion.String() && ref.Kind == "Route"
+}
+
+func isHTTPRoute(ref *corev1.ObjectReference) bool {
+	return ref.APIVersion == gwv1.GroupVersion.String() && ref.Kind == "HTTPRoute"
+}
+
+// Order --.
+//
+//nolint:mnd
+func (k HTTPRefBindingProvider) Order() int {
+	return OrderLast - 20
+}
+
+func init() {
+	RegisterBindingProvider(HTTPRefBindingProvider{})
+}
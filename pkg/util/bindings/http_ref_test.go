@@ -0,0 +1,329 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"context"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func TestHTTPRefServiceBasicAuth(t *testing.T) {
+	svc := corev1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-svc",
+			Namespace: "test",
+			Annotations: map[string]string{
+				HTTPBasePathAnnotation:   "/api/v1",
+				HTTPAuthSecretAnnotation: "my-auth",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{Name: "metrics", Port: 9090},
+				{Name: "https", Port: 8443},
+			},
+		},
+	}
+	svc.Annotations[HTTPPortAnnotation] = "https"
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-auth", Namespace: "test"},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pwd"),
+		},
+	}
+
+	binding, err := translateHTTPRef(t, corev1.SchemeGroupVersion.String(), "Service", "my-svc", &svc, &secret)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "https://my-svc.test.svc.cluster.local:8443/api/v1/orders?timeout=5000"+
+		"&authMethod=Basic&authPassword=RAW({{camel.k.binding.http.test.my-auth.password}})"+
+		"&authUsername=RAW({{camel.k.binding.http.test.my-auth.username}})", binding.URI)
+	assert.Equal(t, []string{
+		"secret:my-auth/username@/etc/camel/conf.d/_secrets/_bindings/test/my-auth/camel.k.binding.http.test.my-auth.username",
+		"secret:my-auth/password@/etc/camel/conf.d/_secrets/_bindings/test/my-auth/camel.k.binding.http.test.my-auth.password",
+	}, binding.Configs)
+	assert.Empty(t, binding.Resources)
+	assert.Empty(t, binding.ApplicationProperties)
+}
+
+func TestHTTPRefPlainService(t *testing.T) {
+	svc := corev1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-svc",
+			Namespace: "test",
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+
+	binding, err := translateHTTPRef(t, corev1.SchemeGroupVersion.String(), "Service", "my-svc", &svc)
+	require.NoError(t, err)
+	assert.Nil(t, binding)
+}
+
+func TestHTTPRefIngressBearerAndTruststore(t *testing.T) {
+	ingress := networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-ingress",
+			Namespace: "test",
+			Annotations: map[string]string{
+				HTTPAuthSecretAnnotation:       "my-token",
+				HTTPTruststoreSecretAnnotation: "my-ca",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "api.example.com"}},
+			TLS:   []networkingv1.IngressTLS{{Hosts: []string{"api.example.com"}}},
+		},
+	}
+	token := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-token", Namespace: "test"},
+		Data:       map[string][]byte{"token": []byte("abc")},
+	}
+	ca := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-ca", Namespace: "test"},
+		Data: map[string][]byte{
+			"truststore.p12":      []byte("p12"),
+			"truststore.password": []byte("changeit"),
+		},
+	}
+
+	binding, err := translateHTTPRef(t, networkingv1.SchemeGroupVersion.String(), "Ingress", "my-ingress", &ingress, &token, &ca)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "https://api.example.com/orders?timeout=5000"+
+		"&authBearerToken=RAW({{camel.k.binding.http.test.my-token.token}})&sslContextParameters=#bean:httpSslTest_MyCa", binding.URI)
+	assert.Equal(t, []string{
+		"secret:my-token/token@/etc/camel/conf.d/_secrets/_bindings/test/my-token/camel.k.binding.http.test.my-token.token",
+		"secret:my-ca/truststore.password@/etc/camel/conf.d/_secrets/_bindings/test/my-ca/camel.k.binding.http.test.my-ca.truststore.password",
+	}, binding.Configs)
+	assert.Equal(t, []string{"secret:my-ca/truststore.p12@/etc/camel/resources/_http/test/my-ca/truststore.p12"}, binding.Resources)
+	assert.Equal(t, map[string]string{
+		"camel.beans.httpSslTest_MyCa":                                 "#class:org.apache.camel.support.jsse.SSLContextParameters",
+		"camel.beans.httpSslTest_MyCa.trustManagers":                   "#class:org.apache.camel.support.jsse.TrustManagersParameters",
+		"camel.beans.httpSslTest_MyCa.trustManagers.keyStore":          "#class:org.apache.camel.support.jsse.KeyStoreParameters",
+		"camel.beans.httpSslTest_MyCa.trustManagers.keyStore.resource": "file:/etc/camel/resources/_http/test/my-ca/truststore.p12",
+		"camel.beans.httpSslTest_MyCa.trustManagers.keyStore.password": "{{camel.k.binding.http.test.my-ca.truststore.password}}",
+		"camel.beans.httpSslTest_MyCa.trustManagers.keyStore.type":     "PKCS12",
+	}, binding.ApplicationProperties)
+}
+
+func TestHTTPRefEndpointsWithDistinctSecrets(t *testing.T) {
+	orders := corev1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "orders",
+			Namespace:   "test",
+			Annotations: map[string]string{HTTPAuthSecretAnnotation: "orders-auth"},
+		},
+	}
+	invoices := corev1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "invoices",
+			Namespace:   "test",
+			Annotations: map[string]string{HTTPAuthSecretAnnotation: "invoices-auth"},
+		},
+	}
+	ordersAuth := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "orders-auth", Namespace: "test"},
+		Data:       map[string][]byte{"token": []byte("abc")},
+	}
+	invoicesAuth := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "invoices-auth", Namespace: "test"},
+		Data:       map[string][]byte{"token": []byte("def")},
+	}
+
+	ordersBinding, err := translateHTTPRef(t, corev1.SchemeGroupVersion.String(), "Service", "orders", &orders, &invoices, &ordersAuth, &invoicesAuth)
+	require.NoError(t, err)
+	invoicesBinding, err := translateHTTPRef(t, corev1.SchemeGroupVersion.String(), "Service", "invoices", &orders, &invoices, &ordersAuth, &invoicesAuth)
+	require.NoError(t, err)
+
+	// Each endpoint reads the token of its own Secret
+	assert.Contains(t, ordersBinding.URI, "authBearerToken=RAW({{camel.k.binding.http.test.orders-auth.token}})")
+	assert.Contains(t, invoicesBinding.URI, "authBearerToken=RAW({{camel.k.binding.http.test.invoices-auth.token}})")
+	assert.NotEqual(t, ordersBinding.Configs, invoicesBinding.Configs)
+}
+
+func TestHTTPRefSecretsWithTheSameNameInTwoNamespaces(t *testing.T) {
+	objects := make([]runtime.Object, 0)
+	for _, namespace := range []string{"ns-a", "ns-b"} {
+		objects = append(objects,
+			&networkingv1.Ingress{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "api",
+					Namespace: namespace,
+					Annotations: map[string]string{
+						HTTPAuthSecretAnnotation:       "api-auth",
+						HTTPTruststoreSecretAnnotation: "api-ca",
+					},
+				},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{{Host: namespace + ".example.com"}},
+					TLS:   []networkingv1.IngressTLS{{Hosts: []string{namespace + ".example.com"}}},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{Name: "api-auth", Namespace: namespace},
+				Data:       map[string][]byte{"token": []byte(namespace + "-token")},
+			},
+			&corev1.Secret{
+				ObjectMeta: v1.ObjectMeta{Name: "api-ca", Namespace: namespace},
+				Data: map[string][]byte{
+					"truststore.p12":      []byte(namespace + "-p12"),
+					"truststore.password": []byte(namespace + "-password"),
+				},
+			},
+		)
+	}
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+	bindingContext := BindingContext{
+		Ctx:       context.Background(),
+		Client:    client,
+		Namespace: "test",
+	}
+	translate := func(namespace string) *Binding {
+		endpoint := camelv1.Endpoint{
+			Ref: &corev1.ObjectReference{
+				Namespace:  namespace,
+				Name:       "api",
+				APIVersion: networkingv1.SchemeGroupVersion.String(),
+				Kind:       "Ingress",
+			},
+		}
+		binding, err := HTTPRefBindingProvider{}.Translate(bindingContext, EndpointContext{Type: camelv1.EndpointTypeSink}, endpoint)
+		require.NoError(t, err)
+		require.NotNil(t, binding)
+
+		return binding
+	}
+
+	source := translate("ns-a")
+	sink := translate("ns-b")
+	assert.Equal(t, "https://ns-a.example.com?authBearerToken=RAW({{camel.k.binding.http.ns-a.api-auth.token}})"+
+		"&sslContextParameters=#bean:httpSslNsA_ApiCa", source.URI)
+	assert.Equal(t, "https://ns-b.example.com?authBearerToken=RAW({{camel.k.binding.http.ns-b.api-auth.token}})"+
+		"&sslContextParameters=#bean:httpSslNsB_ApiCa", sink.URI)
+	assert.Equal(t, []string{"secret:ns-a:api-ca/truststore.p12@/etc/camel/resources/_http/ns-a/api-ca/truststore.p12"}, source.Resources)
+	assert.Equal(t, []string{"secret:ns-b:api-ca/truststore.p12@/etc/camel/resources/_http/ns-b/api-ca/truststore.p12"}, sink.Resources)
+	for _, config := range source.Configs {
+		assert.NotContains(t, sink.Configs, config)
+	}
+	for property := range source.ApplicationProperties {
+		assert.NotContains(t, sink.ApplicationProperties, property)
+	}
+}
+
+func TestHTTPRefRoute(t *testing.T) {
+	route := routev1.Route{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-route",
+			Namespace: "test",
+		},
+		Spec: routev1.RouteSpec{
+			Host: "my-route.apps.example.com",
+		},
+	}
+
+	binding, err := translateHTTPRef(t, routev1.GroupVersion.String(), "Route", "my-route", &route)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "http://my-route.apps.example.com/orders?timeout=5000", binding.URI)
+}
+
+func TestHTTPRefHTTPRoute(t *testing.T) {
+	httpRoute := gwv1.HTTPRoute{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-http-route",
+			Namespace: "test",
+			Annotations: map[string]string{
+				HTTPSchemeAnnotation: "https",
+			},
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			Hostnames: []gwv1.Hostname{"orders.example.com"},
+		},
+	}
+
+	binding, err := translateHTTPRef(t, gwv1.GroupVersion.String(), "HTTPRoute", "my-http-route", &httpRoute)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "https://orders.example.com/orders?timeout=5000", binding.URI)
+}
+
+func TestHTTPRefWrongAuthSecret(t *testing.T) {
+	route := routev1.Route{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-route",
+			Namespace: "test",
+			Annotations: map[string]string{
+				HTTPAuthSecretAnnotation: "my-auth",
+			},
+		},
+		Spec: routev1.RouteSpec{
+			Host: "my-route.apps.example.com",
+		},
+	}
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-auth", Namespace: "test"},
+		Data:       map[string][]byte{"username": []byte("user")},
+	}
+
+	_, err := translateHTTPRef(t, routev1.GroupVersion.String(), "Route", "my-route", &route, &secret)
+	require.EqualError(t, err, "secret my-auth must provide either username and password or token keys")
+}
+
+func translateHTTPRef(t *testing.T, apiVersion, kind, name string, objects ...runtime.Object) (*Binding, error) {
+	t.Helper()
+
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       context.Background(),
+		Client:    client,
+		Namespace: "test",
+	}
+	endpoint := camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       name,
+			APIVersion: apiVersion,
+			Kind:       kind,
+		},
+		Properties: asEndpointProperties(map[string]string{
+			"path":    "orders",
+			"timeout": "5000",
+		}),
+	}
+
+	return HTTPRefBindingProvider{}.Translate(bindingContext, EndpointContext{Type: camelv1.EndpointTypeSink}, endpoint)
+}
@@ -0,0 +1,213 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/camel"
+	"github.com/apache/camel-k/v2/pkg/util/uri"
+)
+
+const (
+	// KafkaRefType is the value of the `type` key identifying the Secrets and the ConfigMaps describing a Kafka cluster.
+	KafkaRefType = "kafka"
+
+	kafkaRefTypeKey             = "type"
+	kafkaRefBootstrapServersKey = "bootstrapServers"
+	kafkaRefTopicKey            = "topic"
+	kafkaRefSecurityProtocolKey = "securityProtocol"
+	kafkaRefSASLMechanismKey    = "saslMechanism"
+	kafkaRefUserKey             = "user"
+	kafkaRefPasswordKey         = "password"
+	kafkaRefCACertKey           = "ca.crt"
+)
+
+// KafkaRefBindingProvider allows to connect to a Kafka topic described by a Secret or a ConfigMap, for the Kafka clusters
+// which are not managed by Strimzi. The Secret or the ConfigMap must provide the `type` key with the `kafka` value,
+// the `bootstrapServers` key and optionally the `topic`, `securityProtocol`, `saslMechanism`, `user`, `password`
+// and `ca.crt` keys. The credentials can only be provided by a Secret.
+type KafkaRefBindingProvider struct{}
+
+// ID --.
+func (k KafkaRefBindingProvider) ID() string {
+	return "kafka-ref"
+}
+
+// Translate --.
+func (k KafkaRefBindingProvider) Translate(ctx BindingContext, _ EndpointContext, e v1.Endpoint) (*Binding, error) {
+	if e.Ref == nil || e.Ref.APIVersion != corev1.SchemeGroupVersion.String() ||
+		(e.Ref.Kind != "Secret" && e.Ref.Kind != "ConfigMap") {
+		// IMPORTANT: just pass through if this provider cannot manage the binding. Another provider in the chain may take care or it.
+		return nil, nil
+	}
+	namespace := e.Ref.Namespace
+	if namespace == "" {
+		namespace = ctx.Namespace
+	}
+	storageType, data, err := lookupKafkaRefData(ctx, e.Ref.Kind, namespace, e.Ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	if data[kafkaRefTypeKey] != KafkaRefType {
+		return nil, nil
+	}
+
+	props, err := e.Properties.GetPropertyMap()
+	if err != nil {
+		return nil, err
+	}
+	if props == nil {
+		props = make(map[string]string)
+	}
+	topic := props["topic"]
+	if topic == "" {
+		topic = data[kafkaRefTopicKey]
+	}
+	if topic == "" {
+		return nil, errors.New("invalid endpoint configuration: missing topic property")
+	}
+	delete(props, "topic")
+	if props["brokers"] == "" {
+		if data[kafkaRefBootstrapServersKey] == "" {
+			return nil, fmt.Errorf("%s %s has no %s key", e.Ref.Kind, e.Ref.Name, kafkaRefBootstrapServersKey)
+		}
+		props["brokers"] = data[kafkaRefBootstrapServersKey]
+	}
+
+	binding := Binding{
+		ApplicationProperties: make(map[string]string),
+	}
+	securityProtocol := data[kafkaRefSecurityProtocolKey]
+	rawParams := make(map[string]string)
+	//nolint:nestif
+	if _, hasUser := data[kafkaRefUserKey]; hasUser {
+		if storageType != "secret" {
+			return nil, fmt.Errorf("the Kafka credentials of ConfigMap %s must be provided by a Secret", e.Ref.Name)
+		}
+		if _, hasPassword := data[kafkaRefPasswordKey]; !hasPassword {
+			return nil, fmt.Errorf("secret %s has no %s key", e.Ref.Name, kafkaRefPasswordKey)
+		}
+		mechanism := data[kafkaRefSASLMechanismKey]
+		if mechanism == "" {
+			mechanism = "PLAIN"
+		}
+		loginModule, err := kafkaLoginModule(mechanism)
+		if err != nil {
+			return nil, err
+		}
+		if securityProtocol == "" {
+			securityProtocol = "SASL_SSL"
+		}
+		// The credentials are mounted as properties, so that they never appear in the Integration. The properties
+		// are named after the namespace and the name of the Secret, so that they don't collide with the ones of the other endpoints.
+		userProperty := kafkaRefProperty(namespace, e.Ref.Name, kafkaRefUserKey)
+		passwordProperty := kafkaRefProperty(namespace, e.Ref.Name, kafkaRefPasswordKey)
+		binding.Configs = append(binding.Configs,
+			mountSecretProperty(ctx, namespace, e.Ref.Name, kafkaRefUserKey, userProperty),
+			mountSecretProperty(ctx, namespace, e.Ref.Name, kafkaRefPasswordKey, passwordProperty),
+		)
+		jaasConfigProperty := kafkaRefProperty(namespace, e.Ref.Name, "sasl-jaas-config")
+		binding.ApplicationProperties[jaasConfigProperty] = fmt.Sprintf(`%s required username="{{%s}}" password="{{%s}}";`,
+			loginModule, userProperty, passwordProperty)
+		setDefaultParameter(props, "saslMechanism", mechanism)
+		if props["saslJaasConfig"] == "" {
+			rawParams["saslJaasConfig"] = rawPlaceholder(jaasConfigProperty)
+		}
+	}
+	if _, hasCACert := data[kafkaRefCACertKey]; hasCACert {
+		if securityProtocol == "" {
+			securityProtocol = "SSL"
+		}
+		caCertPath := path.Join(camel.ResourcesDefaultMountPath, "_kafka", namespace, e.Ref.Name, kafkaRefCACertKey)
+		binding.Resources = append(binding.Resources,
+			mountConfig(ctx, storageType, namespace, e.Ref.Name, kafkaRefCACertKey)+"@"+caCertPath)
+		setDefaultParameter(props, "sslTruststoreLocation", caCertPath)
+		setDefaultParameter(props, "sslTruststoreType", "PEM")
+	}
+	if securityProtocol != "" {
+		setDefaultParameter(props, "securityProtocol", securityProtocol)
+	}
+	binding.URI = appendRawParameters(uri.AppendParameters("kafka:"+topic, props), rawParams)
+
+	return &binding, nil
+}
+
+// lookupKafkaRefData returns the storage type and the content of the referenced Secret or ConfigMap.
+func lookupKafkaRefData(ctx BindingContext, kind, namespace, name string) (string, map[string]string, error) {
+	key := ctrl.ObjectKey{Namespace: namespace, Name: name}
+	if kind == "ConfigMap" {
+		cm := corev1.ConfigMap{}
+		if err := ctx.Client.Get(ctx.Ctx, key, &cm); err != nil {
+			return "", nil, fmt.Errorf("could not load a ConfigMap with name %s in namespace %s: %w", name, namespace, err)
+		}
+
+		return "configmap", cm.Data, nil
+	}
+	secret := corev1.Secret{}
+	if err := ctx.Client.Get(ctx.Ctx, key, &secret); err != nil {
+		return "", nil, fmt.Errorf("could not load a Secret with name %s in namespace %s: %w", name, namespace, err)
+	}
+	data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	for k, v := range secret.StringData {
+		data[k] = v
+	}
+
+	return "secret", data, nil
+}
+
+// kafkaRefProperty returns the name of a property of the endpoint referencing the given Secret.
+func kafkaRefProperty(namespace, name, key string) string {
+	return fmt.Sprintf("camel.k.binding.kafka.%s.%s.%s", namespace, name, key)
+}
+
+func kafkaLoginModule(mechanism string) (string, error) {
+	switch mechanism {
+	case "PLAIN":
+		return "org.apache.kafka.common.security.plain.PlainLoginModule", nil
+	case "SCRAM-SHA-256", "SCRAM-SHA-512":
+		return "org.apache.kafka.common.security.scram.ScramLoginModule", nil
+	default:
+		return "", fmt.Errorf("unsupported SASL mechanism %s: must be one of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512", mechanism)
+	}
+}
+
+// setDefaultParameter sets the endpoint parameter unless it is provided by the endpoint properties.
+func setDefaultParameter(props map[string]string, name, value string) {
+	if props[name] == "" {
+		props[name] = value
+	}
+}
+
+// Order --.
+func (k KafkaRefBindingProvider) Order() int {
+	return OrderStandard
+}
+
+func init() {
+	RegisterBindingProvider(KafkaRefBindingProvider{})
+}
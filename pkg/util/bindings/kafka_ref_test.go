@@ -0,0 +1,248 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	camelv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func TestKafkaRefConfigMap(t *testing.T) {
+	cm := corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "my-kafka", Namespace: "test"},
+		Data: map[string]string{
+			"type":             "kafka",
+			"bootstrapServers": "kafka-0:9092,kafka-1:9092",
+			"topic":            "orders",
+		},
+	}
+
+	binding, err := translateKafkaRef(t, "ConfigMap", "my-kafka", map[string]string{"groupId": "my-group"}, &cm)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "kafka:orders?brokers=kafka-0%3A9092%2Ckafka-1%3A9092&groupId=my-group", binding.URI)
+	assert.Empty(t, binding.Configs)
+	assert.Empty(t, binding.Resources)
+	assert.Empty(t, binding.ApplicationProperties)
+}
+
+func TestKafkaRefSecretSASLSSL(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-kafka", Namespace: "test"},
+		Data: map[string][]byte{
+			"type":             []byte("kafka"),
+			"bootstrapServers": []byte("kafka.example.com:9093"),
+			"saslMechanism":    []byte("SCRAM-SHA-512"),
+			"user":             []byte("my-user"),
+			"password":         []byte("my-password"),
+			"ca.crt":           []byte("my-ca"),
+		},
+	}
+
+	binding, err := translateKafkaRef(t, "Secret", "my-kafka", map[string]string{"topic": "payments"}, &secret)
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, "kafka:payments?brokers=kafka.example.com%3A9093&saslMechanism=SCRAM-SHA-512&securityProtocol=SASL_SSL"+
+		"&sslTruststoreLocation=%2Fetc%2Fcamel%2Fresources%2F_kafka%2Ftest%2Fmy-kafka%2Fca.crt&sslTruststoreType=PEM"+
+		"&saslJaasConfig=RAW({{camel.k.binding.kafka.test.my-kafka.sasl-jaas-config}})", binding.URI)
+	assert.Equal(t, []string{
+		"secret:my-kafka/user@/etc/camel/conf.d/_secrets/_bindings/test/my-kafka/camel.k.binding.kafka.test.my-kafka.user",
+		"secret:my-kafka/password@/etc/camel/conf.d/_secrets/_bindings/test/my-kafka/camel.k.binding.kafka.test.my-kafka.password",
+	}, binding.Configs)
+	assert.Equal(t, []string{"secret:my-kafka/ca.crt@/etc/camel/resources/_kafka/test/my-kafka/ca.crt"}, binding.Resources)
+	assert.Equal(t, map[string]string{
+		"camel.k.binding.kafka.test.my-kafka.sasl-jaas-config": `org.apache.kafka.common.security.scram.ScramLoginModule required ` +
+			`username="{{camel.k.binding.kafka.test.my-kafka.user}}" password="{{camel.k.binding.kafka.test.my-kafka.password}}";`,
+	}, binding.ApplicationProperties)
+}
+
+func TestKafkaRefSecretsOfTwoEndpoints(t *testing.T) {
+	orders := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "orders-kafka", Namespace: "test"},
+		Data: map[string][]byte{
+			"type":             []byte("kafka"),
+			"bootstrapServers": []byte("orders.example.com:9093"),
+			"user":             []byte("orders-user"),
+			"password":         []byte("orders-password"),
+		},
+	}
+	payments := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "payments-kafka", Namespace: "test"},
+		Data: map[string][]byte{
+			"type":             []byte("kafka"),
+			"bootstrapServers": []byte("payments.example.com:9093"),
+			"user":             []byte("payments-user"),
+			"password":         []byte("payments-password"),
+		},
+	}
+
+	source, err := translateKafkaRef(t, "Secret", "orders-kafka", map[string]string{"topic": "orders"}, &orders, &payments)
+	require.NoError(t, err)
+	require.NotNil(t, source)
+	sink, err := translateKafkaRef(t, "Secret", "payments-kafka", map[string]string{"topic": "payments"}, &orders, &payments)
+	require.NoError(t, err)
+	require.NotNil(t, sink)
+
+	// Each endpoint reads the credentials of its own Secret
+	assert.Equal(t, map[string]string{
+		"camel.k.binding.kafka.test.orders-kafka.sasl-jaas-config": `org.apache.kafka.common.security.plain.PlainLoginModule required ` +
+			`username="{{camel.k.binding.kafka.test.orders-kafka.user}}" password="{{camel.k.binding.kafka.test.orders-kafka.password}}";`,
+	}, source.ApplicationProperties)
+	assert.Equal(t, map[string]string{
+		"camel.k.binding.kafka.test.payments-kafka.sasl-jaas-config": `org.apache.kafka.common.security.plain.PlainLoginModule required ` +
+			`username="{{camel.k.binding.kafka.test.payments-kafka.user}}" password="{{camel.k.binding.kafka.test.payments-kafka.password}}";`,
+	}, sink.ApplicationProperties)
+	for _, config := range source.Configs {
+		assert.NotContains(t, sink.Configs, config)
+	}
+}
+
+func TestKafkaRefSecretsWithTheSameNameInTwoNamespaces(t *testing.T) {
+	secret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{Name: "kafka", Namespace: namespace},
+			Data: map[string][]byte{
+				"type":             []byte("kafka"),
+				"bootstrapServers": []byte(namespace + ".example.com:9093"),
+				"user":             []byte(namespace + "-user"),
+				"password":         []byte(namespace + "-password"),
+				"ca.crt":           []byte(namespace + "-ca"),
+			},
+		}
+	}
+	client, err := internal.NewFakeClient(secret("ns-a"), secret("ns-b"))
+	require.NoError(t, err)
+	bindingContext := BindingContext{
+		Ctx:       context.Background(),
+		Client:    client,
+		Namespace: "test",
+	}
+	translate := func(namespace string) *Binding {
+		endpoint := camelv1.Endpoint{
+			Ref: &corev1.ObjectReference{
+				Namespace:  namespace,
+				Name:       "kafka",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Secret",
+			},
+			Properties: asEndpointProperties(map[string]string{"topic": "orders"}),
+		}
+		binding, err := KafkaRefBindingProvider{}.Translate(bindingContext, EndpointContext{Type: camelv1.EndpointTypeSink}, endpoint)
+		require.NoError(t, err)
+		require.NotNil(t, binding)
+
+		return binding
+	}
+
+	source := translate("ns-a")
+	sink := translate("ns-b")
+	assert.Equal(t, []string{
+		"secret:ns-a:kafka/user@/etc/camel/conf.d/_secrets/_bindings/ns-a/kafka/camel.k.binding.kafka.ns-a.kafka.user",
+		"secret:ns-a:kafka/password@/etc/camel/conf.d/_secrets/_bindings/ns-a/kafka/camel.k.binding.kafka.ns-a.kafka.password",
+	}, source.Configs)
+	assert.Equal(t, []string{"secret:ns-a:kafka/ca.crt@/etc/camel/resources/_kafka/ns-a/kafka/ca.crt"}, source.Resources)
+	assert.Equal(t, []string{"secret:ns-b:kafka/ca.crt@/etc/camel/resources/_kafka/ns-b/kafka/ca.crt"}, sink.Resources)
+	for _, config := range source.Configs {
+		assert.NotContains(t, sink.Configs, config)
+	}
+	for property := range source.ApplicationProperties {
+		assert.NotContains(t, sink.ApplicationProperties, property)
+	}
+}
+
+func TestKafkaRefNotKafka(t *testing.T) {
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "my-secret", Namespace: "test"},
+		Data:       map[string][]byte{"password": []byte("my-password")},
+	}
+
+	binding, err := translateKafkaRef(t, "Secret", "my-secret", nil, &secret)
+	require.NoError(t, err)
+	assert.Nil(t, binding)
+}
+
+func TestKafkaRefWrongConfiguration(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     map[string]string
+		props    map[string]string
+		expected string
+	}{
+		{
+			name:     "missing topic",
+			data:     map[string]string{"type": "kafka", "bootstrapServers": "kafka:9092"},
+			expected: "invalid endpoint configuration: missing topic property",
+		},
+		{
+			name:     "missing bootstrap servers",
+			data:     map[string]string{"type": "kafka"},
+			props:    map[string]string{"topic": "orders"},
+			expected: "ConfigMap my-kafka has no bootstrapServers key",
+		},
+		{
+			name:     "credentials in configmap",
+			data:     map[string]string{"type": "kafka", "bootstrapServers": "kafka:9092", "user": "my-user"},
+			props:    map[string]string{"topic": "orders"},
+			expected: "the Kafka credentials of ConfigMap my-kafka must be provided by a Secret",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := corev1.ConfigMap{
+				ObjectMeta: v1.ObjectMeta{Name: "my-kafka", Namespace: "test"},
+				Data:       tc.data,
+			}
+			_, err := translateKafkaRef(t, "ConfigMap", "my-kafka", tc.props, &cm)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func translateKafkaRef(t *testing.T, kind, name string, props map[string]string, objects ...runtime.Object) (*Binding, error) {
+	t.Helper()
+
+	client, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	bindingContext := BindingContext{
+		Ctx:       context.Background(),
+		Client:    client,
+		Namespace: "test",
+	}
+	endpoint := camelv1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Name:       name,
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       kind,
+		},
+	}
+	if props != nil {
+		endpoint.Properties = asEndpointProperties(props)
+	}
+
+	return KafkaRefBindingProvider{}.Translate(bindingContext, EndpointContext{Type: camelv1.EndpointTypeSink}, endpoint)
+}
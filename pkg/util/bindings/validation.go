@@ -0,0 +1,383 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/client/camel/clientset/versioned"
+	"github.com/apache/camel-k/v2/pkg/kamelet/repository"
+	"github.com/apache/camel-k/v2/pkg/trait"
+)
+
+// KameletLookup returns the Kamelet referenced by an endpoint, or nil if it cannot be found.
+type KameletLookup func(ctx context.Context, ref *corev1.ObjectReference) (*v1.Kamelet, error)
+
+// NewKameletLookup returns a KameletLookup searching the Kamelet in the namespace of the reference first,
+// and then in the given fallback namespaces (typically the operator namespace).
+func NewKameletLookup(c versioned.Interface, fallbackNamespaces ...string) KameletLookup {
+	return func(ctx context.Context, ref *corev1.ObjectReference) (*v1.Kamelet, error) {
+		namespaces := append([]string{ref.Namespace}, fallbackNamespaces...)
+		repo, err := repository.New(ctx, c, namespaces...)
+		if err != nil {
+			return nil, err
+		}
+
+		return repo.Get(ctx, ref.Name)
+	}
+}
+
+// ValidatePipe checks the routing of the Pipe sinks and the Kamelet endpoints of the Pipe against the definition of
+// the referenced Kamelets: required properties, property types and enums, and data types. It returns the warnings about the checks
+// that could not be performed, along with the list of errors found. A missing required property is only reported
+// as a warning, as it can be provided by the Integration configuration, for instance from a mounted Secret.
+func ValidatePipe(ctx context.Context, pipe *v1.Pipe, lookup KameletLookup) ([]string, field.ErrorList) {
+	var warnings []string
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+	errs = append(errs, validateRouting(pipe, specPath)...)
+
+	configured := configuredProperties(pipe)
+	validate := func(e v1.Endpoint, endpointCtx EndpointContext, path *field.Path) {
+		w, err := validateKameletEndpoint(ctx, pipe.Namespace, e, endpointCtx, path, lookup, configured)
+		warnings = append(warnings, w...)
+		errs = append(errs, err...)
+	}
+
+	validate(pipe.Spec.Source, EndpointContext{Type: v1.EndpointTypeSource}, specPath.Child("source"))
+	for i, step := range pipe.Spec.Steps {
+		validate(step, EndpointContext{Type: v1.EndpointTypeAction, Position: &i}, specPath.Child("steps").Index(i))
+	}
+	validate(pipe.Spec.Sink, EndpointContext{Type: v1.EndpointTypeSink}, specPath.Child("sink"))
+	for i, sink := range pipe.Spec.Sinks {
+		validate(sink.Endpoint, EndpointContext{Type: v1.EndpointTypeSink, Position: &i}, specPath.Child("sinks").Index(i))
+	}
+
+	if pipe.Spec.ErrorHandler != nil {
+		var errorHandler struct {
+			Sink *struct {
+				Endpoint *v1.Endpoint `json:"endpoint,omitempty"`
+			} `json:"sink,omitempty"`
+		}
+		// a malformed error handler is reported by the Pipe controller
+		if err := json.Unmarshal(pipe.Spec.ErrorHandler.RawMessage, &errorHandler); err == nil &&
+			errorHandler.Sink != nil && errorHandler.Sink.Endpoint != nil {
+			validate(*errorHandler.Sink.Endpoint, EndpointContext{Type: v1.EndpointTypeErrorHandler}, specPath.Child("errorHandler", "sink", "endpoint"))
+		}
+	}
+
+	return warnings, errs
+}
+
+// ValidateRouting checks the sinks of a Pipe routing the events to several destinations.
+func ValidateRouting(pipe *v1.Pipe) error {
+	return validateRouting(pipe, field.NewPath("spec")).ToAggregate()
+}
+
+func validateRouting(pipe *v1.Pipe, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if len(pipe.Spec.Sinks) == 0 {
+		if pipe.Spec.Routing != "" {
+			errs = append(errs, field.Invalid(path.Child("routing"), pipe.Spec.Routing, "requires sinks"))
+		}
+
+		return errs
+	}
+	if pipe.Spec.Sink.Ref != nil || pipe.Spec.Sink.URI != nil {
+		errs = append(errs, field.Forbidden(path.Child("sink"), "a Pipe cannot declare both a sink and sinks"))
+	}
+
+	unfiltered := 0
+	for idx, sink := range pipe.Spec.Sinks {
+		if sink.Filter == "" {
+			if sink.FilterLanguage != "" {
+				errs = append(errs, field.Invalid(path.Child("sinks").Index(idx).Child("filterLanguage"), sink.FilterLanguage,
+					"a filter language requires a filter"))
+			}
+			unfiltered++
+		}
+	}
+
+	switch pipe.Spec.Routing {
+	case "", v1.PipeRoutingBroadcast:
+	case v1.PipeRoutingContentBased:
+		if unfiltered > 1 {
+			errs = append(errs, field.Invalid(path.Child("sinks"), unfiltered,
+				"content-based routing accepts at most one sink without filter"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("routing"), pipe.Spec.Routing,
+			[]string{string(v1.PipeRoutingBroadcast), string(v1.PipeRoutingContentBased)}))
+	}
+
+	return errs
+}
+
+// configuredProperties returns the keys of the properties set by the camel trait of the Pipe, either in the spec
+// or with the trait annotations, as the Integration created by the Pipe controller does.
+func configuredProperties(pipe *v1.Pipe) []string {
+	traits := pipe.Spec.Traits
+	if traits == nil {
+		// invalid trait annotations are reported by the Pipe controller
+		traits, _ = trait.ExtractAndMaybeDeleteTraits(nil, pipe.Annotations, false)
+	}
+	if traits == nil || traits.Camel == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(traits.Camel.Properties))
+	for _, prop := range traits.Camel.Properties {
+		key, _, _ := strings.Cut(prop, "=")
+		keys = append(keys, strings.TrimSpace(key))
+	}
+
+	return keys
+}
+
+func validateKameletEndpoint(
+	ctx context.Context, namespace string, e v1.Endpoint, endpointCtx EndpointContext, path *field.Path, lookup KameletLookup, configured []string,
+) ([]string, field.ErrorList) {
+	if e.Ref == nil || e.Ref.Kind != v1.KameletKind {
+		return nil, nil
+	}
+	if gv, err := schema.ParseGroupVersion(e.Ref.APIVersion); err != nil || gv.Group != v1.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+
+	ref := e.Ref.DeepCopy()
+	if ref.Namespace == "" {
+		ref.Namespace = namespace
+	}
+
+	propsPath := path.Child("properties")
+	props, err := endpointProperties(e.Properties)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(propsPath, string(e.Properties.RawMessage), err.Error())}
+	}
+
+	kamelet, err := lookup(ctx, ref)
+	if err != nil {
+		// do not prevent the Pipe from being admitted when the Kamelet cannot be retrieved
+		return []string{fmt.Sprintf("%s: cannot retrieve Kamelet %q, skipping validation: %v", path.Child("ref"), ref.Name, err)}, nil
+	}
+	if kamelet == nil {
+		// the Kamelet may be created after the Pipe, the controller will report it if still missing
+		return []string{fmt.Sprintf("%s: Kamelet %q not found in namespace %q, skipping validation", path.Child("ref"), ref.Name, ref.Namespace)}, nil
+	}
+
+	if version, ok := props[v1.KameletVersionProperty].(string); ok && version != "" {
+		kamelet, err = kamelet.CloneWithVersion(version)
+		if err != nil {
+			return nil, field.ErrorList{field.Invalid(propsPath.Child(v1.KameletVersionProperty), version, err.Error())}
+		}
+	}
+
+	id, ok := props[v1.KameletIDProperty].(string)
+	if !ok || id == "" {
+		id = endpointCtx.GenerateID()
+	}
+	warnings, errs := validateProperties(kamelet, id, props, propsPath, configured)
+	errs = append(errs, validateDataTypes(kamelet, e.DataTypes, path.Child("dataTypes"))...)
+
+	return warnings, errs
+}
+
+func endpointProperties(p *v1.EndpointProperties) (map[string]any, error) {
+	props := make(map[string]any)
+	if p == nil || len(p.RawMessage) == 0 {
+		return props, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(p.RawMessage))
+	decoder.UseNumber()
+	if err := decoder.Decode(&props); err != nil {
+		return nil, fmt.Errorf("cannot decode properties: %w", err)
+	}
+
+	return props, nil
+}
+
+func validateProperties(kamelet *v1.Kamelet, id string, props map[string]any, path *field.Path, configured []string) ([]string, field.ErrorList) {
+	var warnings []string
+	var errs field.ErrorList
+
+	definition := kamelet.Spec.Definition
+	if definition == nil {
+		return nil, nil
+	}
+
+	for _, name := range definition.Required {
+		if _, ok := props[name]; ok || isConfiguredKameletProperty(kamelet.Name, id, name, configured) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("%s: property required by Kamelet %q is not set, it must be provided by the Integration configuration",
+			path.Child(name), kamelet.Name))
+	}
+
+	for _, name := range kamelet.SortedDefinitionPropertiesKeys() {
+		value, ok := props[name]
+		if !ok {
+			continue
+		}
+		if err := validateProperty(kamelet, definition.Properties[name], value, path.Child(name)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(props)) {
+		if _, ok := definition.Properties[name]; ok || isKameletReservedProperty(name) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("%s: property not defined by Kamelet %q", path.Child(name), kamelet.Name))
+	}
+
+	return warnings, errs
+}
+
+func validateProperty(kamelet *v1.Kamelet, prop v1.JSONSchemaProp, value any, path *field.Path) *field.Error {
+	text, scalar := scalarString(value)
+	if scalar && strings.Contains(text, "{{") {
+		// property placeholders are only resolved at runtime
+		return nil
+	}
+
+	if !hasType(prop.Type, value) {
+		return field.Invalid(path, value, fmt.Sprintf("must be of type %s as defined by Kamelet %q", prop.Type, kamelet.Name))
+	}
+
+	if len(prop.Enum) > 0 {
+		allowed := make([]string, 0, len(prop.Enum))
+		for _, e := range prop.Enum {
+			var v any
+			if err := json.Unmarshal(e.RawMessage, &v); err != nil {
+				continue
+			}
+			allowed = append(allowed, fmt.Sprint(v))
+		}
+		if !slices.Contains(allowed, text) {
+			return field.NotSupported(path, value, allowed)
+		}
+	}
+
+	return nil
+}
+
+// hasType checks the value against the JSON schema type. Since properties end up as Camel endpoint options,
+// strings holding a valid representation of the expected type are accepted as well.
+func hasType(schemaType string, value any) bool {
+	text, scalar := scalarString(value)
+	switch schemaType {
+	case "string":
+		return scalar
+	case "boolean":
+		return scalar && (strings.EqualFold(text, "true") || strings.EqualFold(text, "false"))
+	case "integer":
+		_, err := strconv.ParseInt(text, 10, 64)
+
+		return scalar && err == nil
+	case "number":
+		_, err := strconv.ParseFloat(text, 64)
+
+		return scalar && err == nil
+	case "array":
+		_, ok := value.([]any)
+
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+
+		return ok
+	default:
+		return true
+	}
+}
+
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// isConfiguredKameletProperty checks whether the Kamelet property is set for all the Kamelet instances
+// (camel.kamelet.<kamelet>.<property>) or for the endpoint (camel.kamelet.<kamelet>.<id>.<property>).
+func isConfiguredKameletProperty(kamelet, id, name string, configured []string) bool {
+	prefix := "camel.kamelet." + kamelet + "."
+
+	return slices.Contains(configured, prefix+name) || slices.Contains(configured, prefix+id+"."+name)
+}
+
+func isKameletReservedProperty(name string) bool {
+	return name == v1.KameletIDProperty || name == v1.KameletVersionProperty || name == v1.KameletNamespaceProperty
+}
+
+func validateDataTypes(kamelet *v1.Kamelet, dataTypes map[v1.TypeSlot]v1.DataTypeReference, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for _, slot := range slices.Sorted(maps.Keys(dataTypes)) {
+		ref := dataTypes[slot]
+		slotPath := path.Child(string(slot))
+		spec, ok := kamelet.Spec.DataTypes[slot]
+		if !ok {
+			errs = append(errs, field.Invalid(slotPath, slot, fmt.Sprintf("Kamelet %q does not declare any %s data type", kamelet.Name, slot)))
+
+			continue
+		}
+
+		scheme := ref.Scheme
+		format := ref.Format
+		if scheme == "" && strings.Contains(format, ":") {
+			tuple := strings.SplitN(format, ":", 2)
+			scheme = tuple[0]
+			format = tuple[1]
+		}
+
+		found := false
+		allowed := make([]string, 0, len(spec.Types))
+		for _, name := range slices.Sorted(maps.Keys(spec.Types)) {
+			t := spec.Types[name]
+			allowed = append(allowed, name)
+			if (format == name || format == t.Format) && (scheme == "" || t.Scheme == "" || scheme == t.Scheme) {
+				found = true
+			}
+		}
+		if !found {
+			errs = append(errs, field.NotSupported(slotPath.Child("format"), ref.Format, allowed))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,276 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func validationKamelet() *v1.Kamelet {
+	return &v1.Kamelet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-kamelet", Namespace: "test"},
+		Spec: v1.KameletSpec{
+			KameletSpecBase: v1.KameletSpecBase{
+				Definition: &v1.JSONSchemaProps{
+					Required: []string{"topic"},
+					Properties: map[string]v1.JSONSchemaProp{
+						"topic":    {Type: "string"},
+						"period":   {Type: "integer"},
+						"ratio":    {Type: "number"},
+						"autoAck":  {Type: "boolean"},
+						"encoding": {Type: "string", Enum: []v1.JSON{{RawMessage: v1.RawMessage(`"utf-8"`)}, {RawMessage: v1.RawMessage(`"ascii"`)}}},
+					},
+				},
+				DataTypes: map[v1.TypeSlot]v1.DataTypesSpec{
+					v1.TypeSlotOut: {
+						Default: "binary",
+						Types: map[string]v1.DataTypeSpec{
+							"binary":     {Format: "application-octet-stream"},
+							"cloudevent": {Scheme: "my", Format: "application-cloudevents"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kameletEndpoint(props string) v1.Endpoint {
+	e := v1.Endpoint{
+		Ref: &corev1.ObjectReference{
+			Kind:       v1.KameletKind,
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Name:       "my-kamelet",
+		},
+	}
+	if props != "" {
+		e.Properties = &v1.EndpointProperties{RawMessage: v1.RawMessage(props)}
+	}
+
+	return e
+}
+
+func validationPipe(source, sink v1.Endpoint) *v1.Pipe {
+	return &v1.Pipe{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pipe", Namespace: "test"},
+		Spec: v1.PipeSpec{
+			Source: source,
+			Sink:   sink,
+		},
+	}
+}
+
+func TestValidatePipeValid(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	source := kameletEndpoint(`{"topic": "orders", "period": 1000, "ratio": "0.5", "autoAck": "true", "encoding": "ascii"}`)
+	source.DataTypes = map[v1.TypeSlot]v1.DataTypeReference{
+		v1.TypeSlotOut: {Format: "my:application-cloudevents"},
+	}
+	sink := kameletEndpoint(`{"topic": "{{my.topic}}", "period": "{{my.period}}"}`)
+	pipe := validationPipe(source, sink)
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+}
+
+func TestValidatePipeProperties(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	source := kameletEndpoint(`{"period": "1s", "ratio": "half", "autoAck": "yes", "encoding": "utf-16", "unknown": "value"}`)
+	sink := kameletEndpoint(`{"topic": ["orders"]}`)
+	pipe := validationPipe(source, sink)
+	pipe.Spec.Steps = []v1.Endpoint{kameletEndpoint("")}
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Equal(t, []string{
+		`spec.source.properties.topic: property required by Kamelet "my-kamelet" is not set, it must be provided by the Integration configuration`,
+		`spec.source.properties.unknown: property not defined by Kamelet "my-kamelet"`,
+		`spec.steps[0].properties.topic: property required by Kamelet "my-kamelet" is not set, it must be provided by the Integration configuration`,
+	}, warnings)
+	require.Len(t, errs, 5)
+	assert.Equal(t, "spec.source.properties.autoAck", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	assert.Contains(t, errs[0].Detail, "must be of type boolean")
+	assert.Equal(t, "spec.source.properties.encoding", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[1].Type)
+	assert.Contains(t, errs[1].Error(), `supported values: "utf-8", "ascii"`)
+	assert.Equal(t, "spec.source.properties.period", errs[2].Field)
+	assert.Contains(t, errs[2].Detail, "must be of type integer")
+	assert.Equal(t, "spec.source.properties.ratio", errs[3].Field)
+	assert.Contains(t, errs[3].Detail, "must be of type number")
+	assert.Equal(t, "spec.sink.properties.topic", errs[4].Field)
+	assert.Contains(t, errs[4].Detail, "must be of type string")
+}
+
+func TestValidatePipeConfiguredProperties(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	source := kameletEndpoint(`{"id": "my-source"}`)
+	sink := kameletEndpoint("")
+	step := kameletEndpoint("")
+	pipe := validationPipe(source, sink)
+	pipe.Spec.Steps = []v1.Endpoint{step}
+	pipe.Spec.Traits = &v1.Traits{
+		Camel: &traitv1.CamelTrait{
+			Properties: []string{
+				"camel.kamelet.my-kamelet.my-source.topic=orders",
+				"camel.kamelet.my-kamelet.sink.topic = {{secret.topic}}",
+			},
+		},
+	}
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{
+		`spec.steps[0].properties.topic: property required by Kamelet "my-kamelet" is not set, it must be provided by the Integration configuration`,
+	}, warnings)
+
+	pipe.Spec.Traits = nil
+	pipe.Annotations = map[string]string{
+		v1.TraitAnnotationPrefix + "camel.properties": `["camel.kamelet.my-kamelet.topic=orders"]`,
+	}
+	warnings, errs = ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, errs)
+	assert.Empty(t, warnings)
+}
+
+func TestValidatePipeDataTypes(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	source := kameletEndpoint(`{"topic": "orders"}`)
+	source.DataTypes = map[v1.TypeSlot]v1.DataTypeReference{
+		v1.TypeSlotIn:  {Format: "binary"},
+		v1.TypeSlotOut: {Scheme: "other", Format: "application-cloudevents"},
+	}
+	sink := kameletEndpoint(`{"topic": "orders"}`)
+	sink.DataTypes = map[v1.TypeSlot]v1.DataTypeReference{
+		v1.TypeSlotOut: {Format: "binary"},
+	}
+	pipe := validationPipe(source, sink)
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, warnings)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "spec.source.dataTypes.in", errs[0].Field)
+	assert.Contains(t, errs[0].Detail, `Kamelet "my-kamelet" does not declare any in data type`)
+	assert.Equal(t, "spec.source.dataTypes.out.format", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[1].Type)
+	assert.Contains(t, errs[1].Error(), `supported values: "binary", "cloudevent"`)
+}
+
+func TestValidatePipeErrorHandlerSink(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	pipe := validationPipe(v1.Endpoint{URI: ptr.To("timer:tick")}, v1.Endpoint{URI: ptr.To("log:info")})
+	pipe.Spec.ErrorHandler = &v1.ErrorHandlerSpec{
+		RawMessage: v1.RawMessage(`{"sink": {"endpoint": {"ref": {"kind": "Kamelet", "apiVersion": "camel.apache.org/v1", "name": "my-kamelet"}}}}`),
+	}
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, errs)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "spec.errorHandler.sink.endpoint.properties.topic: property required by Kamelet")
+}
+
+func TestValidatePipeKameletNotFound(t *testing.T) {
+	client, err := internal.NewFakeClient()
+	require.NoError(t, err)
+
+	pipe := validationPipe(kameletEndpoint(`{"period": "1s"}`), v1.Endpoint{URI: ptr.To("log:info")})
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{`spec.source.ref: Kamelet "my-kamelet" not found in namespace "test", skipping validation`}, warnings)
+}
+
+func TestValidatePipeFallbackNamespace(t *testing.T) {
+	kamelet := validationKamelet()
+	kamelet.Namespace = "operator"
+	client, err := internal.NewFakeClient(kamelet)
+	require.NoError(t, err)
+
+	pipe := validationPipe(kameletEndpoint(`{}`), v1.Endpoint{URI: ptr.To("log:info")})
+
+	warnings, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client, "operator"))
+	assert.Empty(t, errs)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "spec.source.properties.topic: property required by Kamelet")
+}
+
+func TestValidatePipeSinks(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	pipe := validationPipe(v1.Endpoint{URI: ptr.To("timer:tick")}, v1.Endpoint{})
+	pipe.Spec.Sinks = []v1.PipeSink{
+		{Endpoint: v1.Endpoint{URI: ptr.To("log:info")}},
+		{Endpoint: kameletEndpoint(`{"topic": "orders", "period": "often"}`), Filter: "${header.important}"},
+	}
+
+	_, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.sinks[1].properties.period", errs[0].Field)
+}
+
+func TestValidatePipeRouting(t *testing.T) {
+	client, err := internal.NewFakeClient(validationKamelet())
+	require.NoError(t, err)
+
+	pipe := validationPipe(v1.Endpoint{URI: ptr.To("timer:tick")}, v1.Endpoint{URI: ptr.To("log:info")})
+	pipe.Spec.Routing = v1.PipeRoutingContentBased
+	pipe.Spec.Sinks = []v1.PipeSink{
+		{Endpoint: v1.Endpoint{URI: ptr.To("log:info")}},
+		{Endpoint: kameletEndpoint(`{"topic": "orders"}`), FilterLanguage: "jq"},
+	}
+
+	_, errs := ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	require.Len(t, errs, 3)
+	assert.Equal(t, "spec.sink", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.sinks[1].filterLanguage", errs[1].Field)
+	assert.Equal(t, "spec.sinks", errs[2].Field)
+	assert.Contains(t, errs[2].Detail, "content-based routing accepts at most one sink without filter")
+
+	pipe.Spec.Sink = v1.Endpoint{}
+	pipe.Spec.Routing = "round-robin"
+	pipe.Spec.Sinks[1].Filter = "${header.important}"
+	_, errs = ValidatePipe(context.TODO(), pipe, NewKameletLookup(client))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "spec.routing", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	require.NoError(t, ValidateRouting(validationPipe(v1.Endpoint{URI: ptr.To("timer:tick")}, v1.Endpoint{URI: ptr.To("log:info")})))
+}
@@ -0,0 +1,212 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cosign provides the support to sign container images in a format compatible with https://github.com/sigstore/cosign.
+package cosign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PrivateKeySecretKey is the key of the private key in the Secret generated by `cosign generate-key-pair k8s://<namespace>/<name>`.
+	PrivateKeySecretKey = "cosign.key"
+	// PasswordSecretKey is the key of the private key password in the Secret generated by `cosign generate-key-pair k8s://<namespace>/<name>`.
+	PasswordSecretKey = "cosign.password"
+
+	// SignatureTagSuffix is the suffix of the tag the image signature is pushed to.
+	SignatureTagSuffix = "sig"
+	// AttestationTagSuffix is the suffix of the tag the image attestations are pushed to.
+	AttestationTagSuffix = "att"
+	// SimpleSigningMediaType is the media type of the signed payload.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// DSSEMediaType is the media type of the signed attestation envelope.
+	DSSEMediaType = "application/vnd.dsse.envelope.v1+json"
+	// SignatureAnnotation is the layer annotation holding the base64 encoded signature.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// PredicateTypeAnnotation is the layer annotation holding the type of the attestation predicate.
+	PredicateTypeAnnotation = "predicateType"
+	// InTotoPayloadType is the payload type of the in-toto attestations.
+	InTotoPayloadType = "application/vnd.in-toto+json"
+
+	encryptedPrivateKeyPEMType       = "ENCRYPTED SIGSTORE PRIVATE KEY"
+	legacyEncryptedPrivateKeyPEMType = "ENCRYPTED COSIGN PRIVATE KEY"
+	//nolint:gosec
+	privateKeyPEMType   = "PRIVATE KEY"
+	ecPrivateKeyPEMType = "EC PRIVATE KEY"
+)
+
+// encryptedKey is the format of the password encrypted private keys generated by cosign.
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// LoadPrivateKey loads a PEM encoded private key, either encrypted by cosign with the given password,
+// or an unencrypted PKCS #8 or EC private key.
+func LoadPrivateKey(data []byte, password []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid private key: no PEM block found")
+	}
+
+	der := block.Bytes
+	switch block.Type {
+	case encryptedPrivateKeyPEMType, legacyEncryptedPrivateKeyPEMType:
+		var err error
+		if der, err = decrypt(block.Bytes, password); err != nil {
+			return nil, err
+		}
+	case ecPrivateKeyPEMType:
+		return x509.ParseECPrivateKey(der)
+	case privateKeyPEMType:
+	default:
+		return nil, fmt.Errorf("unsupported private key type: %s", block.Type)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key: %T", key)
+	}
+
+	return signer, nil
+}
+
+func decrypt(data []byte, password []byte) ([]byte, error) {
+	var key encryptedKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("invalid encrypted private key: %w", err)
+	}
+	if key.KDF.Name != "scrypt" || key.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("unsupported private key encryption: %s, %s", key.KDF.Name, key.Cipher.Name)
+	}
+	const keyLen = 32
+	secret, err := scrypt.Key(password, key.KDF.Salt, key.KDF.Params.N, key.KDF.Params.R, key.KDF.Params.P, keyLen)
+	if err != nil {
+		return nil, err
+	}
+	var k [keyLen]byte
+	copy(k[:], secret)
+	var nonce [24]byte
+	copy(nonce[:], key.Cipher.Nonce)
+	der, ok := secretbox.Open(nil, key.Ciphertext, &nonce, &k)
+	if !ok {
+		return nil, errors.New("cannot decrypt private key: wrong password")
+	}
+
+	return der, nil
+}
+
+// Sign signs the payload with the given key, and returns the base64 encoded signature.
+func Sign(signer crypto.Signer, payload []byte) (string, error) {
+	var sig []byte
+	var err error
+	switch signer.(type) {
+	case ed25519.PrivateKey:
+		sig, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+		digest := sha256.Sum256(payload)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return "", fmt.Errorf("unsupported private key: %T", signer)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// SimpleSigningPayload returns the payload to sign to attest the image with the given repository and manifest digest.
+func SimpleSigningPayload(repository string, digest string) ([]byte, error) {
+	payload := map[string]any{
+		"critical": map[string]any{
+			"identity": map[string]string{
+				"docker-reference": repository,
+			},
+			"image": map[string]string{
+				"docker-manifest-digest": digest,
+			},
+			"type": "cosign container image signature",
+		},
+		"optional": nil,
+	}
+
+	return json.Marshal(payload)
+}
+
+// Envelope is a Dead Simple Signing Envelope.
+type Envelope struct {
+	PayloadType string              `json:"payloadType"`
+	Payload     string              `json:"payload"`
+	Signatures  []EnvelopeSignature `json:"signatures"`
+}
+
+// EnvelopeSignature is the signature of a Dead Simple Signing Envelope.
+type EnvelopeSignature struct {
+	KeyID     string `json:"keyid"`
+	Signature string `json:"sig"`
+}
+
+// SignEnvelope wraps the payload of the given type into a Dead Simple Signing Envelope, signed with the given key.
+func SignEnvelope(signer crypto.Signer, payloadType string, payload []byte) ([]byte, error) {
+	sig, err := Sign(signer, pae(payloadType, payload))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Envelope{
+		PayloadType: payloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []EnvelopeSignature{{Signature: sig}},
+	})
+}
+
+// pae is the pre-authentication encoding of the payload, as defined by the DSSE specification.
+func pae(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
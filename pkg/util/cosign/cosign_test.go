@@ -0,0 +1,151 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+func encryptKey(t *testing.T, der []byte, password []byte) []byte {
+	t.Helper()
+	key := encryptedKey{}
+	key.KDF.Name = "scrypt"
+	key.KDF.Params.N = 1024
+	key.KDF.Params.R = 8
+	key.KDF.Params.P = 1
+	key.KDF.Salt = []byte("0123456789abcdef0123456789abcdef")
+	key.Cipher.Name = "nacl/secretbox"
+	key.Cipher.Nonce = []byte("0123456789abcdef01234567")
+
+	secret, err := scrypt.Key(password, key.KDF.Salt, key.KDF.Params.N, key.KDF.Params.R, key.KDF.Params.P, 32)
+	require.NoError(t, err)
+	var k [32]byte
+	copy(k[:], secret)
+	var nonce [24]byte
+	copy(nonce[:], key.Cipher.Nonce)
+	key.Ciphertext = secretbox.Seal(nil, der, &nonce, &k)
+
+	data, err := json.Marshal(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: encryptedPrivateKeyPEMType, Bytes: data})
+}
+
+func TestLoadEncryptedPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	data := encryptKey(t, der, []byte("secret"))
+
+	signer, err := LoadPrivateKey(data, []byte("secret"))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+
+	_, err = LoadPrivateKey(data, []byte("wrong"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "wrong password")
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+
+	der, err = x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	signer, err = LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(signer))
+
+	_, err = LoadPrivateKey([]byte("not a key"), nil)
+	require.Error(t, err)
+	_, err = LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil)
+	require.Error(t, err)
+}
+
+func TestSign(t *testing.T) {
+	payload, err := SimpleSigningPayload("registry/camel-k/kit", "sha256:abc")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"critical": {
+			"identity": {"docker-reference": "registry/camel-k/kit"},
+			"image": {"docker-manifest-digest": "sha256:abc"},
+			"type": "cosign container image signature"
+		},
+		"optional": null
+	}`, string(payload))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sig, err := Sign(ecKey, payload)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	require.NoError(t, err)
+	digest := sha256.Sum256(payload)
+	assert.True(t, ecdsa.VerifyASN1(&ecKey.PublicKey, digest[:], raw))
+
+	public, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sig, err = Sign(edKey, payload)
+	require.NoError(t, err)
+	raw, err = base64.StdEncoding.DecodeString(sig)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(public, payload, raw))
+}
+
+func TestSignEnvelope(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	data, err := SignEnvelope(key, InTotoPayloadType, []byte(`{"_type":"https://in-toto.io/Statement/v1"}`))
+	require.NoError(t, err)
+
+	var envelope Envelope
+	require.NoError(t, json.Unmarshal(data, &envelope))
+	assert.Equal(t, InTotoPayloadType, envelope.PayloadType)
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_type":"https://in-toto.io/Statement/v1"}`, string(payload))
+
+	require.Len(t, envelope.Signatures, 1)
+	raw, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Signature)
+	require.NoError(t, err)
+	digest := sha256.Sum256(fmt.Appendf(nil, "DSSEv1 28 application/vnd.in-toto+json %d %s", len(payload), payload))
+	assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, digest[:], raw))
+}
@@ -0,0 +1,81 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalsecret provides a pluggable layer resolving the secrets stored out of the cluster, which are
+// referenced by the Integrations with the `provider:path[#key][@destination]` syntax.
+package externalsecret
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/camel-k/v2/pkg/client"
+)
+
+// Provider resolves the secrets stored in an external secret store.
+type Provider interface {
+	// ID returns the name of the provider, used as prefix of the secret references
+	ID() string
+	// Resolve returns the content of the referenced secret
+	Resolve(ctx Context, ref Reference) (*Content, error)
+}
+
+// Context is the context of the resolution of an external secret.
+//
+//nolint:containedctx
+type Context struct {
+	Ctx       context.Context
+	Client    client.Client
+	Namespace string
+	// ServiceAccount is the ServiceAccount of the Integration, which the providers may authenticate with
+	ServiceAccount string
+}
+
+// Content is the content of an external secret, as resolved by a Provider.
+type Content struct {
+	// Data is the content of the secret, which the operator syncs into a Secret of the Integration namespace
+	Data map[string][]byte
+	// Volume projects the secret into the Integration pods, for the stores which don't expose the secret to the operator
+	Volume *corev1.VolumeSource
+}
+
+// Digest returns a hash of the secret data, which changes when the secret is rotated. It is empty
+// when the secret is projected with a volume, as the secret is then rotated in the pods directly.
+func (c *Content) Digest() string {
+	if c.Data == nil {
+		return ""
+	}
+	keys := make([]string, 0, len(c.Data))
+	for k := range c.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, k := range keys {
+		hash.Write([]byte(k))
+		hash.Write([]byte{0})
+		hash.Write(c.Data[k])
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"fmt"
+	"sort"
+)
+
+var providers = make(map[string]Provider)
+
+// RegisterProvider --.
+func RegisterProvider(p Provider) {
+	providers[p.ID()] = p
+}
+
+// GetProvider returns the provider with the given ID, or nil if it is not registered.
+func GetProvider(id string) Provider {
+	return providers[id]
+}
+
+// ProviderIDs returns the sorted IDs of the registered providers.
+func ProviderIDs() []string {
+	ids := make([]string, 0, len(providers))
+	for id := range providers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Resolve returns the content of the referenced secret, filtered by the reference key if any.
+func Resolve(ctx Context, ref Reference) (*Content, error) {
+	p := GetProvider(ref.Provider)
+	if p == nil {
+		return nil, fmt.Errorf("unknown external secret provider %s. Providers available: %q", ref.Provider, ProviderIDs())
+	}
+	content, err := p.Resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve external secret %s: %w", ref.String(), err)
+	}
+	if content.Data != nil && ref.Key != "" {
+		value, ok := content.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("could not resolve external secret %s: key %s not found", ref.String(), ref.Key)
+		}
+		content.Data = map[string][]byte{ref.Key: value}
+	}
+
+	return content, nil
+}
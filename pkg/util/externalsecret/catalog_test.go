@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localProvider is a stand-in provider, resolving the secrets from memory.
+type localProvider map[string]map[string][]byte
+
+func (p localProvider) ID() string {
+	return "local"
+}
+
+func (p localProvider) Resolve(ctx Context, ref Reference) (*Content, error) {
+	data := make(map[string][]byte)
+	for k, v := range p[ref.Path] {
+		data[k] = v
+	}
+
+	return &Content{Data: data}, nil
+}
+
+func TestResolve(t *testing.T) {
+	RegisterProvider(localProvider{
+		"db": {"user": []byte("admin"), "password": []byte("changeit")},
+	})
+	t.Cleanup(func() { delete(providers, "local") })
+	ctx := Context{Ctx: context.Background(), Namespace: "default"}
+
+	content, err := Resolve(ctx, Reference{Provider: "local", Path: "db"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin"), "password": []byte("changeit")}, content.Data)
+
+	content, err = Resolve(ctx, Reference{Provider: "local", Path: "db", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("changeit")}, content.Data)
+
+	_, err = Resolve(ctx, Reference{Provider: "local", Path: "db", Key: "token"})
+	require.EqualError(t, err, "could not resolve external secret local:db#token: key token not found")
+}
+
+func TestContentDigest(t *testing.T) {
+	content := Content{Data: map[string][]byte{"user": []byte("admin"), "password": []byte("changeit")}}
+	rotated := Content{Data: map[string][]byte{"user": []byte("admin"), "password": []byte("rotated")}}
+
+	assert.NotEmpty(t, content.Digest())
+	assert.NotEqual(t, content.Digest(), rotated.Digest())
+	assert.Empty(t, (&Content{}).Digest())
+}
+
+func TestCSIProvider(t *testing.T) {
+	content, err := Resolve(Context{Ctx: context.Background()}, Reference{Provider: "csi", Path: "my-provider-class"})
+	require.NoError(t, err)
+	assert.Nil(t, content.Data)
+	require.NotNil(t, content.Volume)
+	require.NotNil(t, content.Volume.CSI)
+	assert.Equal(t, CSIDriver, content.Volume.CSI.Driver)
+	assert.Equal(t, "my-provider-class", content.Volume.CSI.VolumeAttributes[CSISecretProviderClassAttribute])
+}
@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// CSIDriver is the name of the Secrets Store CSI driver.
+	CSIDriver = "secrets-store.csi.k8s.io"
+	// CSISecretProviderClassAttribute is the volume attribute referencing the SecretProviderClass.
+	CSISecretProviderClassAttribute = "secretProviderClass"
+)
+
+// CSIProvider projects the secrets described by a SecretProviderClass of the Secrets Store CSI driver, with the
+// `csi:secret-provider-class[#object]` syntax. The secrets are mounted by the driver directly, which also rotates them.
+type CSIProvider struct{}
+
+// ID --.
+func (p CSIProvider) ID() string {
+	return "csi"
+}
+
+// Resolve --.
+func (p CSIProvider) Resolve(ctx Context, ref Reference) (*Content, error) {
+	return &Content{
+		Volume: &corev1.VolumeSource{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:   CSIDriver,
+				ReadOnly: ptr.To(true),
+				VolumeAttributes: map[string]string{
+					CSISecretProviderClassAttribute: ref.Path,
+				},
+			},
+		},
+	}, nil
+}
+
+func init() {
+	RegisterProvider(CSIProvider{})
+}
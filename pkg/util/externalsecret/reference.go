@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+)
+
+var referenceRegexp = regexp.MustCompile(`^([a-z][a-z0-9\-]*):([\w\.\-\/]+)(#([\w\.\-]+))?(@([\w\.\-\_\:\/]+))?$`)
+
+// Reference is a reference to an external secret.
+type Reference struct {
+	// Provider is the ID of the provider resolving the secret
+	Provider string
+	// Path is the location of the secret in the external store
+	Path string
+	// Key optionally filters a single entry of the secret
+	Key string
+	// DestinationPath optionally is the path where the secret is mounted in the Integration container
+	DestinationPath string
+}
+
+// IsReference returns true if the value references a secret of a registered provider.
+func IsReference(value string) bool {
+	groups := referenceRegexp.FindStringSubmatch(value)
+	if groups == nil {
+		return false
+	}
+
+	return GetProvider(groups[1]) != nil
+}
+
+// ParseReference parses a reference with the `provider:path[#key][@destination]` syntax.
+func ParseReference(value string) (*Reference, error) {
+	groups := referenceRegexp.FindStringSubmatch(value)
+	if groups == nil {
+		return nil, fmt.Errorf("could not match external secret reference %s, expected syntax is provider:path[#key][@destination]", value)
+	}
+	if GetProvider(groups[1]) == nil {
+		return nil, fmt.Errorf("unknown external secret provider %s. Providers available: %q", groups[1], ProviderIDs())
+	}
+
+	return &Reference{
+		Provider:        groups[1],
+		Path:            groups[2],
+		Key:             groups[4],
+		DestinationPath: groups[6],
+	}, nil
+}
+
+// String represents the unparsed value of the reference.
+func (r Reference) String() string {
+	s := fmt.Sprintf("%s:%s", r.Provider, r.Path)
+	if r.Key != "" {
+		s = fmt.Sprintf("%s#%s", s, r.Key)
+	}
+	if r.DestinationPath != "" {
+		s = fmt.Sprintf("%s@%s", s, r.DestinationPath)
+	}
+
+	return s
+}
+
+// PropertiesSecretName returns the name of the Secret the properties referencing external secrets are synced into,
+// for the given Integration.
+func PropertiesSecretName(integration string) string {
+	return integration + "-external-properties"
+}
+
+// SecretName returns the name of the Secret the referenced secret is synced into, for the given Integration.
+// References to the same secret and key share the same Secret.
+func (r Reference) SecretName(integration string) string {
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s:%s#%s", r.Provider, r.Path, r.Key))
+
+	return fmt.Sprintf("%s-%s-%s", integration, r.Provider, hex.EncodeToString(hash[:])[:10])
+}
@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("vault:secret/db#password@/etc/db/password")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{
+		Provider:        "vault",
+		Path:            "secret/db",
+		Key:             "password",
+		DestinationPath: "/etc/db/password",
+	}, *ref)
+	assert.Equal(t, "vault:secret/db#password@/etc/db/password", ref.String())
+
+	ref, err = ParseReference("csi:my-provider-class")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{Provider: "csi", Path: "my-provider-class"}, *ref)
+
+	_, err = ParseReference("unknown:secret/db#password")
+	require.EqualError(t, err, `unknown external secret provider unknown. Providers available: ["csi" "vault"]`)
+
+	_, err = ParseReference("vault:secret db")
+	require.Error(t, err)
+}
+
+func TestIsReference(t *testing.T) {
+	assert.True(t, IsReference("vault:secret/db#password"))
+	assert.True(t, IsReference("csi:my-provider-class"))
+	assert.False(t, IsReference("secret:my-secret/password"))
+	assert.False(t, IsReference("configmap:my-cm"))
+	assert.False(t, IsReference("a plain value"))
+}
+
+func TestSecretName(t *testing.T) {
+	password := Reference{Provider: "vault", Path: "secret/db", Key: "password"}
+	mounted := Reference{Provider: "vault", Path: "secret/db", Key: "password", DestinationPath: "/etc/db"}
+	user := Reference{Provider: "vault", Path: "secret/db", Key: "user"}
+
+	assert.Regexp(t, "^my-it-vault-[0-9a-f]{10}$", password.SecretName("my-it"))
+	assert.Equal(t, password.SecretName("my-it"), mounted.SecretName("my-it"))
+	assert.NotEqual(t, password.SecretName("my-it"), user.SecretName("my-it"))
+}
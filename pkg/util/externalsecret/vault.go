@@ -0,0 +1,238 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// VaultAddressEnvVar is the operator environment variable holding the address of the HashiCorp Vault server.
+	VaultAddressEnvVar = "VAULT_ADDR"
+	// VaultCredentialsSecret is the Secret of the Integration namespace holding the credentials used to read the HashiCorp Vault secrets.
+	VaultCredentialsSecret = "camel-k-vault"
+	// VaultTokenKey is the key of the credentials Secret holding a Vault token.
+	VaultTokenKey = "token"
+	// VaultRoleKey is the key of the credentials Secret holding a role of the Vault Kubernetes auth method, which the
+	// Integration ServiceAccount is bound to.
+	VaultRoleKey = "role"
+	// VaultAuthMountKey is the key of the credentials Secret holding the mount of the Vault Kubernetes auth method.
+	VaultAuthMountKey = "auth-mount"
+	// VaultPathsKey is the key of the credentials Secret holding the comma separated list of the paths the Integrations
+	// of the namespace are allowed to read.
+	VaultPathsKey = "paths"
+
+	vaultDefaultAuthMount     = "kubernetes"
+	vaultRequestTimeout       = 30 * time.Second
+	vaultServiceAccountExpiry = int64(600)
+)
+
+// VaultProvider reads the secrets stored in a HashiCorp Vault KV version 2 secrets engine, with the
+// `vault:mount/path[#key]` syntax. The Vault server is configured with the VAULT_ADDR environment variable of
+// the operator, while the credentials are read from the camel-k-vault Secret of the Integration namespace, either
+// as a token, or as a role of the Vault Kubernetes auth method the Integration ServiceAccount logs in with.
+type VaultProvider struct{}
+
+// ID --.
+func (p VaultProvider) ID() string {
+	return "vault"
+}
+
+// Resolve --.
+func (p VaultProvider) Resolve(ctx Context, ref Reference) (*Content, error) {
+	address := os.Getenv(VaultAddressEnvVar)
+	if address == "" {
+		return nil, fmt.Errorf("the %s environment variable must be set on the operator", VaultAddressEnvVar)
+	}
+	address = strings.TrimSuffix(address, "/")
+	mount, path, ok := strings.Cut(ref.Path, "/")
+	if !ok || mount == "" || path == "" {
+		return nil, fmt.Errorf("vault secret path %s must be in the form mount/path", ref.Path)
+	}
+	credentials, err := vaultCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isVaultPathAllowed(credentials, ref.Path) {
+		return nil, fmt.Errorf("vault secret path %s is not allowed in namespace %s, the paths allowed are %q",
+			ref.Path, ctx.Namespace, string(credentials.Data[VaultPathsKey]))
+	}
+	token, err := vaultToken(ctx, address, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx.Ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s/data/%s", address, mount, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	client := http.Client{Timeout: vaultRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errors.New("secret not found")
+	default:
+		return nil, fmt.Errorf("unexpected status %s from the vault server", resp.Status)
+	}
+
+	var secret struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("could not decode the vault secret: %w", err)
+	}
+
+	data := make(map[string][]byte, len(secret.Data.Data))
+	for k, v := range secret.Data.Data {
+		if s, ok := v.(string); ok {
+			data[k] = []byte(s)
+
+			continue
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = value
+	}
+
+	return &Content{Data: data}, nil
+}
+
+// vaultCredentials returns the Secret holding the Vault credentials of the Integration namespace. The references
+// are rejected when there is none, so that an Integration cannot read the secrets granted to the operator or to
+// other namespaces.
+func vaultCredentials(ctx Context) (*corev1.Secret, error) {
+	if ctx.Client == nil {
+		return nil, fmt.Errorf("no %s Secret holding the vault credentials in namespace %s", VaultCredentialsSecret, ctx.Namespace)
+	}
+	secret := corev1.Secret{}
+	err := ctx.Client.Get(ctx.Ctx, ctrl.ObjectKey{Namespace: ctx.Namespace, Name: VaultCredentialsSecret}, &secret)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("no %s Secret holding the vault credentials in namespace %s", VaultCredentialsSecret, ctx.Namespace)
+	} else if err != nil {
+		return nil, err
+	}
+	if len(secret.Data[VaultTokenKey]) == 0 && len(secret.Data[VaultRoleKey]) == 0 {
+		return nil, fmt.Errorf("the %s Secret of namespace %s must hold either a %s or a %s key",
+			VaultCredentialsSecret, ctx.Namespace, VaultTokenKey, VaultRoleKey)
+	}
+
+	return &secret, nil
+}
+
+// isVaultPathAllowed returns true if the path matches any of the paths allowed by the credentials, or if
+// the credentials do not restrict the paths.
+func isVaultPathAllowed(credentials *corev1.Secret, path string) bool {
+	paths, ok := credentials.Data[VaultPathsKey]
+	if !ok {
+		return true
+	}
+	for _, allowed := range strings.Split(string(paths), ",") {
+		allowed = strings.TrimSuffix(strings.TrimSpace(allowed), "/")
+		if allowed != "" && (path == allowed || strings.HasPrefix(path, allowed+"/")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vaultToken returns the token of the credentials, or logs in with the Integration ServiceAccount when they
+// hold a role of the Vault Kubernetes auth method.
+func vaultToken(ctx Context, address string, credentials *corev1.Secret) (string, error) {
+	if token := credentials.Data[VaultTokenKey]; len(token) > 0 {
+		return string(token), nil
+	}
+	serviceAccount := ctx.ServiceAccount
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	request, err := ctx.Client.CoreV1().ServiceAccounts(ctx.Namespace).CreateToken(ctx.Ctx, serviceAccount,
+		&authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: ptr.To(vaultServiceAccountExpiry),
+			},
+		}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not request a token for ServiceAccount %s: %w", serviceAccount, err)
+	}
+
+	authMount := string(credentials.Data[VaultAuthMountKey])
+	if authMount == "" {
+		authMount = vaultDefaultAuthMount
+	}
+	login, err := json.Marshal(map[string]string{
+		"role": string(credentials.Data[VaultRoleKey]),
+		"jwt":  request.Status.Token,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx.Ctx, http.MethodPost, fmt.Sprintf("%s/v1/auth/%s/login", address, authMount), bytes.NewReader(login))
+	if err != nil {
+		return "", err
+	}
+	client := http.Client{Timeout: vaultRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from the vault server when logging in with ServiceAccount %s", resp.Status, serviceAccount)
+	}
+
+	var auth struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&auth); err != nil {
+		return "", fmt.Errorf("could not decode the vault login response: %w", err)
+	}
+
+	return auth.Auth.ClientToken, nil
+}
+
+func init() {
+	RegisterProvider(VaultProvider{})
+}
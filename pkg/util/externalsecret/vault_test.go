@@ -0,0 +1,176 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func newVaultServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/kubernetes/login" {
+			var login map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role"] != "my-role" || login["jwt"] != "my-sa-token" {
+				w.WriteHeader(http.StatusForbidden)
+
+				return
+			}
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"my-token"}}`))
+
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "my-token" {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/db" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"user":"admin","password":"changeit","port":5432},"metadata":{"version":3}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newVaultContext(t *testing.T, data map[string]string) Context {
+	t.Helper()
+	objects := make([]runtime.Object, 0)
+	if data != nil {
+		secret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: VaultCredentialsSecret},
+			Data:       make(map[string][]byte),
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		objects = append(objects, &secret)
+	}
+	c, err := internal.NewFakeClient(objects...)
+	require.NoError(t, err)
+
+	return Context{Ctx: context.Background(), Client: c, Namespace: "default", ServiceAccount: "my-sa"}
+}
+
+func TestVaultProvider(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, newVaultServer(t).URL)
+	ctx := newVaultContext(t, map[string]string{VaultTokenKey: "my-token"})
+
+	content, err := Resolve(ctx, Reference{Provider: "vault", Path: "secret/db"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte("changeit"),
+		"port":     []byte("5432"),
+	}, content.Data)
+
+	content, err = Resolve(ctx, Reference{Provider: "vault", Path: "secret/db", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("changeit")}, content.Data)
+
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "secret/missing"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/missing: secret not found")
+
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "db"})
+	require.EqualError(t, err, "could not resolve external secret vault:db: vault secret path db must be in the form mount/path")
+}
+
+func TestVaultProviderKubernetesAuth(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, newVaultServer(t).URL)
+	ctx := newVaultContext(t, map[string]string{VaultRoleKey: "my-role"})
+	clientset, ok := ctx.Client.(*internal.FakeClient).Interface.(*fakeclientset.Clientset)
+	require.True(t, ok)
+	clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create, ok := action.(k8stesting.CreateActionImpl)
+		if !ok || create.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		if create.Name != "my-sa" {
+			return true, nil, fmt.Errorf("unexpected ServiceAccount %s", create.Name)
+		}
+
+		return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "my-sa-token"}}, nil
+	})
+
+	content, err := Resolve(ctx, Reference{Provider: "vault", Path: "secret/db", Key: "user"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin")}, content.Data)
+
+	ctx.ServiceAccount = ""
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "secret/db", Key: "user"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/db#user: could not request a token for ServiceAccount default: unexpected ServiceAccount default")
+}
+
+func TestVaultProviderWithoutCredentials(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, newVaultServer(t).URL)
+
+	_, err := Resolve(newVaultContext(t, nil), Reference{Provider: "vault", Path: "secret/db"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/db: no camel-k-vault Secret holding the vault credentials in namespace default")
+
+	_, err = Resolve(newVaultContext(t, map[string]string{VaultPathsKey: "secret/db"}), Reference{Provider: "vault", Path: "secret/db"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/db: the camel-k-vault Secret of namespace default must hold either a token or a role key")
+}
+
+func TestVaultProviderAllowedPaths(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, newVaultServer(t).URL)
+	ctx := newVaultContext(t, map[string]string{VaultTokenKey: "my-token", VaultPathsKey: "secret/team-a/, secret/db"})
+
+	_, err := Resolve(ctx, Reference{Provider: "vault", Path: "secret/db"})
+	require.NoError(t, err)
+
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "secret/team-b/db"})
+	require.EqualError(t, err, `could not resolve external secret vault:secret/team-b/db: vault secret path secret/team-b/db is not allowed in namespace default, the paths allowed are "secret/team-a/, secret/db"`)
+
+	_, err = Resolve(ctx, Reference{Provider: "vault", Path: "secret/dbs"})
+	require.Error(t, err)
+}
+
+func TestVaultProviderWithoutAddress(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, "")
+
+	_, err := Resolve(newVaultContext(t, map[string]string{VaultTokenKey: "my-token"}), Reference{Provider: "vault", Path: "secret/db"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/db: the VAULT_ADDR environment variable must be set on the operator")
+}
+
+func TestVaultProviderForbidden(t *testing.T) {
+	t.Setenv(VaultAddressEnvVar, newVaultServer(t).URL)
+
+	_, err := Resolve(newVaultContext(t, map[string]string{VaultTokenKey: "wrong-token"}), Reference{Provider: "vault", Path: "secret/db"})
+	require.EqualError(t, err, "could not resolve external secret vault:secret/db: unexpected status 403 Forbidden from the vault server")
+}
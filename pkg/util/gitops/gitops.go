@@ -192,6 +192,11 @@ func EditPipe(kb *v1.Pipe, it *v1.Integration, kit *v1.IntegrationKit, toNamespa
 	if dst.Spec.Sink.Ref != nil {
 		dst.Spec.Sink.Ref.Namespace = toNamespace
 	}
+	for _, sink := range dst.Spec.Sinks {
+		if sink.Ref != nil {
+			sink.Ref.Namespace = toNamespace
+		}
+	}
 	if dst.Spec.Steps != nil {
 		for _, step := range dst.Spec.Steps {
 			if step.Ref != nil {
@@ -26,6 +26,7 @@ import (
 	messaging "knative.dev/eventing/pkg/apis/messaging/v1"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	eventing "knative.dev/eventing/pkg/apis/eventing/v1"
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -169,6 +170,38 @@ func (c *Collection) RemoveDeployment(filter func(*appsv1.Deployment) bool) *app
 	return deploy
 }
 
+// VisitStatefulSet executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSet(visitor func(*appsv1.StatefulSet)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// VisitStatefulSetE executes the visitor function on all StatefulSet resources.
+func (c *Collection) VisitStatefulSetE(visitor func(*appsv1.StatefulSet) error) error {
+	return c.VisitE(func(res runtime.Object) error {
+		if conv, ok := res.(*appsv1.StatefulSet); ok {
+			return visitor(conv)
+		}
+
+		return nil
+	})
+}
+
+// GetStatefulSet returns a StatefulSet that matches the given function.
+func (c *Collection) GetStatefulSet(filter func(*appsv1.StatefulSet) bool) *appsv1.StatefulSet {
+	var retValue *appsv1.StatefulSet
+	c.VisitStatefulSet(func(re *appsv1.StatefulSet) {
+		if filter(re) {
+			retValue = re
+		}
+	})
+
+	return retValue
+}
+
 // VisitConfigMap executes the visitor function on all ConfigMap resources.
 func (c *Collection) VisitConfigMap(visitor func(*corev1.ConfigMap)) {
 	c.Visit(func(res runtime.Object) {
@@ -333,6 +366,27 @@ func (c *Collection) GetRoute(filter func(*routev1.Route) bool) *routev1.Route {
 	return retValue
 }
 
+// VisitHTTPRoute executes the visitor function on all Gateway API HTTPRoute resources.
+func (c *Collection) VisitHTTPRoute(visitor func(*gwv1.HTTPRoute)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*gwv1.HTTPRoute); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// GetHTTPRoute returns a Gateway API HTTPRoute that matches the given function.
+func (c *Collection) GetHTTPRoute(filter func(*gwv1.HTTPRoute) bool) *gwv1.HTTPRoute {
+	var retValue *gwv1.HTTPRoute
+	c.VisitHTTPRoute(func(re *gwv1.HTTPRoute) {
+		if filter(re) {
+			retValue = re
+		}
+	})
+
+	return retValue
+}
+
 // GetCronJob returns a CronJob that matches the given function.
 func (c *Collection) GetCronJob(filter func(job *batchv1.CronJob) bool) *batchv1.CronJob {
 	var retValue *batchv1.CronJob
@@ -444,6 +498,12 @@ func (c *Collection) VisitContainer(visitor func(container *corev1.Container)) {
 			visitor(cntref)
 		}
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		for idx := range s.Spec.Template.Spec.Containers {
+			cntref := &s.Spec.Template.Spec.Containers[idx]
+			visitor(cntref)
+		}
+	})
 	c.VisitKnativeConfigurationSpec(func(cs *serving.ConfigurationSpec) {
 		for id := range cs.Template.Spec.Containers {
 			cntref := &cs.Template.Spec.Containers[id]
@@ -458,7 +518,7 @@ func (c *Collection) VisitContainer(visitor func(container *corev1.Container)) {
 	})
 }
 
-// GetController returns the controller associated with the integration (e.g. Deployment, Knative Service or CronJob).
+// GetController returns the controller associated with the integration (e.g. Deployment, StatefulSet, Knative Service or CronJob).
 func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Object {
 	d := c.GetDeployment(func(deployment *appsv1.Deployment) bool {
 		return filter(deployment)
@@ -466,6 +526,12 @@ func (c *Collection) GetController(filter func(object ctrl.Object) bool) ctrl.Ob
 	if d != nil {
 		return d
 	}
+	ss := c.GetStatefulSet(func(statefulSet *appsv1.StatefulSet) bool {
+		return filter(statefulSet)
+	})
+	if ss != nil {
+		return ss
+	}
 	svc := c.GetKnativeService(func(service *serving.Service) bool {
 		return filter(service)
 	})
@@ -487,6 +553,9 @@ func (c *Collection) VisitPodSpec(visitor func(container *corev1.PodSpec)) {
 	c.VisitDeployment(func(d *appsv1.Deployment) {
 		visitor(&d.Spec.Template.Spec)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.Spec)
+	})
 	c.VisitKnativeConfigurationSpec(func(cs *serving.ConfigurationSpec) {
 		visitor(&cs.Template.Spec.PodSpec)
 	})
@@ -500,6 +569,9 @@ func (c *Collection) VisitPodTemplateMeta(visitor func(meta *metav1.ObjectMeta))
 	c.VisitDeployment(func(d *appsv1.Deployment) {
 		visitor(&d.Spec.Template.ObjectMeta)
 	})
+	c.VisitStatefulSet(func(s *appsv1.StatefulSet) {
+		visitor(&s.Spec.Template.ObjectMeta)
+	})
 	c.VisitKnativeConfigurationSpec(func(cs *serving.ConfigurationSpec) {
 		visitor(&cs.Template.ObjectMeta)
 	})
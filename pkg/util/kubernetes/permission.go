@@ -19,6 +19,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 
 	authorizationv1 "k8s.io/api/authorization/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,8 +56,9 @@ func CheckSelfPermission(ctx context.Context, client kubernetes.Interface, group
 }
 
 // CheckServiceAccountPermission verify if a given Service Account can access a given resource.
-// Service Account must be provided as "system:serviceaccount:namespace:name" format.
-func CheckServiceAccountPermission(ctx context.Context, client kubernetes.Interface, sa, group, resources, namespace, verb string) (bool, error) {
+// Service Account must be provided as "system:serviceaccount:namespace:name" format. The name of the resource
+// may be empty, to check the access to any resource of the given kind.
+func CheckServiceAccountPermission(ctx context.Context, client kubernetes.Interface, sa, group, resources, namespace, name, verb string) (bool, error) {
 	sarReview := &authorizationv1.SubjectAccessReview{
 		Spec: authorizationv1.SubjectAccessReviewSpec{
 			User: sa,
@@ -64,6 +66,7 @@ func CheckServiceAccountPermission(ctx context.Context, client kubernetes.Interf
 				Group:     group,
 				Namespace: namespace,
 				Resource:  resources,
+				Name:      name,
 				Verb:      verb,
 			},
 		},
@@ -80,3 +83,35 @@ func CheckServiceAccountPermission(ctx context.Context, client kubernetes.Interf
 
 	return sar.Status.Allowed, nil
 }
+
+// CheckCrossNamespaceAccess verify if the ServiceAccount running an Integration or a Pipe (the kind) of the given namespace
+// is authorized to get a resource of another namespace, so that a resource of another namespace can only be referenced
+// on behalf of a ServiceAccount allowed to read it. The name of the resource may be empty, to check the access to any
+// resource of the given kind.
+func CheckCrossNamespaceAccess(
+	ctx context.Context, client kubernetes.Interface, kind, namespace, serviceAccount, group, resources, resourceNamespace, name string,
+) error {
+	if serviceAccount == "" {
+		return fmt.Errorf("you must to use an authorized ServiceAccount to access cross-namespace resources %s. "+
+			"Set it in the %s spec accordingly", resources, kind)
+	}
+	ok, err := CheckServiceAccountPermission(
+		ctx,
+		client,
+		fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+		group,
+		resources,
+		resourceNamespace,
+		name,
+		"get",
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("cross-namespace %s reference authorization denied for the ServiceAccount %s and resources %s in namespace %s",
+			kind, serviceAccount, resources, resourceNamespace)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCheckCrossNamespaceAccess(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	var reviewed *authorizationv1.ResourceAttributes
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		//nolint:forcetypeassert
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		reviewed = sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "system:serviceaccount:default:cross-ns-sa" && reviewed.Name == "my-cm"
+
+		return true, sar, nil
+	})
+
+	err := CheckCrossNamespaceAccess(context.Background(), client, "Integration", "default", "", "", "configmaps", "shared", "my-cm")
+	require.EqualError(t, err, "you must to use an authorized ServiceAccount to access cross-namespace resources configmaps. "+
+		"Set it in the Integration spec accordingly")
+
+	err = CheckCrossNamespaceAccess(context.Background(), client, "Integration", "default", "cross-ns-sa", "", "configmaps", "shared", "my-cm")
+	require.NoError(t, err)
+	assert.Equal(t, &authorizationv1.ResourceAttributes{
+		Namespace: "shared",
+		Resource:  "configmaps",
+		Name:      "my-cm",
+		Verb:      "get",
+	}, reviewed)
+
+	err = CheckCrossNamespaceAccess(context.Background(), client, "Pipe", "default", "cross-ns-sa", "", "configmaps", "shared", "other-cm")
+	require.EqualError(t, err, "cross-namespace Pipe reference authorization denied for the ServiceAccount cross-ns-sa "+
+		"and resources configmaps in namespace shared")
+}
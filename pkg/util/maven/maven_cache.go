@@ -0,0 +1,155 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+)
+
+// localRepositoryEntry groups the files stored in the same directory of a Maven local repository,
+// which is typically an artifact version.
+type localRepositoryEntry struct {
+	dir     string
+	files   []string
+	size    int64
+	modTime time.Time
+}
+
+// EvictLocalRepository evicts the content of the Maven local repository located in dir, according to the given policy,
+// when its size exceeds maxSize. The top level entries listed in exclude are never evicted.
+// It returns the number of bytes that have been freed.
+func EvictLocalRepository(dir string, maxSize int64, policy v1.MavenCacheEvictionPolicy, exclude ...string) (int64, error) {
+	if policy == "" || policy == v1.MavenCacheEvictionPolicyNone {
+		return 0, nil
+	}
+	if err := policy.Validate(); err != nil {
+		return 0, err
+	}
+
+	entries, size, err := scanLocalRepository(dir, exclude)
+	if err != nil {
+		return 0, err
+	}
+	if size <= maxSize {
+		return 0, nil
+	}
+
+	var freed int64
+	switch policy {
+	case v1.MavenCacheEvictionPolicyPurge:
+		for _, entry := range entries {
+			if err := removeLocalRepositoryEntry(dir, entry); err != nil {
+				return freed, err
+			}
+			freed += entry.size
+		}
+	case v1.MavenCacheEvictionPolicyOldestFirst:
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].modTime.Before(entries[j].modTime)
+		})
+		for _, entry := range entries {
+			if size-freed <= maxSize {
+				break
+			}
+			if err := removeLocalRepositoryEntry(dir, entry); err != nil {
+				return freed, err
+			}
+			freed += entry.size
+		}
+	}
+
+	return freed, nil
+}
+
+func scanLocalRepository(dir string, exclude []string) ([]*localRepositoryEntry, int64, error) {
+	entries := make(map[string]*localRepositoryEntry)
+	var size int64
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if filepath.Dir(path) == dir && slices.Contains(exclude, d.Name()) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+		if filepath.Dir(path) == dir && slices.Contains(exclude, d.Name()) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		parent := filepath.Dir(path)
+		entry, ok := entries[parent]
+		if !ok {
+			entry = &localRepositoryEntry{dir: parent}
+			entries[parent] = entry
+		}
+		entry.files = append(entry.files, path)
+		entry.size += info.Size()
+		if info.ModTime().After(entry.modTime) {
+			entry.modTime = info.ModTime()
+		}
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not scan Maven local repository %s: %w", dir, err)
+	}
+
+	result := make([]*localRepositoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry)
+	}
+	// make the eviction order deterministic for entries sharing the same modification time
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].dir < result[j].dir
+	})
+
+	return result, size, nil
+}
+
+// removeLocalRepositoryEntry removes the files of the entry, and its directory when left empty.
+// Sub directories, such as the versions of an artifact, are left untouched.
+func removeLocalRepositoryEntry(root string, entry *localRepositoryEntry) error {
+	for _, f := range entry.files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if entry.dir != root {
+		// the directory may still contain other entries
+		_ = os.Remove(entry.dir)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalRepositoryArtifact(t *testing.T, repo string, path string, size int, modTime time.Time) string {
+	t.Helper()
+
+	dir := filepath.Join(repo, path)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	jar := filepath.Join(dir, filepath.Base(filepath.Dir(path))+".jar")
+	require.NoError(t, os.WriteFile(jar, make([]byte, size), 0o600))
+	require.NoError(t, os.Chtimes(jar, modTime, modTime))
+
+	return jar
+}
+
+func createLocalRepository(t *testing.T) (string, []string) {
+	t.Helper()
+
+	repo := t.TempDir()
+	now := time.Now()
+	jars := []string{
+		createLocalRepositoryArtifact(t, repo, "org/apache/camel/camel-core/4.0.0", 100, now.Add(-3*time.Hour)),
+		createLocalRepositoryArtifact(t, repo, "org/apache/camel/camel-main/4.0.0", 100, now.Add(-2*time.Hour)),
+		createLocalRepositoryArtifact(t, repo, "org/apache/camel/camel-yaml-dsl/4.0.0", 100, now.Add(-1*time.Hour)),
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, ".camel-k"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, ".camel-k", "lease"), make([]byte, 100), 0o600))
+
+	return repo, jars
+}
+
+func TestEvictLocalRepositoryNone(t *testing.T) {
+	repo, jars := createLocalRepository(t)
+
+	freed, err := EvictLocalRepository(repo, 10, v1.MavenCacheEvictionPolicyNone, ".camel-k")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), freed)
+	for _, jar := range jars {
+		assert.FileExists(t, jar)
+	}
+}
+
+func TestEvictLocalRepositoryBelowMaxSize(t *testing.T) {
+	repo, jars := createLocalRepository(t)
+
+	freed, err := EvictLocalRepository(repo, 300, v1.MavenCacheEvictionPolicyPurge, ".camel-k")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), freed)
+	for _, jar := range jars {
+		assert.FileExists(t, jar)
+	}
+}
+
+func TestEvictLocalRepositoryOldestFirst(t *testing.T) {
+	repo, jars := createLocalRepository(t)
+
+	freed, err := EvictLocalRepository(repo, 150, v1.MavenCacheEvictionPolicyOldestFirst, ".camel-k")
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), freed)
+	assert.NoFileExists(t, jars[0])
+	assert.NoDirExists(t, filepath.Dir(jars[0]))
+	assert.NoFileExists(t, jars[1])
+	assert.FileExists(t, jars[2])
+	assert.FileExists(t, filepath.Join(repo, ".camel-k", "lease"))
+}
+
+func TestEvictLocalRepositoryPurge(t *testing.T) {
+	repo, jars := createLocalRepository(t)
+
+	freed, err := EvictLocalRepository(repo, 250, v1.MavenCacheEvictionPolicyPurge, ".camel-k")
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), freed)
+	for _, jar := range jars {
+		assert.NoFileExists(t, jar)
+	}
+	assert.DirExists(t, repo)
+	assert.FileExists(t, filepath.Join(repo, ".camel-k", "lease"))
+}
+
+func TestEvictLocalRepositoryInvalidPolicy(t *testing.T) {
+	repo, _ := createLocalRepository(t)
+
+	_, err := EvictLocalRepository(repo, 0, v1.MavenCacheEvictionPolicy("Random"), ".camel-k")
+	require.Error(t, err)
+}
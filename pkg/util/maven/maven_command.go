@@ -53,7 +53,9 @@ func (c *Command) Do(ctx context.Context) error {
 
 	Log.WithValues("MAVEN_OPTS", mavenOptions).Infof("executing: %s", strings.Join(cmd.Args, " "))
 
-	return util.RunAndLog(ctx, cmd, LogHandler, LogHandler)
+	handler := c.context.logHandler()
+
+	return util.RunAndLog(ctx, cmd, handler, handler)
 }
 
 // DoPom is in charge to generate the pom file.
@@ -197,6 +199,16 @@ type Context struct {
 	AdditionalArguments       []string
 	AdditionalEntries         map[string]any
 	LocalRepository           string
+	// LogHandler handles the Maven output, it defaults to LogHandler when not set
+	LogHandler func(string) string
+}
+
+func (c *Context) logHandler() func(string) string {
+	if c.LogHandler != nil {
+		return c.LogHandler
+	}
+
+	return LogHandler
 }
 
 func (c *Context) AddEntry(id string, entry any) {
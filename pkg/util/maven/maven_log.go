@@ -20,6 +20,7 @@ package maven
 import (
 	"regexp"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util/log"
 )
 
@@ -40,10 +41,35 @@ const (
 var mavenLogger = log.WithName("maven.build")
 var mavenLoggingFormat = regexp.MustCompile(`^\[(TRACE|DEBUG|INFO|WARNING|ERROR|FATAL)\] (.*)$`)
 
+// The patterns of the Maven error messages, used to classify a failure. Network errors are checked first,
+// as Maven reports a failure to download an artifact as a dependency resolution failure.
+var (
+	mavenNetworkFailure = regexp.MustCompile(`(?i)(could not transfer|transfer failed|connection (reset|refused|timed out)|` +
+		`connect timed out|read timed out|unknownhost|unknown host|temporary failure in name resolution|no route to host|` +
+		`remote host terminated|premature end of|status code: 5\d\d|service unavailable|bad gateway|gateway time-?out)`)
+	mavenCompilationFailure = regexp.MustCompile(`(?i)(compilation (error|failure)|cannot find symbol)`)
+	mavenDependencyFailure  = regexp.MustCompile(`(?i)(could not resolve dependencies|could not find artifact|` +
+		`non-resolvable|failed to read artifact descriptor|failed to collect dependencies)`)
+)
+
 // LogHandler is in charge to log the text passed and, if the trace is an error, to return the message to the caller.
 func LogHandler(s string) string {
+	return logWith(mavenLogger, s)
+}
+
+// NewLogHandler returns a log handler that behaves as LogHandler, but logs the Maven output with the given logger,
+// so that the build context carried by the logger, e.g., the build task and phase, is attached to every trace.
+func NewLogHandler(logger log.Logger) func(string) string {
+	l := logger.WithName("maven.build")
+
+	return func(s string) string {
+		return logWith(l, s)
+	}
+}
+
+func logWith(logger log.Logger, s string) string {
 	l := parseLog(s)
-	normalizeLog(l)
+	normalizeLog(logger, l)
 
 	if l.Level == ERROR {
 		return l.Msg
@@ -73,13 +99,27 @@ func parseLog(line string) mavenLog {
 	return l
 }
 
-func normalizeLog(mavenLog mavenLog) {
+func normalizeLog(logger log.Logger, mavenLog mavenLog) {
 	switch mavenLog.Level {
 	case DEBUG, TRACE:
-		mavenLogger.Debug(mavenLog.Msg)
+		logger.Debug(mavenLog.Msg)
 	case INFO, WARNING:
-		mavenLogger.Info(mavenLog.Msg)
+		logger.Info(mavenLog.Msg)
 	case ERROR, FATAL:
-		mavenLogger.Error(nil, mavenLog.Msg)
+		logger.Error(nil, mavenLog.Msg)
+	}
+}
+
+// FailureClass classifies the failure reported by the given Maven error message.
+func FailureClass(msg string) v1.BuildFailureClass {
+	switch {
+	case mavenNetworkFailure.MatchString(msg):
+		return v1.BuildFailureClassNetwork
+	case mavenCompilationFailure.MatchString(msg):
+		return v1.BuildFailureClassCompilation
+	case mavenDependencyFailure.MatchString(msg):
+		return v1.BuildFailureClassDependency
+	default:
+		return v1.BuildFailureClassUnknown
 	}
 }
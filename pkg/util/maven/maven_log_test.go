@@ -18,14 +18,21 @@ limitations under the License.
 package maven
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/v2/pkg/util"
+	"github.com/apache/camel-k/v2/pkg/util/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 func TestRunAndLogErrorMvn(t *testing.T) {
@@ -58,3 +65,63 @@ func TestParseLogCannotTrace(t *testing.T) {
 	assert.Equal(t, INFO, mavenLogLine.Level)
 	assert.Equal(t, "[FAILING] this is a failing log trace", mavenLogLine.Msg)
 }
+
+func TestFailureClass(t *testing.T) {
+	tests := []struct {
+		msg   string
+		class v1.BuildFailureClass
+	}{
+		{
+			"Failed to execute goal on project camel-k-integration: Could not resolve dependencies for project org.apache.camel.k.integration:camel-k-integration:jar:2.9.0: " +
+				"Could not transfer artifact org.apache.camel:camel-timer:jar:4.14.0 from/to central (https://repo.maven.apache.org/maven2): Connection reset",
+			v1.BuildFailureClassNetwork,
+		},
+		{
+			"Failed to execute goal on project camel-k-integration: Could not resolve dependencies for project org.apache.camel.k.integration:camel-k-integration:jar:2.9.0: " +
+				"Could not transfer artifact org.apache.camel:camel-timer:jar:4.14.0 from/to central (https://repo.maven.apache.org/maven2): status code: 503, reason phrase: Service Unavailable (503)",
+			v1.BuildFailureClassNetwork,
+		},
+		{
+			"Failed to execute goal org.apache.maven.plugins:maven-compiler-plugin:3.14.0:compile (default-compile) on project camel-k-integration: Compilation failure",
+			v1.BuildFailureClassCompilation,
+		},
+		{
+			"COMPILATION ERROR : ",
+			v1.BuildFailureClassCompilation,
+		},
+		{
+			"Failed to execute goal on project camel-k-integration: Could not resolve dependencies for project org.apache.camel.k.integration:camel-k-integration:jar:2.9.0: " +
+				"Could not find artifact org.acme:missing:jar:1.0.0 in central (https://repo.maven.apache.org/maven2)",
+			v1.BuildFailureClassDependency,
+		},
+		{
+			"The goal you specified requires a project to execute but there is no POM in this directory",
+			v1.BuildFailureClassUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.class, FailureClass(tt.msg), tt.msg)
+	}
+}
+
+func TestNewLogHandler(t *testing.T) {
+	var out bytes.Buffer
+	logf.SetLogger(zap.New(zap.WriteTo(&out)))
+
+	handler := NewLogHandler(log.WithValues("task", "builder"))
+
+	assert.Empty(t, handler("[INFO] this is an info log trace"))
+	assert.Equal(t, "this is an error log trace", handler("[ERROR] this is an error log trace"))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		record := map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		assert.Equal(t, "camel-k.maven.build", record["logger"])
+		assert.Equal(t, "builder", record["task"])
+	}
+	assert.Contains(t, lines[0], `"level":"info","ts"`)
+	assert.Contains(t, lines[1], `"msg":"this is an error log trace"`)
+}
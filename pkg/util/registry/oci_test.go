@@ -0,0 +1,85 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactTag(t *testing.T) {
+	assert.Equal(t, "sha256-abc.sbom", ArtifactTag("sha256:abc", "sbom"))
+}
+
+func TestRepository(t *testing.T) {
+	repository, err := Repository("my-registry:5000/camel-k/camel-k-kit-123:456", true)
+	require.NoError(t, err)
+	assert.Equal(t, "my-registry:5000/camel-k/camel-k-kit-123", repository)
+
+	repository, err = Repository("camel-k/camel-k-kit-123@sha256:"+strings.Repeat("a", 64), false)
+	require.NoError(t, err)
+	assert.Equal(t, "index.docker.io/camel-k/camel-k-kit-123", repository)
+}
+
+func TestPushArtifact(t *testing.T) {
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	image := u.Host + "/camel-k/camel-k-kit-123:456"
+	ref, err := PushArtifact(context.TODO(), image, ArtifactTag("sha256:abc", "sbom"), "application/vnd.cyclonedx+json", []byte("{}"),
+		map[string]string{"predicateType": "https://cyclonedx.org/bom"}, true)
+	require.NoError(t, err)
+	assert.Contains(t, ref, u.Host+"/camel-k/camel-k-kit-123@sha256:")
+
+	digest, err := name.NewDigest(ref, name.Insecure)
+	require.NoError(t, err)
+	img, err := remote.Image(digest)
+	require.NoError(t, err)
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	manifest, err := img.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"predicateType": "https://cyclonedx.org/bom"}, manifest.Layers[0].Annotations)
+	mediaType, err := layers[0].MediaType()
+	require.NoError(t, err)
+	assert.Equal(t, "application/vnd.cyclonedx+json", string(mediaType))
+	r, err := layers[0].Uncompressed()
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(content))
+
+	tagged, err := name.NewTag(u.Host+"/camel-k/camel-k-kit-123:sha256-abc.sbom", name.Insecure)
+	require.NoError(t, err)
+	_, err = remote.Head(tagged)
+	require.NoError(t, err)
+}
@@ -25,11 +25,12 @@ import (
 
 // Config represents a config option.
 type Config struct {
-	storageType     StorageType
-	contentType     ContentType
-	resourceName    string
-	resourceKey     string
-	destinationPath string
+	storageType       StorageType
+	contentType       ContentType
+	resourceNamespace string
+	resourceName      string
+	resourceKey       string
+	destinationPath   string
 }
 
 // DestinationPath is the location where the resource will be stored on destination.
@@ -47,6 +48,11 @@ func (config *Config) ContentType() ContentType {
 	return config.contentType
 }
 
+// Namespace is the namespace of the resource, when it does not belong to the namespace of the Integration.
+func (config *Config) Namespace() string {
+	return config.resourceNamespace
+}
+
 // Name is the name of the resource.
 func (config *Config) Name() string {
 	return config.resourceName
@@ -57,9 +63,21 @@ func (config *Config) Key() string {
 	return config.resourceKey
 }
 
+// WithLocalName returns a copy of the config, referencing the resource with the given name in the namespace of the Integration.
+func (config *Config) WithLocalName(name string) *Config {
+	local := *config
+	local.resourceNamespace = ""
+	local.resourceName = name
+
+	return &local
+}
+
 // String represents the unparsed value of the resource.
 func (config *Config) String() string {
 	s := fmt.Sprintf("%s:%s", config.storageType, config.resourceName)
+	if config.resourceNamespace != "" {
+		s = fmt.Sprintf("%s:%s:%s", config.storageType, config.resourceNamespace, config.resourceName)
+	}
 	if config.resourceKey != "" {
 		s = fmt.Sprintf("%s/%s", s, config.resourceKey)
 	}
@@ -101,13 +119,20 @@ var (
 
 func newConfig(storageType StorageType, contentType ContentType, value string) *Config {
 	rn, mk, mp := parseCMOrSecretValue(value)
+	// The resource may be qualified with its namespace, as namespace:name. The namespace/name notation
+	// cannot be used, as it would be ambiguous with the name/key notation selecting the key to be filtered.
+	ns, name, found := strings.Cut(rn, ":")
+	if !found {
+		ns, name = "", rn
+	}
 
 	return &Config{
-		storageType:     storageType,
-		contentType:     contentType,
-		resourceName:    rn,
-		resourceKey:     mk,
-		destinationPath: mp,
+		storageType:       storageType,
+		contentType:       contentType,
+		resourceNamespace: ns,
+		resourceName:      name,
+		resourceKey:       mk,
+		destinationPath:   mp,
 	}
 }
 
@@ -107,3 +107,32 @@ func TestParseConfigOptionAllParams(t *testing.T) {
 	assert.Equal(t, "", parsedSec4.Key())
 	assert.Equal(t, "", parsedSec4.DestinationPath())
 }
+
+func TestParseConfigOptionNamespace(t *testing.T) {
+	parsedCm, err := ParseConfig("configmap:shared:my-cm/key@/tmp/my")
+	require.NoError(t, err)
+	assert.Equal(t, StorageTypeConfigmap, parsedCm.StorageType())
+	assert.Equal(t, "shared", parsedCm.Namespace())
+	assert.Equal(t, "my-cm", parsedCm.Name())
+	assert.Equal(t, "key", parsedCm.Key())
+	assert.Equal(t, "/tmp/my", parsedCm.DestinationPath())
+	assert.Equal(t, "configmap:shared:my-cm/key@/tmp/my", parsedCm.String())
+
+	parsedSec, err := ParseResource("secret:shared:my-sec")
+	require.NoError(t, err)
+	assert.Equal(t, StorageTypeSecret, parsedSec.StorageType())
+	assert.Equal(t, "shared", parsedSec.Namespace())
+	assert.Equal(t, "my-sec", parsedSec.Name())
+	assert.Equal(t, "", parsedSec.Key())
+
+	local := parsedCm.WithLocalName("my-it-shared-my-cm")
+	assert.Equal(t, "", local.Namespace())
+	assert.Equal(t, "my-it-shared-my-cm", local.Name())
+	assert.Equal(t, "configmap:my-it-shared-my-cm/key@/tmp/my", local.String())
+	assert.Equal(t, "shared", parsedCm.Namespace())
+
+	parsedLocal, err := ParseConfig("configmap:my-cm/key")
+	require.NoError(t, err)
+	assert.Equal(t, "", parsedLocal.Namespace())
+	assert.Equal(t, "my-cm", parsedLocal.Name())
+}
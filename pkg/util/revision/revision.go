@@ -0,0 +1,259 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/util/kubernetes"
+)
+
+const (
+	// DefaultHistoryLimit is the number of revisions kept in the history of an Integration, unless
+	// configured with the IntegrationRevisionHistoryLimitAnnotation.
+	DefaultHistoryLimit = 10
+
+	integrationKey = "integration.yaml"
+
+	digestAnnotation         = "camel.apache.org/revision.digest"
+	integrationKitAnnotation = "camel.apache.org/revision.integration-kit"
+	imageAnnotation          = "camel.apache.org/revision.image"
+	timestampAnnotation      = "camel.apache.org/revision.timestamp"
+)
+
+// Revision is a revision of an Integration, as recorded in its history.
+type Revision struct {
+	// Number is the sequence number of the revision in the history of the Integration
+	Number int
+	// Digest is the digest of the Integration revision
+	Digest string
+	// IntegrationKit is the IntegrationKit used by the Integration revision
+	IntegrationKit *corev1.ObjectReference
+	// Image is the container image used by the Integration revision
+	Image string
+	// Timestamp is the time when the Integration revision was recorded
+	Timestamp metav1.Time
+	// Integration is the snapshot of the Integration revision
+	Integration *v1.Integration
+}
+
+// ConfigMapName returns the name of the ConfigMap storing the given revision of an Integration.
+func ConfigMapName(integration string, number int) string {
+	return fmt.Sprintf("%s-revision-%d", integration, number)
+}
+
+// HistoryLimit returns the number of revisions kept in the history of the Integration.
+func HistoryLimit(it *v1.Integration) int {
+	if value := v1.GetAnnotation(v1.IntegrationRevisionHistoryLimitAnnotation, it); value != "" {
+		if limit, err := strconv.Atoi(value); err == nil && limit >= 0 {
+			return limit
+		}
+	}
+
+	return DefaultHistoryLimit
+}
+
+// List returns the revisions of the given Integration, from the oldest to the latest.
+func List(ctx context.Context, c ctrl.Reader, namespace string, name string) ([]Revision, error) {
+	list := corev1.ConfigMapList{}
+	if err := c.List(ctx, &list,
+		ctrl.InNamespace(namespace),
+		ctrl.MatchingLabels{v1.IntegrationRevisionOfLabel: name},
+		ctrl.HasLabels{v1.IntegrationRevisionLabel},
+	); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]Revision, 0, len(list.Items))
+	for _, cm := range list.Items {
+		r, err := fromConfigMap(&cm)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revision %s of integration %s: %w", cm.Name, name, err)
+		}
+		revisions = append(revisions, *r)
+	}
+	slices.SortFunc(revisions, func(a, b Revision) int {
+		return a.Number - b.Number
+	})
+
+	return revisions, nil
+}
+
+// Get returns the given revision of an Integration, or nil if it is not in its history.
+func Get(ctx context.Context, c ctrl.Reader, namespace string, name string, number int) (*Revision, error) {
+	cm := corev1.ConfigMap{}
+	if err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: ConfigMapName(name, number)}, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return fromConfigMap(&cm)
+}
+
+// Record adds the current revision of the Integration to its history, unless it is already the latest one,
+// and removes the oldest revisions exceeding the history limit. It returns the recorded revision, if any.
+func Record(ctx context.Context, c ctrl.Client, it *v1.Integration) (*Revision, error) {
+	limit := HistoryLimit(it)
+	if limit == 0 || it.Status.Digest == "" {
+		return nil, nil
+	}
+
+	revisions, err := List(ctx, c, it.Namespace, it.Name)
+	if err != nil {
+		return nil, err
+	}
+	number := 1
+	if len(revisions) > 0 {
+		latest := revisions[len(revisions)-1]
+		if latest.Digest == it.Status.Digest {
+			return nil, nil
+		}
+		number = latest.Number + 1
+	}
+
+	r := Revision{
+		Number:         number,
+		Digest:         it.Status.Digest,
+		IntegrationKit: it.Status.IntegrationKit,
+		Image:          it.Status.Image,
+		Timestamp:      metav1.Now().Rfc3339Copy(),
+		Integration:    snapshot(it),
+	}
+	cm, err := toConfigMap(it, &r)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Create(ctx, cm); err != nil {
+		return nil, fmt.Errorf("unable to record revision %d of integration %s: %w", number, it.Name, err)
+	}
+
+	// Remove the oldest revisions, including the one just recorded
+	for i := 0; i < len(revisions)+1-limit; i++ {
+		old := corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: it.Namespace,
+				Name:      ConfigMapName(it.Name, revisions[i].Number),
+			},
+		}
+		if err := c.Delete(ctx, &old); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to delete revision %d of integration %s: %w", revisions[i].Number, it.Name, err)
+		}
+	}
+
+	return &r, nil
+}
+
+// snapshot returns a copy of the Integration, limited to the content needed to restore it.
+func snapshot(it *v1.Integration) *v1.Integration {
+	s := v1.NewIntegration(it.Namespace, it.Name)
+	s.Spec = *it.Spec.DeepCopy()
+
+	return &s
+}
+
+func toConfigMap(it *v1.Integration, r *Revision) (*corev1.ConfigMap, error) {
+	data, err := kubernetes.ToYAML(r.Integration)
+	if err != nil {
+		return nil, err
+	}
+	kit := ""
+	if r.IntegrationKit != nil {
+		kit = r.IntegrationKit.Namespace + "/" + r.IntegrationKit.Name
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: it.Namespace,
+			Name:      ConfigMapName(it.Name, r.Number),
+			Labels: map[string]string{
+				v1.IntegrationRevisionOfLabel: it.Name,
+				v1.IntegrationRevisionLabel:   strconv.Itoa(r.Number),
+			},
+			Annotations: map[string]string{
+				digestAnnotation:         r.Digest,
+				integrationKitAnnotation: kit,
+				imageAnnotation:          r.Image,
+				timestampAnnotation:      r.Timestamp.Format(time.RFC3339),
+			},
+			// The history is only deleted along with the Integration
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       v1.IntegrationKind,
+					Name:       it.Name,
+					UID:        it.UID,
+				},
+			},
+		},
+		Data: map[string]string{
+			integrationKey: string(data),
+		},
+	}, nil
+}
+
+func fromConfigMap(cm *corev1.ConfigMap) (*Revision, error) {
+	number, err := strconv.Atoi(cm.Labels[v1.IntegrationRevisionLabel])
+	if err != nil {
+		return nil, err
+	}
+	r := Revision{
+		Number: number,
+		Digest: cm.Annotations[digestAnnotation],
+		Image:  cm.Annotations[imageAnnotation],
+	}
+	if kit := cm.Annotations[integrationKitAnnotation]; kit != "" {
+		namespace, name, _ := strings.Cut(kit, "/")
+		r.IntegrationKit = &corev1.ObjectReference{
+			Namespace: namespace,
+			Name:      name,
+		}
+	}
+	if ts := cm.Annotations[timestampAnnotation]; ts != "" {
+		t, err := time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return nil, err
+		}
+		r.Timestamp = metav1.NewTime(t)
+	}
+	it := v1.Integration{}
+	if err := yaml.Unmarshal([]byte(cm.Data[integrationKey]), &it); err != nil {
+		return nil, err
+	}
+	r.Integration = &it
+
+	return &r, nil
+}
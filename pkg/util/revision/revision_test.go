@@ -0,0 +1,155 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/v2/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/v2/pkg/internal"
+)
+
+func TestRecord(t *testing.T) {
+	it := integration("digest-1", "kit-1", "my-image:1")
+	c, err := internal.NewFakeClient(it)
+	require.NoError(t, err)
+
+	r, err := Record(context.TODO(), c, it)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	assert.Equal(t, 1, r.Number)
+
+	// Same revision
+	r, err = Record(context.TODO(), c, it)
+	require.NoError(t, err)
+	assert.Nil(t, r)
+
+	it.Spec.Sources[0].Content = "from('timer:tick').log('v2')"
+	it.Status.Digest = "digest-2"
+	it.Status.IntegrationKit.Name = "kit-2"
+	it.Status.Image = "my-image:2"
+	r, err = Record(context.TODO(), c, it)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	assert.Equal(t, 2, r.Number)
+
+	revisions, err := List(context.TODO(), c, "ns", "my-it")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 1, revisions[0].Number)
+	assert.Equal(t, "digest-1", revisions[0].Digest)
+	assert.Equal(t, "ns", revisions[0].IntegrationKit.Namespace)
+	assert.Equal(t, "kit-1", revisions[0].IntegrationKit.Name)
+	assert.Equal(t, "my-image:1", revisions[0].Image)
+	assert.False(t, revisions[0].Timestamp.IsZero())
+	assert.Equal(t, "from('timer:tick').log('v1')", revisions[0].Integration.Spec.Sources[0].Content)
+	assert.Equal(t, 2, revisions[1].Number)
+	assert.Equal(t, "from('timer:tick').log('v2')", revisions[1].Integration.Spec.Sources[0].Content)
+
+	cm := corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.TODO(), ctrl.ObjectKey{Namespace: "ns", Name: ConfigMapName("my-it", 2)}, &cm))
+	assert.Equal(t, "my-it", cm.Labels[v1.IntegrationRevisionOfLabel])
+	// the history is not selected by the resources of the Integration, which are garbage collected on undeploy
+	assert.NotContains(t, cm.Labels, v1.IntegrationLabel)
+	assert.Equal(t, "2", cm.Labels[v1.IntegrationRevisionLabel])
+	assert.Equal(t, v1.IntegrationKind, cm.OwnerReferences[0].Kind)
+	assert.Equal(t, "my-it", cm.OwnerReferences[0].Name)
+}
+
+func TestRecordHistoryLimit(t *testing.T) {
+	it := integration("digest-1", "kit-1", "my-image:1")
+	it.Annotations = map[string]string{
+		v1.IntegrationRevisionHistoryLimitAnnotation: "2",
+	}
+	c, err := internal.NewFakeClient(it)
+	require.NoError(t, err)
+
+	for _, digest := range []string{"digest-1", "digest-2", "digest-3"} {
+		it.Status.Digest = digest
+		_, err := Record(context.TODO(), c, it)
+		require.NoError(t, err)
+	}
+
+	revisions, err := List(context.TODO(), c, "ns", "my-it")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Number)
+	assert.Equal(t, 3, revisions[1].Number)
+
+	r, err := Get(context.TODO(), c, "ns", "my-it", 1)
+	require.NoError(t, err)
+	assert.Nil(t, r)
+	r, err = Get(context.TODO(), c, "ns", "my-it", 3)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	assert.Equal(t, "digest-3", r.Digest)
+}
+
+func TestRecordDisabled(t *testing.T) {
+	it := integration("digest-1", "kit-1", "my-image:1")
+	it.Annotations = map[string]string{
+		v1.IntegrationRevisionHistoryLimitAnnotation: "0",
+	}
+	c, err := internal.NewFakeClient(it)
+	require.NoError(t, err)
+
+	r, err := Record(context.TODO(), c, it)
+	require.NoError(t, err)
+	assert.Nil(t, r)
+
+	revisions, err := List(context.TODO(), c, "ns", "my-it")
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	it := integration("digest-1", "kit-1", "my-image:1")
+	assert.Equal(t, DefaultHistoryLimit, HistoryLimit(it))
+	it.Annotations = map[string]string{
+		v1.IntegrationRevisionHistoryLimitAnnotation: "5",
+	}
+	assert.Equal(t, 5, HistoryLimit(it))
+	it.Annotations[v1.IntegrationRevisionHistoryLimitAnnotation] = "-1"
+	assert.Equal(t, DefaultHistoryLimit, HistoryLimit(it))
+	it.Annotations[v1.IntegrationRevisionHistoryLimitAnnotation] = "ten"
+	assert.Equal(t, DefaultHistoryLimit, HistoryLimit(it))
+}
+
+func integration(digest string, kit string, image string) *v1.Integration {
+	it := v1.NewIntegration("ns", "my-it")
+	it.Spec.Sources = []v1.SourceSpec{
+		{
+			DataSpec: v1.DataSpec{
+				Name:    "routes.groovy",
+				Content: "from('timer:tick').log('v1')",
+			},
+		},
+	}
+	it.Status.Phase = v1.IntegrationPhaseRunning
+	it.Status.Digest = digest
+	it.Status.IntegrationKit = &corev1.ObjectReference{Namespace: "ns", Name: kit}
+	it.Status.Image = image
+
+	return &it
+}
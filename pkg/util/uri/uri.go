@@ -19,9 +19,11 @@ package uri
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/apache/camel-k/v2/pkg/util/log"
@@ -29,6 +31,33 @@ import (
 
 var uriRegexp = regexp.MustCompile(`^[a-z0-9+][a-zA-Z0-9-+]*:.*$`)
 var queryExtractorRegexp = `^[^?]+\?(?:|.*[&])%s=([^&]+)(?:[&].*|$)`
+var nestedSchemeRegexp = regexp.MustCompile(`^([a-z][a-z0-9+.-]*):(//.*)$`)
+
+// networkSchemes are the schemes a network address is declared with, along with their well-known port if any.
+var networkSchemes = map[string]int32{
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+	"ftp":   21,
+	"ftps":  990,
+	"sftp":  22,
+	"smtp":  25,
+	"smtps": 465,
+	"imap":  143,
+	"imaps": 993,
+	"pop3":  110,
+	"pop3s": 995,
+	"tcp":   0,
+	"udp":   0,
+}
+
+// Endpoint is a network address a Camel URI connects to.
+type Endpoint struct {
+	Host string
+	// The port, or 0 when it cannot be inferred from the URI
+	Port int32
+}
 
 // HasCamelURIFormat tells if a given string may belong to a Camel URI, without checking any catalog.
 func HasCamelURIFormat(uri string) bool {
@@ -87,3 +116,66 @@ func AppendParameters(uri string, params map[string]string) string {
 
 	return uri
 }
+
+// GetEndpoints returns the network addresses the Camel URI connects to, as far as they can be inferred from the URI,
+// ie, from the authority of the network URIs (as in `http://host:port/path` or `netty:tcp://host:port`),
+// or from the `brokers` parameter.
+// Property placeholders are not resolved, so that the addresses containing any are ignored.
+func GetEndpoints(uri string) []Endpoint {
+	component := GetComponent(uri)
+	if component == "" {
+		return nil
+	}
+
+	var endpoints []Endpoint
+	scheme := component
+	remainder := strings.TrimPrefix(uri, component+":")
+	if i := strings.Index(remainder, "?"); i >= 0 {
+		remainder = remainder[:i]
+	}
+	// Components wrapping another URI, e.g., vertx-http:http://host or netty:tcp://host
+	if match := nestedSchemeRegexp.FindStringSubmatch(remainder); match != nil {
+		scheme = match[1]
+		remainder = match[2]
+	}
+	defaultPort, isNetworkScheme := networkSchemes[scheme]
+	if authority, ok := strings.CutPrefix(remainder, "//"); ok && isNetworkScheme {
+		if i := strings.Index(authority, "/"); i >= 0 {
+			authority = authority[:i]
+		}
+		if i := strings.LastIndex(authority, "@"); i >= 0 {
+			authority = authority[i+1:]
+		}
+		if endpoint, ok := parseEndpoint(authority, defaultPort); ok {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	for _, broker := range strings.Split(GetQueryParameter(uri, "brokers"), ",") {
+		if endpoint, ok := parseEndpoint(strings.TrimSpace(broker), 0); ok {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints
+}
+
+func parseEndpoint(address string, defaultPort int32) (Endpoint, bool) {
+	if address == "" || strings.Contains(address, "{{") || strings.Contains(address, "${") {
+		return Endpoint{}, false
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// No port
+		return Endpoint{Host: strings.Trim(address, "[]"), Port: defaultPort}, true
+	}
+	if host == "" {
+		return Endpoint{}, false
+	}
+	p, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return Endpoint{}, false
+	}
+
+	return Endpoint{Host: host, Port: int32(p)}, true
+}